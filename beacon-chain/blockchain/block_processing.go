@@ -134,6 +134,7 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock)
 	}
 
 	log.WithField("slot", block.Slot-params.BeaconConfig().GenesisSlot).Info("Finished processing beacon block")
+	c.processedBlockFeed.Send(block)
 	return beaconState, nil
 }
 
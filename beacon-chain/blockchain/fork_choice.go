@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prometheus/client_golang/prometheus"
@@ -392,6 +393,10 @@ func (c *ChainService) AttestationTargets(state *pb.BeaconState) (map[uint64]*pb
 //            for validator_index, target in attestation_targets
 //            if get_ancestor(store, target, block.slot) == block
 //        )
+//
+// The targets map holds the latest target of each validator, so a validator contributes to the
+// count at most once. Targets are processed in ascending validator index order so that counting
+// the votes is reproducible regardless of the map iteration order.
 func VoteCount(block *pb.BeaconBlock, state *pb.BeaconState, targets map[uint64]*pb.AttestationTarget, beaconDB *db.BeaconDB) (int, error) {
 	balances := 0
 	var ancestorRoot []byte
@@ -402,7 +407,15 @@ func VoteCount(block *pb.BeaconBlock, state *pb.BeaconState, targets map[uint64]
 		return 0, err
 	}
 
-	for validatorIndex, target := range targets {
+	validatorIndices := make([]uint64, 0, len(targets))
+	for validatorIndex := range targets {
+		validatorIndices = append(validatorIndices, validatorIndex)
+	}
+	sort.Slice(validatorIndices, func(i, j int) bool {
+		return validatorIndices[i] < validatorIndices[j]
+	})
+	for _, validatorIndex := range validatorIndices {
+		target := targets[validatorIndex]
 		ancestorRoot, err = cachedAncestor(target, block.Slot, beaconDB)
 		if err != nil {
 			return 0, err
@@ -43,6 +43,7 @@ type ChainService struct {
 	opsPoolService       operations.OperationFeeds
 	chainStartChan       chan time.Time
 	canonicalBlockFeed   *event.Feed
+	processedBlockFeed   *event.Feed
 	genesisTime          time.Time
 	finalizedEpoch       uint64
 	stateInitializedFeed *event.Feed
@@ -77,6 +78,7 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		opsPoolService:       cfg.OpsPoolService,
 		attsService:          cfg.AttsService,
 		canonicalBlockFeed:   new(event.Feed),
+		processedBlockFeed:   new(event.Feed),
 		chainStartChan:       make(chan time.Time),
 		stateInitializedFeed: new(event.Feed),
 		p2p:                  cfg.P2p,
@@ -211,6 +213,12 @@ func (c *ChainService) CanonicalBlockFeed() *event.Feed {
 	return c.canonicalBlockFeed
 }
 
+// ProcessedBlockFeed returns a feed that is written to
+// whenever a block has been fully processed by the chain service.
+func (c *ChainService) ProcessedBlockFeed() *event.Feed {
+	return c.processedBlockFeed
+}
+
 // StateInitializedFeed returns a feed that is written to
 // when the beacon state is first initialized.
 func (c *ChainService) StateInitializedFeed() *event.Feed {
@@ -1,13 +1,22 @@
 package backend
 
 import (
+	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -24,26 +33,16 @@ func generateSimulatedBlock(
 	historicalDeposits []*pb.Deposit,
 	simObjects *SimulatedObjects,
 	privKeys []*bls.SecretKey,
+	skipEpochProcessing bool,
 ) (*pb.BeaconBlock, [32]byte, error) {
-	stateRoot, err := hashutil.HashProto(beaconState)
-	if err != nil {
-		return nil, [32]byte{}, fmt.Errorf("could not tree hash state: %v", err)
-	}
 	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot+1)
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
-	epoch := helpers.SlotToEpoch(beaconState.Slot + 1)
-	buf := make([]byte, 32)
-	binary.LittleEndian.PutUint64(buf, epoch)
-	domain := forkutil.DomainVersion(beaconState.Fork, epoch, params.BeaconConfig().DomainRandao)
-	// We make the previous validator's index sign the message instead of the proposer.
-	epochSignature := privKeys[proposerIdx].Sign(buf, domain)
 	block := &pb.BeaconBlock{
 		Slot:             beaconState.Slot + 1,
-		RandaoReveal:     epochSignature.Marshal(),
+		RandaoReveal:     randaoReveal(beaconState, beaconState.Slot+1, privKeys[proposerIdx]),
 		ParentRootHash32: prevBlockRoot[:],
-		StateRootHash32:  stateRoot[:],
 		Eth1Data: &pb.Eth1Data{
 			DepositRootHash32: []byte{1},
 			BlockHash32:       []byte{2},
@@ -56,10 +55,31 @@ func generateSimulatedBlock(
 			VoluntaryExits:    []*pb.VoluntaryExit{},
 		},
 	}
+	if simObjects.eth1BlockHash != nil {
+		block.Eth1Data.BlockHash32 = simObjects.eth1BlockHash
+	}
+	if simObjects.emptyBody {
+		// Skip every operation so the block only advances the slot.
+		simObjects = &SimulatedObjects{}
+	}
 	if simObjects.simDeposit != nil {
+		pubkey := []byte(simObjects.simDeposit.Pubkey)
+		withdrawalCredentials := make([]byte, 32)
+		if simObjects.simDeposit.TopUp {
+			idx := simObjects.simDeposit.ValidatorIndex
+			if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+				return nil, [32]byte{}, fmt.Errorf(
+					"top up deposit targets validator %d outside of registry of size %d",
+					idx,
+					len(beaconState.ValidatorRegistry),
+				)
+			}
+			pubkey = beaconState.ValidatorRegistry[idx].Pubkey
+			withdrawalCredentials = beaconState.ValidatorRegistry[idx].WithdrawalCredentialsHash32
+		}
 		depositInput := &pb.DepositInput{
-			Pubkey:                      []byte(simObjects.simDeposit.Pubkey),
-			WithdrawalCredentialsHash32: make([]byte, 32),
+			Pubkey:                      pubkey,
+			WithdrawalCredentialsHash32: withdrawalCredentials,
 			ProofOfPossession:           make([]byte, 96),
 		}
 
@@ -92,55 +112,400 @@ func generateSimulatedBlock(
 		})
 	}
 	if simObjects.simProposerSlashing != nil {
-		block.Body.ProposerSlashings = append(block.Body.ProposerSlashings, &pb.ProposerSlashing{
-			ProposerIndex: simObjects.simProposerSlashing.ProposerIndex,
-			ProposalData_1: &pb.ProposalSignedData{
-				Slot:            simObjects.simProposerSlashing.Proposal1Slot,
-				Shard:           simObjects.simProposerSlashing.Proposal1Shard,
-				BlockRootHash32: []byte(simObjects.simProposerSlashing.Proposal1Root),
-			},
-			ProposalData_2: &pb.ProposalSignedData{
-				Slot:            simObjects.simProposerSlashing.Proposal2Slot,
-				Shard:           simObjects.simProposerSlashing.Proposal2Shard,
-				BlockRootHash32: []byte(simObjects.simProposerSlashing.Proposal2Root),
-			},
-		})
+		proposerSlashing, err := generateSignedProposerSlashing(beaconState, simObjects.simProposerSlashing, privKeys)
+		if err != nil {
+			return nil, [32]byte{}, fmt.Errorf("could not generate proposer slashing: %v", err)
+		}
+		block.Body.ProposerSlashings = append(block.Body.ProposerSlashings, proposerSlashing)
 	}
 	if simObjects.simAttesterSlashing != nil {
-		block.Body.AttesterSlashings = append(block.Body.AttesterSlashings, &pb.AttesterSlashing{
-			SlashableAttestation_1: &pb.SlashableAttestation{
-				Data: &pb.AttestationData{
-					Slot:           simObjects.simAttesterSlashing.SlashableAttestation1Slot,
-					JustifiedEpoch: simObjects.simAttesterSlashing.SlashableAttestation1JustifiedEpoch,
-				},
-				CustodyBitfield:  []byte(simObjects.simAttesterSlashing.SlashableAttestation1CustodyBitField),
-				ValidatorIndices: simObjects.simAttesterSlashing.SlashableAttestation1ValidatorIndices,
-			},
-			SlashableAttestation_2: &pb.SlashableAttestation{
-				Data: &pb.AttestationData{
-					Slot:           simObjects.simAttesterSlashing.SlashableAttestation2Slot,
-					JustifiedEpoch: simObjects.simAttesterSlashing.SlashableAttestation2JustifiedEpoch,
-				},
-				CustodyBitfield:  []byte(simObjects.simAttesterSlashing.SlashableAttestation2CustodyBitField),
-				ValidatorIndices: simObjects.simAttesterSlashing.SlashableAttestation2ValidatorIndices,
-			},
-		})
+		attesterSlashing, err := generateAttesterSlashing(simObjects.simAttesterSlashing)
+		if err != nil {
+			return nil, [32]byte{}, fmt.Errorf("could not generate attester slashing: %v", err)
+		}
+		block.Body.AttesterSlashings = append(block.Body.AttesterSlashings, attesterSlashing)
 	}
-	if simObjects.simValidatorExit != nil {
-		block.Body.VoluntaryExits = append(block.Body.VoluntaryExits, &pb.VoluntaryExit{
-			Epoch:          simObjects.simValidatorExit.Epoch,
-			ValidatorIndex: simObjects.simValidatorExit.ValidatorIndex,
-		})
+	for _, simExit := range simObjects.simValidatorExits {
+		exit, err := generateSignedValidatorExit(beaconState, simExit, privKeys)
+		if err != nil {
+			return nil, [32]byte{}, fmt.Errorf("could not generate validator exit: %v", err)
+		}
+		block.Body.VoluntaryExits = append(block.Body.VoluntaryExits, exit)
 	}
+	block.Body.Attestations = append(block.Body.Attestations, simObjects.simAttestations...)
+	stateRoot, err := computeStateRoot(beaconState, block, prevBlockRoot, skipEpochProcessing)
+	if err != nil {
+		return nil, [32]byte{}, fmt.Errorf("could not compute state root: %v", err)
+	}
+	block.StateRootHash32 = stateRoot[:]
 	blockRoot, err := hashutil.HashBeaconBlock(block)
 	if err != nil {
 		return nil, [32]byte{}, fmt.Errorf("could not tree hash new block: %v", err)
 	}
+	// The block root ignores the signature, so signing the block last leaves both
+	// the block root and the state root the block commits to unchanged.
+	block.Signature, err = signProposal(beaconState, &pb.ProposalSignedData{
+		Slot:            block.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: blockRoot[:],
+	}, privKeys[proposerIdx])
+	if err != nil {
+		return nil, [32]byte{}, fmt.Errorf("could not sign block: %v", err)
+	}
 	return block, blockRoot, nil
 }
 
+// computeStateRoot runs the state transition for the block on a copy of the beacon state
+// and returns the tree hash root of the resulting state.
+func computeStateRoot(
+	beaconState *pb.BeaconState,
+	block *pb.BeaconBlock,
+	prevBlockRoot [32]byte,
+	skipEpochProcessing bool,
+) ([32]byte, error) {
+	newState := proto.Clone(beaconState).(*pb.BeaconState)
+	setDepositRoot(newState, block)
+	newState, err := executeStateTransition(newState, block, prevBlockRoot, skipEpochProcessing, false /* verifyAttestationSignatures */)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not execute state transition: %v", err)
+	}
+	return postStateRoot(newState, beaconState.LatestBlock)
+}
+
+// setDepositRoot sets the deposit root of the state's latest eth1 data to the one of the block,
+// so the deposits of the block verify without waiting for eth1 data voting. The eth1 block hash
+// is left unchanged, as it is only updated once an eth1 data vote crosses the voting threshold.
+func setDepositRoot(beaconState *pb.BeaconState, block *pb.BeaconBlock) {
+	eth1Data := &pb.Eth1Data{DepositRootHash32: block.Eth1Data.DepositRootHash32}
+	if beaconState.LatestEth1Data != nil {
+		eth1Data.BlockHash32 = beaconState.LatestEth1Data.BlockHash32
+	}
+	beaconState.LatestEth1Data = eth1Data
+}
+
+// executeStateTransition runs the state transition for the block, which may be nil. If epoch
+// processing is skipped, only the slot and block transitions are run, and the resulting state
+// is not the one any other client would compute once an epoch boundary is crossed.
+//
+// The transition itself runs without signature verification. If verifyAttestationSignatures is
+// set, the aggregate signatures of the block's attestations are verified against the state at the
+// slot of the block before the block is processed.
+func executeStateTransition(
+	beaconState *pb.BeaconState,
+	block *pb.BeaconBlock,
+	prevBlockRoot [32]byte,
+	skipEpochProcessing bool,
+	verifyAttestationSignatures bool,
+) (*pb.BeaconState, error) {
+	ctx := context.Background()
+	if !skipEpochProcessing && !verifyAttestationSignatures {
+		return state.ExecuteStateTransition(ctx, beaconState, block, prevBlockRoot, state.DefaultConfig())
+	}
+	beaconState = state.ProcessSlot(ctx, beaconState, prevBlockRoot)
+	if block != nil {
+		if verifyAttestationSignatures {
+			for i, att := range block.Body.Attestations {
+				if err := blocks.VerifyAttestation(beaconState, att, true /* verifySignatures */); err != nil {
+					return nil, fmt.Errorf("could not verify attestation at index %d in block: %v", i, err)
+				}
+			}
+		}
+		var err error
+		beaconState, err = state.ProcessBlock(ctx, beaconState, block, state.DefaultConfig())
+		if err != nil {
+			return nil, fmt.Errorf("could not process block: %v", err)
+		}
+	}
+	if skipEpochProcessing || !e.CanProcessEpoch(beaconState) {
+		return beaconState, nil
+	}
+	beaconState, err := state.ProcessEpoch(ctx, beaconState, block, state.DefaultConfig())
+	if err != nil {
+		return nil, fmt.Errorf("could not process epoch: %v", err)
+	}
+	return beaconState, nil
+}
+
+// postStateRoot tree hashes the state resulting from a block transition. The state's latest
+// block is set to the parent block while hashing, as the block itself commits to the state root.
+func postStateRoot(newState *pb.BeaconState, parentBlock *pb.BeaconBlock) ([32]byte, error) {
+	latestBlock := newState.LatestBlock
+	newState.LatestBlock = parentBlock
+	defer func() {
+		newState.LatestBlock = latestBlock
+	}()
+	root, err := hashutil.HashProto(newState)
+	if err != nil {
+		return [32]byte{}, fmt.Errorf("could not tree hash state: %v", err)
+	}
+	return root, nil
+}
+
+// checkEpochBalanceDirection compares the balances of the validators active in the previous epoch
+// before and after an epoch transition. If none of them attested in the previous epoch their total
+// balance must not increase, and if all of them attested the total balance of those which are not
+// slashed must not decrease. Partial participation can move balances either way and is not checked.
+func checkEpochBalanceDirection(preEpochState *pb.BeaconState, postEpochState *pb.BeaconState) error {
+	prevEpoch := helpers.PrevEpoch(preEpochState)
+	activeIndices := helpers.ActiveValidatorIndices(preEpochState.ValidatorRegistry, prevEpoch)
+	attesters := make(map[uint64]bool)
+	for _, attestation := range preEpochState.LatestAttestations {
+		if helpers.SlotToEpoch(attestation.Data.Slot) != prevEpoch {
+			continue
+		}
+		participants, err := helpers.AttestationParticipants(
+			preEpochState, attestation.Data, attestation.AggregationBitfield)
+		if err != nil {
+			return fmt.Errorf("could not get attestation participants: %v", err)
+		}
+		for _, idx := range participants {
+			attesters[idx] = true
+		}
+	}
+
+	var preBalance, postBalance uint64
+	switch {
+	case len(attesters) == 0:
+		for _, idx := range activeIndices {
+			preBalance += preEpochState.ValidatorBalances[idx]
+			postBalance += postEpochState.ValidatorBalances[idx]
+		}
+		if postBalance > preBalance {
+			return fmt.Errorf(
+				"total balance increased from %d to %d with no attestations in epoch %d",
+				preBalance, postBalance, prevEpoch-params.BeaconConfig().GenesisEpoch,
+			)
+		}
+	case len(attesters) == len(activeIndices):
+		for _, idx := range activeIndices {
+			if preEpochState.ValidatorRegistry[idx].SlashedEpoch != params.BeaconConfig().FarFutureEpoch {
+				continue
+			}
+			preBalance += preEpochState.ValidatorBalances[idx]
+			postBalance += postEpochState.ValidatorBalances[idx]
+		}
+		if postBalance < preBalance {
+			return fmt.Errorf(
+				"total balance decreased from %d to %d with full participation in epoch %d",
+				preBalance, postBalance, prevEpoch-params.BeaconConfig().GenesisEpoch,
+			)
+		}
+	}
+	return nil
+}
+
+// generateSignedProposerSlashing generates a proposer slashing from the simulated proposals, with
+// each proposal signed by the slashed proposer's key so the slashing passes signature verification.
+func generateSignedProposerSlashing(
+	beaconState *pb.BeaconState,
+	simSlashing *StateTestProposerSlashing,
+	privKeys []*bls.SecretKey,
+) (*pb.ProposerSlashing, error) {
+	if simSlashing.ProposerIndex >= uint64(len(privKeys)) {
+		return nil, fmt.Errorf(
+			"no private key for proposer index %d, only %d keys available",
+			simSlashing.ProposerIndex,
+			len(privKeys),
+		)
+	}
+	proposal1 := &pb.ProposalSignedData{
+		Slot:            simSlashing.Proposal1Slot,
+		Shard:           simSlashing.Proposal1Shard,
+		BlockRootHash32: []byte(simSlashing.Proposal1Root),
+	}
+	proposal2 := &pb.ProposalSignedData{
+		Slot:            simSlashing.Proposal2Slot,
+		Shard:           simSlashing.Proposal2Shard,
+		BlockRootHash32: []byte(simSlashing.Proposal2Root),
+	}
+	privKey := privKeys[simSlashing.ProposerIndex]
+	signature1, err := signProposal(beaconState, proposal1, privKey)
+	if err != nil {
+		return nil, err
+	}
+	signature2, err := signProposal(beaconState, proposal2, privKey)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ProposerSlashing{
+		ProposerIndex:       simSlashing.ProposerIndex,
+		ProposalData_1:      proposal1,
+		ProposalSignature_1: signature1,
+		ProposalData_2:      proposal2,
+		ProposalSignature_2: signature2,
+	}, nil
+}
+
+// generateSignedValidatorExit generates a voluntary exit from the simulated exit, signed by the
+// key of the exiting validator over the tree hash root of the exit without its signature.
+func generateSignedValidatorExit(
+	beaconState *pb.BeaconState,
+	simExit *StateTestValidatorExit,
+	privKeys []*bls.SecretKey,
+) (*pb.VoluntaryExit, error) {
+	if simExit.ValidatorIndex >= uint64(len(privKeys)) {
+		return nil, fmt.Errorf(
+			"no private key for validator index %d, only %d keys available",
+			simExit.ValidatorIndex,
+			len(privKeys),
+		)
+	}
+	exit := &pb.VoluntaryExit{
+		Epoch:          simExit.Epoch,
+		ValidatorIndex: simExit.ValidatorIndex,
+	}
+	exitRoot, err := hashutil.HashProto(exit)
+	if err != nil {
+		return nil, fmt.Errorf("could not tree hash validator exit: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, exit.Epoch, params.BeaconConfig().DomainExit)
+	exit.Signature = privKeys[simExit.ValidatorIndex].Sign(exitRoot[:], domain).Marshal()
+	return exit, nil
+}
+
+// generateSignedAttestation generates an attestation for the current slot of the beacon state in
+// which every member of the crosslink committee at the committee index participates. Each
+// participant signs the attestation data with a zero custody bit and the signatures are aggregated.
+func generateSignedAttestation(
+	beaconState *pb.BeaconState,
+	latestBlockRoot [32]byte,
+	committeeIndex uint64,
+	privKeys []*bls.SecretKey,
+) (*pb.Attestation, error) {
+	slot := beaconState.Slot
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+	if err != nil {
+		return nil, fmt.Errorf("could not get crosslink committees: %v", err)
+	}
+	if committeeIndex >= uint64(len(committees)) {
+		return nil, fmt.Errorf(
+			"committee index %d outside of the %d committees at slot %d",
+			committeeIndex,
+			len(committees),
+			slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	committee := committees[committeeIndex]
+	epochBoundaryRoot := latestBlockRoot[:]
+	if epochStart := helpers.StartSlot(helpers.SlotToEpoch(slot)); epochStart < slot {
+		epochBoundaryRoot, err = blocks.BlockRoot(beaconState, epochStart)
+		if err != nil {
+			return nil, fmt.Errorf("could not get epoch boundary root: %v", err)
+		}
+	}
+	data := &pb.AttestationData{
+		Slot:                     slot,
+		Shard:                    committee.Shard,
+		BeaconBlockRootHash32:    latestBlockRoot[:],
+		EpochBoundaryRootHash32:  epochBoundaryRoot,
+		CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
+		LatestCrosslink:          beaconState.LatestCrosslinks[committee.Shard],
+		JustifiedEpoch:           beaconState.JustifiedEpoch,
+		JustifiedBlockRootHash32: beaconState.JustifiedRoot,
+	}
+	bitfieldLength := bitutil.BitLength(len(committee.Committee))
+	aggregationBitfield := make([]byte, bitfieldLength)
+	for i := range committee.Committee {
+		aggregationBitfield[i/8] |= 1 << uint(7-i%8)
+	}
+	messageRoot, err := hashutil.HashProto(&pb.AttestationDataAndCustodyBit{
+		Data:       data,
+		CustodyBit: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not tree hash attestation data: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(slot), params.BeaconConfig().DomainAttestation)
+	sigs := make([]*bls.Signature, len(committee.Committee))
+	for i, idx := range committee.Committee {
+		if idx >= uint64(len(privKeys)) {
+			return nil, fmt.Errorf(
+				"no private key for validator index %d, only %d keys available",
+				idx,
+				len(privKeys),
+			)
+		}
+		sigs[i] = privKeys[idx].Sign(messageRoot[:], domain)
+	}
+	return &pb.Attestation{
+		Data:                data,
+		AggregationBitfield: aggregationBitfield,
+		CustodyBitfield:     make([]byte, bitfieldLength),
+		AggregateSignature:  bls.AggregateSignatures(sigs).Marshal(),
+	}, nil
+}
+
+// generateAttesterSlashing builds an attester slashing from the test configuration. The two
+// slashable attestations are ordered so that, for a surround vote, the first attestation
+// surrounds the second as expected by the state transition.
+func generateAttesterSlashing(simSlashing *StateTestAttesterSlashing) (*pb.AttesterSlashing, error) {
+	slashableAttestation1 := &pb.SlashableAttestation{
+		Data: &pb.AttestationData{
+			Slot:           simSlashing.SlashableAttestation1Slot,
+			JustifiedEpoch: simSlashing.SlashableAttestation1JustifiedEpoch,
+		},
+		CustodyBitfield:  []byte(simSlashing.SlashableAttestation1CustodyBitField),
+		ValidatorIndices: simSlashing.SlashableAttestation1ValidatorIndices,
+	}
+	if simSlashing.SlashableAttestation1TargetEpoch != 0 {
+		slashableAttestation1.Data.Slot = helpers.StartSlot(simSlashing.SlashableAttestation1TargetEpoch)
+	}
+	slashableAttestation2 := &pb.SlashableAttestation{
+		Data: &pb.AttestationData{
+			Slot:           simSlashing.SlashableAttestation2Slot,
+			JustifiedEpoch: simSlashing.SlashableAttestation2JustifiedEpoch,
+		},
+		CustodyBitfield:  []byte(simSlashing.SlashableAttestation2CustodyBitField),
+		ValidatorIndices: simSlashing.SlashableAttestation2ValidatorIndices,
+	}
+	if simSlashing.SlashableAttestation2TargetEpoch != 0 {
+		slashableAttestation2.Data.Slot = helpers.StartSlot(simSlashing.SlashableAttestation2TargetEpoch)
+	}
+	data1 := slashableAttestation1.Data
+	data2 := slashableAttestation2.Data
+	isDoubleVote := helpers.SlotToEpoch(data1.Slot) == helpers.SlotToEpoch(data2.Slot)
+	if !isDoubleVote && !blocks.IsSurroundVote(data1, data2) {
+		if !blocks.IsSurroundVote(data2, data1) {
+			return nil, fmt.Errorf(
+				"attestations with source epochs %d, %d and target epochs %d, %d are neither a double vote nor surround vote",
+				data1.JustifiedEpoch,
+				data2.JustifiedEpoch,
+				helpers.SlotToEpoch(data1.Slot),
+				helpers.SlotToEpoch(data2.Slot),
+			)
+		}
+		slashableAttestation1, slashableAttestation2 = slashableAttestation2, slashableAttestation1
+	}
+	return &pb.AttesterSlashing{
+		SlashableAttestation_1: slashableAttestation1,
+		SlashableAttestation_2: slashableAttestation2,
+	}, nil
+}
+
+// randaoReveal signs the epoch of the given slot using the randao domain, as revealed by the
+// proposer of a block at the slot.
+func randaoReveal(beaconState *pb.BeaconState, slot uint64, privKey *bls.SecretKey) []byte {
+	epoch := helpers.SlotToEpoch(slot)
+	buf := make([]byte, 32)
+	binary.LittleEndian.PutUint64(buf, epoch)
+	domain := forkutil.DomainVersion(beaconState.Fork, epoch, params.BeaconConfig().DomainRandao)
+	return privKey.Sign(buf, domain).Marshal()
+}
+
+// signProposal signs the tree hash root of the proposal data using the proposal domain
+// of the epoch the proposal slot is in.
+func signProposal(beaconState *pb.BeaconState, proposal *pb.ProposalSignedData, privKey *bls.SecretKey) ([]byte, error) {
+	proposalRoot, err := hashutil.HashProto(proposal)
+	if err != nil {
+		return nil, fmt.Errorf("could not tree hash proposal data: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(proposal.Slot), params.BeaconConfig().DomainProposal)
+	return privKey.Sign(proposalRoot[:], domain).Marshal(), nil
+}
+
 // generateInitialSimulatedDeposits generates initial deposits for creating a beacon state in the simulated
-// backend based on the yaml configuration.
+// backend based on the yaml configuration. Each deposit input carries a proof of possession signed by
+// the deposit's private key.
 func generateInitialSimulatedDeposits(numDeposits uint64) ([]*pb.Deposit, []*bls.SecretKey, error) {
 	genesisTime := time.Date(2018, 9, 0, 0, 0, 0, 0, time.UTC).Unix()
 	deposits := make([]*pb.Deposit, numDeposits)
@@ -153,8 +518,12 @@ func generateInitialSimulatedDeposits(numDeposits uint64) ([]*pb.Deposit, []*bls
 		depositInput := &pb.DepositInput{
 			Pubkey:                      priv.PublicKey().Marshal(),
 			WithdrawalCredentialsHash32: make([]byte, 32),
-			ProofOfPossession:           make([]byte, 96),
 		}
+		signingData, err := proofOfPossessionData(depositInput)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not serialize deposit input: %v", err)
+		}
+		depositInput.ProofOfPossession = priv.Sign(signingData, params.BeaconConfig().DomainDeposit).Marshal()
 		depositData, err := helpers.EncodeDepositData(
 			depositInput,
 			params.BeaconConfig().MaxDepositAmount,
@@ -168,3 +537,83 @@ func generateInitialSimulatedDeposits(numDeposits uint64) ([]*pb.Deposit, []*bls
 	}
 	return deposits, privKeys, nil
 }
+
+// depositSignature holds the proof of possession of a deposit along with the pubkey
+// and data it is verified against.
+type depositSignature struct {
+	merkleTreeIndex uint64
+	pubKey          *bls.PublicKey
+	sig             *bls.Signature
+	data            []byte
+}
+
+// verifySimulatedDeposits checks the proof of possession of every deposit is a valid BLS signature
+// by the deposit's pubkey over its pubkey and withdrawal credentials.
+func verifySimulatedDeposits(deposits []*pb.Deposit) error {
+	sigs := make([]*depositSignature, len(deposits))
+	for i, deposit := range deposits {
+		depositInput, err := helpers.DecodeDepositInput(deposit.DepositData)
+		if err != nil {
+			return fmt.Errorf("could not decode deposit input of deposit %d: %v", deposit.MerkleTreeIndex, err)
+		}
+		pubKey, err := bls.PublicKeyFromBytes(depositInput.Pubkey)
+		if err != nil {
+			return fmt.Errorf("could not deserialize pubkey of deposit %d: %v", deposit.MerkleTreeIndex, err)
+		}
+		sig, err := bls.SignatureFromBytes(depositInput.ProofOfPossession)
+		if err != nil {
+			return fmt.Errorf("could not deserialize proof of possession of deposit %d: %v", deposit.MerkleTreeIndex, err)
+		}
+		signingData, err := proofOfPossessionData(depositInput)
+		if err != nil {
+			return fmt.Errorf("could not serialize deposit input of deposit %d: %v", deposit.MerkleTreeIndex, err)
+		}
+		sigs[i] = &depositSignature{
+			merkleTreeIndex: deposit.MerkleTreeIndex,
+			pubKey:          pubKey,
+			sig:             sig,
+			data:            signingData,
+		}
+	}
+	return verifyDepositSignatures(sigs)
+}
+
+// verifyDepositSignatures verifies the proofs of possession of a set of deposits with a single
+// check of their aggregated signature. Only if the aggregate fails to verify, every proof is
+// verified on its own to identify the first deposit with an invalid proof of possession.
+func verifyDepositSignatures(sigs []*depositSignature) error {
+	if len(sigs) == 0 {
+		return nil
+	}
+	pubKeys := make([]*bls.PublicKey, len(sigs))
+	blsSigs := make([]*bls.Signature, len(sigs))
+	msgs := make([][]byte, len(sigs))
+	for i, s := range sigs {
+		pubKeys[i] = s.pubKey
+		blsSigs[i] = s.sig
+		msgs[i] = s.data
+	}
+	domain := params.BeaconConfig().DomainDeposit
+	if bls.AggregateSignatures(blsSigs).VerifyAggregateMessages(pubKeys, msgs, domain) {
+		return nil
+	}
+	for _, s := range sigs {
+		if !s.sig.Verify(s.data, s.pubKey, domain) {
+			return fmt.Errorf("proof of possession of deposit %d did not verify", s.merkleTreeIndex)
+		}
+	}
+	return errors.New("aggregated proof of possession of deposits did not verify")
+}
+
+// proofOfPossessionData returns the data signed by the proof of possession of a deposit input,
+// which is the serialized deposit input without its proof of possession, as done by the keystore.
+func proofOfPossessionData(depositInput *pb.DepositInput) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := ssz.Encode(buf, &pb.DepositInput{
+		Pubkey:                      depositInput.Pubkey,
+		WithdrawalCredentialsHash32: depositInput.WithdrawalCredentialsHash32,
+	}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -15,4 +15,7 @@ type ShuffleTestCase struct {
 	Input  []uint64 `yaml:"input,flow"`
 	Output []uint64 `yaml:"output,flow"`
 	Seed   string
+	// VerifyPermutation additionally checks the shuffled list is a permutation of the input,
+	// which catches elements being dropped or duplicated regardless of the expected output.
+	VerifyPermutation bool `yaml:"verify_permutation"`
 }
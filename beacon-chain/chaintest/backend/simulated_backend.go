@@ -4,25 +4,55 @@
 package backend
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	log "github.com/sirupsen/logrus"
 )
 
+const (
+	// maxStateHashAttempts is the number of times hashing the genesis state is
+	// attempted before the backend setup fails.
+	maxStateHashAttempts = 3
+	// stateHashRetryBackoff is the initial delay between state hashing attempts,
+	// doubled after every failed attempt.
+	stateHashRetryBackoff = 50 * time.Millisecond
+)
+
+// hashProto is used to tree hash beacon states, and can be replaced in tests.
+var hashProto = hashutil.HashProto
+
+// errEpochProcessingSkipped is returned by state transition tests run on a backend which skips
+// epoch processing, as their results cannot be compared against the expected consensus outcome.
+var errEpochProcessingSkipped = errors.New("cannot check state transition results while epoch processing is skipped")
+
+// errAttestationSignaturesSkipped is returned by state transition tests run on a backend which
+// skips the verification of attestation signatures, as the results do not prove the signatures valid.
+var errAttestationSignaturesSkipped = errors.New("cannot check state transition results while attestation signatures are not verified")
+
+// shuffleIndices is used to shuffle the input of shuffle tests, and can be replaced in tests.
+var shuffleIndices = utils.ShuffleIndices
+
 // SimulatedBackend allowing for a programmatic advancement
 // of an in-memory beacon chain for client test runs
 // and other e2e use cases.
@@ -33,6 +63,58 @@ type SimulatedBackend struct {
 	prevBlockRoots     [][32]byte
 	inMemoryBlocks     []*pb.BeaconBlock
 	historicalDeposits []*pb.Deposit
+	genesis            *genesisInputs
+	topUps             []*topUpBalance
+	attestations       []*simulatedAttestation
+	// eth1VotesCast counts the blocks which voted for the eth1 data of the test case, and
+	// eth1VoteResult holds the state's eth1 data right after its voting period ended.
+	eth1VotesCast  uint64
+	eth1VoteResult *eth1VoteResult
+	// relativeGenesis is set when the genesis time is computed from the wall-clock
+	// time of setup plus genesisDelay rather than the fixed simulated genesis time.
+	relativeGenesis bool
+	genesisDelay    time.Duration
+	// skipEpochProcessing is only meant for benchmarks, see SkipEpochProcessing.
+	skipEpochProcessing bool
+	// skipAttestationSignatures is only meant for benchmarks, see SkipAttestationSignatures.
+	skipAttestationSignatures bool
+}
+
+// genesisInputs holds what the genesis state of the last setup was derived from, so the backend
+// can be reset to the same genesis.
+type genesisInputs struct {
+	// deposits are the deposits the genesis state is derived from, while initialDeposits are the
+	// same deposits along with their Merkle proofs, which later deposits are built upon.
+	deposits        []*pb.Deposit
+	initialDeposits []*pb.Deposit
+	genesisTime     uint64
+	eth1Data        *pb.Eth1Data
+}
+
+// topUpBalance records the balance of a validator right before and right after
+// the block including a top up deposit for it was processed.
+type topUpBalance struct {
+	validatorIndex uint64
+	amount         uint64
+	preBalance     uint64
+	postBalance    uint64
+}
+
+// simulatedAttestation is an attestation scheduled by a state test, held by the backend from
+// its slot until it is included in a block.
+type simulatedAttestation struct {
+	config        *StateTestAttestation
+	attestation   *pb.Attestation
+	inclusionSlot uint64
+	// includedSlot is the slot of the block which included the attestation, 0 until it is included.
+	includedSlot uint64
+}
+
+// eth1VoteResult records the eth1 block hash of the state and its number of eth1 data votes
+// right after the epoch transition ending an eth1 data voting period.
+type eth1VoteResult struct {
+	latestBlockHash []byte
+	pendingVotes    int
 }
 
 // SimulatedObjects is a container to hold the
@@ -42,14 +124,27 @@ type SimulatedObjects struct {
 	simDeposit          *StateTestDeposit
 	simProposerSlashing *StateTestProposerSlashing
 	simAttesterSlashing *StateTestAttesterSlashing
-	simValidatorExit    *StateTestValidatorExit
+	simValidatorExits   []*StateTestValidatorExit
+	simAttestations     []*pb.Attestation
+	// eth1BlockHash, if set, is the eth1 block hash the block votes for. It is kept
+	// for blocks with an empty body, as the eth1 data vote is not an operation.
+	eth1BlockHash []byte
+	// emptyBody forces the generated block to contain no operations, ignoring
+	// any of the simulated objects above.
+	emptyBody bool
 }
 
 // NewSimulatedBackend creates an instance by initializing a chain service
 // utilizing a mockDB which will act according to test run parameters specified
 // in the common ETH 2.0 client test YAML format.
 func NewSimulatedBackend() (*SimulatedBackend, error) {
-	db, err := db.SetupDB()
+	return NewSimulatedBackendWithStorage(db.DiskStorage)
+}
+
+// NewSimulatedBackendWithStorage creates an instance like NewSimulatedBackend, keeping its
+// database in the given storage kind. Benchmarks should use db.MemoryStorage to avoid disk I/O.
+func NewSimulatedBackendWithStorage(storage db.Storage) (*SimulatedBackend, error) {
+	db, err := db.SetupDBWithStorage(storage)
 	if err != nil {
 		return nil, fmt.Errorf("could not setup simulated backend db: %v", err)
 	}
@@ -67,19 +162,75 @@ func NewSimulatedBackend() (*SimulatedBackend, error) {
 	}, nil
 }
 
-// SetupBackend sets up the simulated backend with simulated deposits, and initializes the
-// state and genesis block.
+// SetupBackend sets up the simulated backend with simulated deposits, verifies their proofs of
+// possession, and initializes the state and genesis block.
 func (sb *SimulatedBackend) SetupBackend(numOfDeposits uint64) ([]*bls.SecretKey, error) {
 	initialDeposits, privKeys, err := generateInitialSimulatedDeposits(numOfDeposits)
 	if err != nil {
 		return nil, fmt.Errorf("could not simulate initial validator deposits: %v", err)
 	}
+	if err := verifySimulatedDeposits(initialDeposits); err != nil {
+		return nil, fmt.Errorf("could not verify initial validator deposits: %v", err)
+	}
 	if err := sb.setupBeaconStateAndGenesisBlock(initialDeposits); err != nil {
 		return nil, fmt.Errorf("could not set up beacon state and initialize genesis block %v", err)
 	}
 	return privKeys, nil
 }
 
+// SetupBackendFromChainStart sets up the simulated backend with simulated deposits, but instead of
+// constructing the genesis state directly, it submits the deposits to a simulated powchain and
+// initializes the state and genesis block once the ChainStart event has been fired.
+func (sb *SimulatedBackend) SetupBackendFromChainStart(numOfDeposits uint64) ([]*bls.SecretKey, error) {
+	initialDeposits, privKeys, err := generateInitialSimulatedDeposits(numOfDeposits)
+	if err != nil {
+		return nil, fmt.Errorf("could not simulate initial validator deposits: %v", err)
+	}
+	if err := verifySimulatedDeposits(initialDeposits); err != nil {
+		return nil, fmt.Errorf("could not verify initial validator deposits: %v", err)
+	}
+	if err := sb.setupBeaconStateFromChainStart(initialDeposits); err != nil {
+		return nil, fmt.Errorf("could not set up beacon state and initialize genesis block from chain start %v", err)
+	}
+	return privKeys, nil
+}
+
+// SetGenesisDelay makes the backend start its chain at the wall-clock time of the next setup plus
+// the given delay, instead of the fixed simulated genesis time, so genesis can be placed in the
+// future to exercise pre-genesis behavior. Blocks are still generated slot by slot from the
+// genesis slot regardless of the wall clock, so only logic which derives the current slot from
+// the genesis time observes the delay. Such logic computes the slot as the seconds elapsed since
+// genesis divided by SecondsPerSlot, and the slot ticker does not fire until genesis is reached.
+// The genesis time is truncated to whole seconds, as it is stored in the state as a unix timestamp.
+func (sb *SimulatedBackend) SetGenesisDelay(delay time.Duration) {
+	sb.relativeGenesis = true
+	sb.genesisDelay = delay
+}
+
+// SkipEpochProcessing makes the backend advance the chain without running the epoch processing
+// of the state transition, so benchmarks can measure the cost of per slot and per block processing
+// on its own. This is UNSAFE for anything but benchmarking: once an epoch boundary is crossed the
+// states and blocks of the backend are no longer consensus valid, so state transition tests refuse
+// to run while it is set.
+func (sb *SimulatedBackend) SkipEpochProcessing(skip bool) {
+	sb.skipEpochProcessing = skip
+}
+
+// SkipAttestationSignatures makes the backend apply blocks without verifying the BLS aggregate
+// signatures of their attestations, so benchmarks can measure the cost of the rest of the state
+// transition on its own. Attestation signatures are verified by default. This is UNSAFE for
+// anything but benchmarking: a block with forged attestations is applied as if it were valid, so
+// state transition tests refuse to run while it is set.
+//
+// Verifying an attestation aggregates the public keys of its participants and runs a pairing
+// check of its aggregate signature, which outweighs the rest of its processing as the latter only
+// reads the state. BenchmarkGenerateBlockAndAdvanceChain_AttestationSignatures runs the same chain
+// in both modes, so the difference between its verify and skip runs is the cost of verifying the
+// signature of one attestation per block.
+func (sb *SimulatedBackend) SkipAttestationSignatures(skip bool) {
+	sb.skipAttestationSignatures = skip
+}
+
 // DB returns the underlying db instance in the simulated
 // backend.
 func (sb *SimulatedBackend) DB() *db.BeaconDB {
@@ -89,6 +240,11 @@ func (sb *SimulatedBackend) DB() *db.BeaconDB {
 // GenerateBlockAndAdvanceChain generates a simulated block and runs that block though
 // state transition.
 func (sb *SimulatedBackend) GenerateBlockAndAdvanceChain(objects *SimulatedObjects, privKeys []*bls.SecretKey) error {
+	// Every advancement appends to both slices, so checking the latest block is
+	// enough to catch any operation that let them diverge.
+	if err := sb.checkBlockRoots(len(sb.inMemoryBlocks) - 1); err != nil {
+		return fmt.Errorf("inconsistent simulated chain: %v", err)
+	}
 	prevBlockRoot := sb.prevBlockRoots[len(sb.prevBlockRoots)-1]
 	// We generate a new block to pass into the state transition.
 	newBlock, newBlockRoot, err := generateSimulatedBlock(
@@ -97,22 +253,107 @@ func (sb *SimulatedBackend) GenerateBlockAndAdvanceChain(objects *SimulatedObjec
 		sb.historicalDeposits,
 		objects,
 		privKeys,
+		sb.skipEpochProcessing,
 	)
 	if err != nil {
 		return fmt.Errorf("could not generate simulated beacon block %v", err)
 	}
-	newState := sb.state
-	newState.LatestEth1Data = newBlock.Eth1Data
-	newState, err = state.ExecuteStateTransition(
-		context.Background(),
-		sb.state,
+	return sb.advanceChain(newBlock, newBlockRoot, prevBlockRoot)
+}
+
+// ApplyBlock runs an externally built block, such as one read from a captured chain, through
+// the state transition and advances the chain with it. The block must be at the slot following
+// the current state and build upon the latest block, and the state root it commits to must match
+// the state resulting from its transition. The backend is left unchanged if the block is rejected.
+func (sb *SimulatedBackend) ApplyBlock(block *pb.BeaconBlock) error {
+	if err := sb.checkBlockRoots(len(sb.inMemoryBlocks) - 1); err != nil {
+		return fmt.Errorf("inconsistent simulated chain: %v", err)
+	}
+	if block == nil || block.Body == nil {
+		return errors.New("cannot apply a block without a body")
+	}
+	if block.Slot != sb.state.Slot+1 {
+		return fmt.Errorf(
+			"expected block at slot %d, received slot %d",
+			sb.state.Slot+1-params.BeaconConfig().GenesisSlot,
+			block.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	prevBlockRoot := sb.prevBlockRoots[len(sb.prevBlockRoots)-1]
+	if !bytes.Equal(block.ParentRootHash32, prevBlockRoot[:]) {
+		return fmt.Errorf(
+			"parent root %#x does not match the root of the latest block %#x",
+			block.ParentRootHash32,
+			prevBlockRoot,
+		)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not tree hash block: %v", err)
+	}
+	return sb.advanceChain(block, blockRoot, prevBlockRoot)
+}
+
+// checkBlockRoots verifies the in memory blocks and their tracked roots have the same
+// length, and that every root from the given index onwards is the root of its block.
+func (sb *SimulatedBackend) checkBlockRoots(from int) error {
+	if len(sb.inMemoryBlocks) != len(sb.prevBlockRoots) {
+		return fmt.Errorf(
+			"tracking %d in memory blocks but %d block roots",
+			len(sb.inMemoryBlocks),
+			len(sb.prevBlockRoots),
+		)
+	}
+	if from < 0 {
+		from = 0
+	}
+	for i := from; i < len(sb.inMemoryBlocks); i++ {
+		root, err := hashutil.HashBeaconBlock(sb.inMemoryBlocks[i])
+		if err != nil {
+			return fmt.Errorf("could not hash block %d: %v", i, err)
+		}
+		if root != sb.prevBlockRoots[i] {
+			return fmt.Errorf(
+				"root of block at slot %d does not match its tracked root: %#x != %#x",
+				sb.inMemoryBlocks[i].Slot-params.BeaconConfig().GenesisSlot,
+				root,
+				sb.prevBlockRoots[i],
+			)
+		}
+	}
+	return nil
+}
+
+// advanceChain runs the state transition for the given block and, once the resulting
+// state is verified to match the block's state root, appends the block to the chain.
+func (sb *SimulatedBackend) advanceChain(newBlock *pb.BeaconBlock, newBlockRoot [32]byte, prevBlockRoot [32]byte) error {
+	parentBlock := sb.state.LatestBlock
+	// The state transition modifies the state in place, so it runs on a copy which only
+	// replaces the state of the backend once the block is verified.
+	newState := proto.Clone(sb.state).(*pb.BeaconState)
+	setDepositRoot(newState, newBlock)
+	newState, err := executeStateTransition(
+		newState,
 		newBlock,
 		prevBlockRoot,
-		state.DefaultConfig(),
+		sb.skipEpochProcessing,
+		!sb.skipAttestationSignatures,
 	)
 	if err != nil {
 		return fmt.Errorf("could not execute state transition: %v", err)
 	}
+	stateRoot, err := postStateRoot(newState, parentBlock)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(newBlock.StateRootHash32, stateRoot[:]) {
+		return fmt.Errorf(
+			"state root of block at slot %d does not match the state after transition: %#x != %#x",
+			newBlock.Slot-params.BeaconConfig().GenesisSlot,
+			newBlock.StateRootHash32,
+			stateRoot,
+		)
+	}
 
 	sb.state = newState
 	sb.prevBlockRoots = append(sb.prevBlockRoots, newBlockRoot)
@@ -124,16 +365,102 @@ func (sb *SimulatedBackend) GenerateBlockAndAdvanceChain(objects *SimulatedObjec
 	return nil
 }
 
+// BlockValidityReport details the outcome of every check VerifyBlock runs against a block. The error
+// of each check is nil if the block passed it.
+type BlockValidityReport struct {
+	SlotErr              error
+	ParentRootErr        error
+	RandaoRevealErr      error
+	ProposerSignatureErr error
+	TransitionErr        error
+	// StateRootErr is only checked if the state transition succeeded.
+	StateRootErr error
+}
+
+// Valid reports whether the block passed every check.
+func (r *BlockValidityReport) Valid() bool {
+	return r.Err() == nil
+}
+
+// Err returns the error of the first check the block failed, or nil if the block is valid.
+func (r *BlockValidityReport) Err() error {
+	for _, err := range []error{
+		r.SlotErr,
+		r.ParentRootErr,
+		r.RandaoRevealErr,
+		r.ProposerSignatureErr,
+		r.TransitionErr,
+		r.StateRootErr,
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VerifyBlock runs the checks a block must pass to advance the chain of the backend against its
+// current state, without advancing the chain. The block must be for the next slot, point to the
+// latest block, carry the randao reveal and proposal signature of the proposer's key and commit
+// to the state root resulting from its state transition. The transition runs on a copy of the
+// state, leaving the state of the backend untouched.
+func (sb *SimulatedBackend) VerifyBlock(block *pb.BeaconBlock, privKeys []*bls.SecretKey) (*BlockValidityReport, error) {
+	report := &BlockValidityReport{}
+	if block.Slot != sb.state.Slot+1 {
+		report.SlotErr = fmt.Errorf(
+			"expected block at slot %d, received slot %d",
+			sb.state.Slot+1-params.BeaconConfig().GenesisSlot,
+			block.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	prevBlockRoot := sb.prevBlockRoots[len(sb.prevBlockRoots)-1]
+	if !bytes.Equal(block.ParentRootHash32, prevBlockRoot[:]) {
+		report.ParentRootErr = fmt.Errorf(
+			"parent root %#x does not match the root of the latest block %#x",
+			block.ParentRootHash32,
+			prevBlockRoot,
+		)
+	}
+
+	// The proposer is selected from the state as of the block slot.
+	slotState := proto.Clone(sb.state).(*pb.BeaconState)
+	slotState.Slot = block.Slot
+	proposerIdx, err := helpers.BeaconProposerIndex(slotState, block.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposer index: %v", err)
+	}
+	if proposerIdx >= uint64(len(privKeys)) {
+		report.RandaoRevealErr = fmt.Errorf(
+			"no private key for proposer index %d, only %d keys available",
+			proposerIdx,
+			len(privKeys),
+		)
+	} else if reveal := randaoReveal(slotState, block.Slot, privKeys[proposerIdx]); !bytes.Equal(block.RandaoReveal, reveal) {
+		report.RandaoRevealErr = fmt.Errorf("randao reveal was not signed by proposer %d", proposerIdx)
+	}
+	if err := b.VerifyProposerSignature(slotState, block); err != nil {
+		report.ProposerSignatureErr = err
+	}
+
+	stateRoot, err := computeStateRoot(sb.state, block, prevBlockRoot, sb.skipEpochProcessing)
+	if err != nil {
+		report.TransitionErr = err
+		return report, nil
+	}
+	if !bytes.Equal(block.StateRootHash32, stateRoot[:]) {
+		report.StateRootErr = fmt.Errorf(
+			"state root %#x does not match the state after transition %#x",
+			block.StateRootHash32,
+			stateRoot,
+		)
+	}
+	return report, nil
+}
+
 // GenerateNilBlockAndAdvanceChain would trigger a state transition with a nil block.
 func (sb *SimulatedBackend) GenerateNilBlockAndAdvanceChain() error {
 	prevBlockRoot := sb.prevBlockRoots[len(sb.prevBlockRoots)-1]
-	newState, err := state.ExecuteStateTransition(
-		context.Background(),
-		sb.state,
-		nil,
-		prevBlockRoot,
-		state.DefaultConfig(),
-	)
+	newState, err := executeStateTransition(sb.state, nil, prevBlockRoot, sb.skipEpochProcessing, false /* verifyAttestationSignatures */)
 	if err != nil {
 		return fmt.Errorf("could not execute state transition: %v", err)
 	}
@@ -146,6 +473,23 @@ func (sb *SimulatedBackend) Shutdown() error {
 	return sb.beaconDB.Close()
 }
 
+// ResetToGenesis restores the state, blocks and deposits of the backend to the genesis of its
+// last setup, which is derived again from the same initial deposits, so the backend can be reused
+// for another run without the cost of a new setup. The contents of the database are left as is.
+func (sb *SimulatedBackend) ResetToGenesis() error {
+	if sb.genesis == nil {
+		return errors.New("backend was not set up")
+	}
+	var err error
+	sb.state, err = state.GenesisBeaconState(sb.genesis.deposits, sb.genesis.genesisTime, sb.genesis.eth1Data)
+	if err != nil {
+		return fmt.Errorf("could not initialize simulated beacon state: %v", err)
+	}
+	sb.historicalDeposits = append([]*pb.Deposit{}, sb.genesis.initialDeposits...)
+	sb.inMemoryBlocks = make([]*pb.BeaconBlock, 0)
+	return sb.setupGenesisBlock()
+}
+
 // State is a getter to return the current beacon state
 // of the backend.
 func (sb *SimulatedBackend) State() *pb.BeaconState {
@@ -163,6 +507,10 @@ func (sb *SimulatedBackend) InMemoryBlocks() []*pb.BeaconBlock {
 // against the simulated backend.
 func (sb *SimulatedBackend) RunForkChoiceTest(testCase *ForkChoiceTestCase) error {
 	defer db.TeardownDB(sb.beaconDB)
+	defer params.RestoreConfig(params.SnapshotConfig())
+	if err := validateForkChoiceConfig(testCase.Config); err != nil {
+		return fmt.Errorf("invalid fork choice test config: %v", err)
+	}
 	// Utilize the config parameters in the test case to setup
 	// the DB and set global config parameters accordingly.
 	// Config parameters include: ValidatorCount, ShardCount,
@@ -190,59 +538,181 @@ func (sb *SimulatedBackend) RunForkChoiceTest(testCase *ForkChoiceTestCase) erro
 	return nil
 }
 
+// validateForkChoiceConfig checks the validators of a fork choice test are enough to fill
+// at least one committee of the minimum committee size, as smaller validator sets can only
+// be shuffled into degenerate committees.
+func validateForkChoiceConfig(config *ForkChoiceTestConfig) error {
+	if config.MinCommitteeSize == 0 {
+		return errors.New("min committee size must be greater than zero")
+	}
+	if config.ValidatorCount < config.MinCommitteeSize {
+		return fmt.Errorf(
+			"%d validators cannot fill a committee of the min committee size %d",
+			config.ValidatorCount,
+			config.MinCommitteeSize,
+		)
+	}
+	return nil
+}
+
 // RunShuffleTest uses validator set specified from a YAML file, runs the validator shuffle
 // algorithm, then compare the output with the expected output from the YAML file.
 func (sb *SimulatedBackend) RunShuffleTest(testCase *ShuffleTestCase) error {
 	defer db.TeardownDB(sb.beaconDB)
+	defer params.RestoreConfig(params.SnapshotConfig())
 	seed := common.BytesToHash([]byte(testCase.Seed))
-	output, err := utils.ShuffleIndices(seed, testCase.Input)
+	output, err := shuffleIndices(seed, testCase.Input)
 	if err != nil {
 		return err
 	}
+	if testCase.VerifyPermutation {
+		if err := verifyPermutation(testCase.Input, output); err != nil {
+			return fmt.Errorf("shuffle result is not a permutation of the input: %v", err)
+		}
+	}
 	if !reflect.DeepEqual(output, testCase.Output) {
 		return fmt.Errorf("shuffle result error: expected %v, actual %v", testCase.Output, output)
 	}
 	return nil
 }
 
+// verifyPermutation checks the output contains every element of the input exactly as many
+// times as the input does.
+func verifyPermutation(input []uint64, output []uint64) error {
+	counts := make(map[uint64]int, len(input))
+	for _, v := range input {
+		counts[v]++
+	}
+	for i, v := range output {
+		if counts[v] == 0 {
+			return fmt.Errorf("element %d at output index %d is duplicated or not part of the input", v, i)
+		}
+		counts[v]--
+	}
+	for _, v := range input {
+		if counts[v] > 0 {
+			return fmt.Errorf("element %d of the input is missing from the output", v)
+		}
+	}
+	return nil
+}
+
 // RunStateTransitionTest advances a beacon chain state transition an N amount of
 // slots from a genesis state, with a block being processed at every iteration
 // of the state transition function.
 func (sb *SimulatedBackend) RunStateTransitionTest(testCase *StateTestCase) error {
+	restoreConfig := setTestConfig(testCase)
+	defer restoreConfig()
+	return sb.runStateTransitionTest(testCase)
+}
+
+// runStateTransitionTest runs the state test case against the beacon config currently in
+// use, leaving overriding the config with the test case options to the caller.
+func (sb *SimulatedBackend) runStateTransitionTest(testCase *StateTestCase) error {
 	defer db.TeardownDB(sb.beaconDB)
-	setTestConfig(testCase)
+	if sb.skipEpochProcessing {
+		return errEpochProcessingSkipped
+	}
+	if sb.skipAttestationSignatures {
+		return errAttestationSignaturesSkipped
+	}
 
 	privKeys, err := sb.initializeStateTest(testCase)
 	if err != nil {
 		return fmt.Errorf("could not initialize state test %v", err)
 	}
 	averageTimesPerTransition := []time.Duration{}
+	skippedSlots := 0
 	startSlot := params.BeaconConfig().GenesisSlot
 	for i := startSlot; i < startSlot+testCase.Config.NumSlots; i++ {
-
+		if err := sb.createScheduledAttestations(testCase, privKeys); err != nil {
+			return fmt.Errorf("could not create attestations at slot %d: %v", i-params.BeaconConfig().GenesisSlot, err)
+		}
 		// If the slot is marked as skipped in the configuration options,
 		// we simply run the state transition with a nil block argument.
-		if sliceutil.IsInUint64(i, testCase.Config.SkipSlots) {
+		skipped := sliceutil.IsInUint64(i, testCase.Config.SkipSlots)
+		var simulatedObjects *SimulatedObjects
+		if !skipped {
+			simulatedObjects, err = sb.generateSimulatedObjects(testCase, i)
+			if err != nil {
+				return fmt.Errorf("could not generate simulated objects at slot %d: %v", i-params.BeaconConfig().GenesisSlot, err)
+			}
+			simulatedObjects.eth1BlockHash = sb.scheduledEth1Vote(testCase, i+1)
+		}
+		topUp := simulatedObjects != nil && simulatedObjects.simDeposit != nil && simulatedObjects.simDeposit.TopUp
+
+		var preState *pb.BeaconState
+		if testCase.Config.VerifyEpochRewards || topUp {
+			preState = proto.Clone(sb.state).(*pb.BeaconState)
+		}
+		prevBlockRoot := sb.prevBlockRoots[len(sb.prevBlockRoots)-1]
+
+		if skipped {
 			if err := sb.GenerateNilBlockAndAdvanceChain(); err != nil {
 				return fmt.Errorf("could not advance the chain with a nil block %v", err)
 			}
-			continue
+			skippedSlots++
+		} else {
+			startTime := time.Now()
+
+			if err := sb.GenerateBlockAndAdvanceChain(simulatedObjects, privKeys); err != nil {
+				return fmt.Errorf("could not generate the block and advance the chain %v", err)
+			}
+			sb.markAttestationsIncluded(simulatedObjects.simAttestations)
+
+			endTime := time.Now()
+			averageTimesPerTransition = append(averageTimesPerTransition, endTime.Sub(startTime))
+		}
+
+		if topUp {
+			if err := sb.recordTopUp(simulatedObjects.simDeposit, preState, prevBlockRoot); err != nil {
+				return fmt.Errorf("could not record top up at slot %d: %v", i-params.BeaconConfig().GenesisSlot, err)
+			}
+		}
+
+		if vote := testCase.Config.Eth1DataVote; vote != nil && sb.state.Slot == eth1VotingPeriodEnd(vote.VotingPeriod)-1 {
+			sb.eth1VoteResult = &eth1VoteResult{
+				latestBlockHash: sb.state.LatestEth1Data.GetBlockHash32(),
+				pendingVotes:    len(sb.state.Eth1DataVotes),
+			}
+		}
+
+		if log.GetLevel() >= log.DebugLevel {
+			if err := sb.logSlot(i, skipped); err != nil {
+				return err
+			}
 		}
 
-		simulatedObjects := sb.generateSimulatedObjects(testCase, i)
-		startTime := time.Now()
+		if testCase.Config.VerifyEpochRewards && e.CanProcessEpoch(sb.state) {
+			var block *pb.BeaconBlock
+			if !skipped {
+				block = sb.inMemoryBlocks[len(sb.inMemoryBlocks)-1]
+			}
+			if err := sb.verifyEpochRewards(preState, block, prevBlockRoot); err != nil {
+				return fmt.Errorf("could not verify epoch rewards at slot %d: %v", i-params.BeaconConfig().GenesisSlot, err)
+			}
+		}
 
-		if err := sb.GenerateBlockAndAdvanceChain(simulatedObjects, privKeys); err != nil {
-			return fmt.Errorf("could not generate the block and advance the chain %v", err)
+		if interval := testCase.Config.SnapshotInterval; interval > 0 &&
+			(sb.state.Slot-params.BeaconConfig().GenesisSlot)%interval == 0 {
+			if err := sb.saveSnapshot(); err != nil {
+				return err
+			}
 		}
 
-		endTime := time.Now()
-		averageTimesPerTransition = append(averageTimesPerTransition, endTime.Sub(startTime))
+		if testCase.SlotCallback != nil {
+			if err := testCase.SlotCallback(sb.state); err != nil {
+				return err
+			}
+		}
 	}
 
+	// Skipped slots run without a block and are left out of the average, so the
+	// count is logged to show how many transitions the average is taken over.
 	log.Infof(
-		"with %d initial deposits, each state transition took average time = %v",
+		"with %d initial deposits and %d skipped slots, each state transition took average time = %v",
 		testCase.Config.DepositsForChainStart,
+		skippedSlots,
 		averageDuration(averageTimesPerTransition),
 	)
 
@@ -253,13 +723,113 @@ func (sb *SimulatedBackend) RunStateTransitionTest(testCase *StateTestCase) erro
 	return nil
 }
 
+// saveSnapshot saves the current state to the db as the historical state of the latest block,
+// which is the block of the state's slot unless the slot was skipped.
+func (sb *SimulatedBackend) saveSnapshot() error {
+	blockRoot := sb.prevBlockRoots[len(sb.prevBlockRoots)-1]
+	if err := sb.beaconDB.SaveHistoricalState(context.Background(), sb.state, blockRoot); err != nil {
+		return fmt.Errorf(
+			"could not save state snapshot at slot %d: %v",
+			sb.state.Slot-params.BeaconConfig().GenesisSlot,
+			err,
+		)
+	}
+	return nil
+}
+
+// logSlot logs the block root, state root and the operations applied at the given slot
+// at debug level. For a skipped slot no block is applied and the state is hashed directly.
+func (sb *SimulatedBackend) logSlot(slot uint64, skipped bool) error {
+	fields := log.Fields{
+		"slot": slot - params.BeaconConfig().GenesisSlot,
+	}
+	if skipped {
+		stateRoot, err := hashProto(sb.state)
+		if err != nil {
+			return fmt.Errorf("could not tree hash state: %v", err)
+		}
+		fields["stateRoot"] = fmt.Sprintf("%#x", bytesutil.Trunc(stateRoot[:]))
+		log.WithFields(fields).Debug("Skipped slot")
+		return nil
+	}
+	block := sb.inMemoryBlocks[len(sb.inMemoryBlocks)-1]
+	blockRoot := sb.prevBlockRoots[len(sb.prevBlockRoots)-1]
+	fields["blockRoot"] = fmt.Sprintf("%#x", bytesutil.Trunc(blockRoot[:]))
+	fields["stateRoot"] = fmt.Sprintf("%#x", bytesutil.Trunc(block.StateRootHash32))
+	fields["deposits"] = len(block.Body.Deposits)
+	fields["attestations"] = len(block.Body.Attestations)
+	fields["proposerSlashings"] = len(block.Body.ProposerSlashings)
+	fields["attesterSlashings"] = len(block.Body.AttesterSlashings)
+	fields["voluntaryExits"] = len(block.Body.VoluntaryExits)
+	log.WithFields(fields).Debug("Processed block")
+	return nil
+}
+
+// verifyEpochRewards replays the slot and block transitions on the state preceding an epoch
+// boundary to obtain the state right before epoch processing, and checks that the epoch
+// transition which produced the current state changed the validator balances in the
+// direction expected from the previous epoch's participation.
+func (sb *SimulatedBackend) verifyEpochRewards(preState *pb.BeaconState, block *pb.BeaconBlock, prevBlockRoot [32]byte) error {
+	preEpochState, err := replaySlotAndBlock(preState, block, prevBlockRoot)
+	if err != nil {
+		return err
+	}
+	return checkEpochBalanceDirection(preEpochState, sb.state)
+}
+
+// recordTopUp stores the balance of the validator targeted by a top up deposit before and
+// after the latest block was processed. The block is replayed on a copy of the state preceding
+// it so rewards and penalties of an epoch transition at the same slot are not included.
+func (sb *SimulatedBackend) recordTopUp(deposit *StateTestDeposit, preState *pb.BeaconState, prevBlockRoot [32]byte) error {
+	block := sb.inMemoryBlocks[len(sb.inMemoryBlocks)-1]
+	postBlockState, err := replaySlotAndBlock(proto.Clone(preState).(*pb.BeaconState), block, prevBlockRoot)
+	if err != nil {
+		return err
+	}
+	sb.topUps = append(sb.topUps, &topUpBalance{
+		validatorIndex: deposit.ValidatorIndex,
+		amount:         deposit.Amount,
+		preBalance:     preState.ValidatorBalances[deposit.ValidatorIndex],
+		postBalance:    postBlockState.ValidatorBalances[deposit.ValidatorIndex],
+	})
+	return nil
+}
+
+// replaySlotAndBlock runs the slot and block transitions, without the epoch transition,
+// for the given block on top of the state preceding it. A nil block only advances the slot.
+func replaySlotAndBlock(preState *pb.BeaconState, block *pb.BeaconBlock, prevBlockRoot [32]byte) (*pb.BeaconState, error) {
+	ctx := context.Background()
+	newState := state.ProcessSlot(ctx, preState, prevBlockRoot)
+	if block != nil {
+		setDepositRoot(newState, block)
+		var err error
+		newState, err = state.ProcessBlock(ctx, newState, block, state.DefaultConfig())
+		if err != nil {
+			return nil, fmt.Errorf("could not process block: %v", err)
+		}
+	}
+	return newState, nil
+}
+
 // initializeStateTest sets up the environment by generating all the required objects in order
 // to proceed with the state test.
 func (sb *SimulatedBackend) initializeStateTest(testCase *StateTestCase) ([]*bls.SecretKey, error) {
+	sb.topUps = nil
+	sb.attestations = nil
+	sb.eth1VotesCast = 0
+	sb.eth1VoteResult = nil
+	if err := validateEth1DataVote(testCase); err != nil {
+		return nil, fmt.Errorf("invalid eth1 data vote: %v", err)
+	}
 	initialDeposits, privKeys, err := generateInitialSimulatedDeposits(testCase.Config.DepositsForChainStart)
 	if err != nil {
 		return nil, fmt.Errorf("could not simulate initial validator deposits: %v", err)
 	}
+	if !testCase.Config.SkipDepositVerification {
+		if err := verifySimulatedDeposits(initialDeposits); err != nil {
+			return nil, fmt.Errorf("could not verify initial validator deposits: %v", err)
+		}
+	}
 	if err := sb.setupBeaconStateAndGenesisBlock(initialDeposits); err != nil {
 		return nil, fmt.Errorf("could not set up beacon state and initialize genesis block %v", err)
 	}
@@ -269,20 +839,76 @@ func (sb *SimulatedBackend) initializeStateTest(testCase *StateTestCase) ([]*bls
 // setupBeaconStateAndGenesisBlock creates the initial beacon state and genesis block in order to
 // proceed with the test.
 func (sb *SimulatedBackend) setupBeaconStateAndGenesisBlock(initialDeposits []*pb.Deposit) error {
-	var err error
-	genesisTime := time.Date(2018, 9, 0, 0, 0, 0, 0, time.UTC).Unix()
-	sb.state, err = state.GenesisBeaconState(initialDeposits, uint64(genesisTime), nil)
+	sb.genesis = &genesisInputs{
+		deposits:        initialDeposits,
+		initialDeposits: initialDeposits,
+		genesisTime:     uint64(sb.genesisTime().Unix()),
+	}
+	return sb.ResetToGenesis()
+}
+
+// setupBeaconStateFromChainStart drives chain start through a simulated powchain the same way
+// the chain service does: deposits are submitted to the powchain, and once its ChainStart feed
+// fires, the genesis state is initialized in the DB from the chain start deposits and eth1 data.
+func (sb *SimulatedBackend) setupBeaconStateFromChainStart(initialDeposits []*pb.Deposit) error {
+	ctx := context.Background()
+	powChain := newSimulatedPOWChain(sb.genesisTime())
+	chainStartChan := make(chan time.Time, 1)
+	sub := powChain.ChainStartFeed().Subscribe(chainStartChan)
+	defer sub.Unsubscribe()
+
+	for _, deposit := range initialDeposits {
+		if err := powChain.ProcessDeposit(deposit.DepositData); err != nil {
+			return fmt.Errorf("could not process deposit in simulated powchain: %v", err)
+		}
+	}
+
+	var genesisTime time.Time
+	select {
+	case genesisTime = <-chainStartChan:
+	default:
+		return fmt.Errorf(
+			"chain start was not triggered after %d deposits, wanted at least %d",
+			len(initialDeposits),
+			params.BeaconConfig().DepositsForChainStart,
+		)
+	}
+
+	depositsData := powChain.ChainStartDeposits()
+	chainStartDeposits := make([]*pb.Deposit, len(depositsData))
+	for i := range depositsData {
+		chainStartDeposits[i] = &pb.Deposit{DepositData: depositsData[i]}
+	}
+	sb.genesis = &genesisInputs{
+		deposits:        chainStartDeposits,
+		initialDeposits: initialDeposits,
+		genesisTime:     uint64(genesisTime.Unix()),
+		eth1Data:        powChain.ChainStartETH1Data(),
+	}
+	if err := sb.beaconDB.InitializeState(
+		ctx,
+		sb.genesis.genesisTime,
+		sb.genesis.deposits,
+		sb.genesis.eth1Data,
+	); err != nil {
+		return fmt.Errorf("could not initialize beacon state to disk: %v", err)
+	}
+	beaconState, err := sb.beaconDB.HeadState(ctx)
 	if err != nil {
-		return fmt.Errorf("could not initialize simulated beacon state: %v", err)
+		return fmt.Errorf("could not fetch initialized beacon state: %v", err)
 	}
+	sb.state = beaconState
 	sb.historicalDeposits = initialDeposits
+	sb.inMemoryBlocks = make([]*pb.BeaconBlock, 0)
+	return sb.setupGenesisBlock()
+}
 
-	// We do not expect hashing initial beacon state and genesis block to
-	// fail, so we can safely ignore the error below.
-	// #nosec G104
-	stateRoot, err := hashutil.HashProto(sb.state)
+// setupGenesisBlock creates the genesis block from the current state and starts tracking
+// the generated blocks and their roots.
+func (sb *SimulatedBackend) setupGenesisBlock() error {
+	stateRoot, err := hashStateWithRetry(sb.state)
 	if err != nil {
-		return fmt.Errorf("could not tree hash state: %v", err)
+		return fmt.Errorf("could not tree hash genesis state: %v", err)
 	}
 	genesisBlock := b.NewGenesisBlock(stateRoot[:])
 	genesisBlockRoot, err := hashutil.HashBeaconBlock(genesisBlock)
@@ -294,11 +920,74 @@ func (sb *SimulatedBackend) setupBeaconStateAndGenesisBlock(initialDeposits []*p
 	// a slice.
 	sb.prevBlockRoots = [][32]byte{genesisBlockRoot}
 	sb.inMemoryBlocks = append(sb.inMemoryBlocks, genesisBlock)
+	return sb.verifyGenesisBlockRoot(stateRoot)
+}
+
+// verifyGenesisBlockRoot rebuilds the genesis block from the genesis state root and checks
+// that both its root and the root of the stored genesis block match the tracked genesis root,
+// so a regression in block hashing or genesis block construction fails the setup right away
+// instead of surfacing as a parent root mismatch several slots into a test.
+func (sb *SimulatedBackend) verifyGenesisBlockRoot(stateRoot [32]byte) error {
+	if len(sb.inMemoryBlocks) == 0 || len(sb.prevBlockRoots) == 0 {
+		return errors.New("no genesis block to verify")
+	}
+	genesisBlock := sb.inMemoryBlocks[0]
+	if genesisBlock.Slot != params.BeaconConfig().GenesisSlot {
+		return fmt.Errorf(
+			"genesis block is at slot %d, wanted slot 0",
+			genesisBlock.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	trackedRoot := sb.prevBlockRoots[0]
+	storedRoot, err := hashutil.HashBeaconBlock(genesisBlock)
+	if err != nil {
+		return fmt.Errorf("could not tree hash stored genesis block: %v", err)
+	}
+	if storedRoot != trackedRoot {
+		return fmt.Errorf("root of stored genesis block %#x does not match tracked genesis root %#x", storedRoot, trackedRoot)
+	}
+	recomputedRoot, err := hashutil.HashBeaconBlock(b.NewGenesisBlock(stateRoot[:]))
+	if err != nil {
+		return fmt.Errorf("could not tree hash recomputed genesis block: %v", err)
+	}
+	if recomputedRoot != trackedRoot {
+		return fmt.Errorf("recomputed genesis block root %#x does not match tracked genesis root %#x", recomputedRoot, trackedRoot)
+	}
 	return nil
 }
 
+// hashStateWithRetry tree hashes the given state, retrying with an exponential backoff
+// if hashing fails. We do not expect hashing the state to fail, but it can happen under
+// memory pressure during long benchmark runs, so we retry a bounded number of times
+// before giving up on the backend setup.
+func hashStateWithRetry(beaconState *pb.BeaconState) ([32]byte, error) {
+	backoff := stateHashRetryBackoff
+	var err error
+	for attempt := 1; attempt <= maxStateHashAttempts; attempt++ {
+		var root [32]byte
+		root, err = hashProto(beaconState)
+		if err == nil {
+			return root, nil
+		}
+		if attempt == maxStateHashAttempts {
+			break
+		}
+		log.WithFields(log.Fields{
+			"attempt":     attempt,
+			"maxAttempts": maxStateHashAttempts,
+			"backoff":     backoff,
+		}).Warnf("Could not tree hash state, retrying: %v", err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+	return [32]byte{}, fmt.Errorf("failed after %d attempts: %v", maxStateHashAttempts, err)
+}
+
 // generateSimulatedObjects generates the simulated objects depending on the testcase and current slot.
-func (sb *SimulatedBackend) generateSimulatedObjects(testCase *StateTestCase, slotNumber uint64) *SimulatedObjects {
+func (sb *SimulatedBackend) generateSimulatedObjects(testCase *StateTestCase, slotNumber uint64) (*SimulatedObjects, error) {
+	if sliceutil.IsInUint64(slotNumber, testCase.Config.EmptyBodySlots) {
+		return &SimulatedObjects{emptyBody: true}, nil
+	}
 	// If the slot is not skipped, we check if we are simulating a deposit at the current slot.
 	var simulatedDeposit *StateTestDeposit
 	for _, deposit := range testCase.Config.Deposits {
@@ -321,25 +1010,227 @@ func (sb *SimulatedBackend) generateSimulatedObjects(testCase *StateTestCase, sl
 			break
 		}
 	}
-	var simulatedValidatorExit *StateTestValidatorExit
+	// All the exits of the current epoch are included together, so they must fit in a single block.
+	var simulatedValidatorExits []*StateTestValidatorExit
 	for _, exit := range testCase.Config.ValidatorExits {
 		if exit.Epoch == slotNumber/params.BeaconConfig().SlotsPerEpoch {
-			simulatedValidatorExit = exit
-			break
+			simulatedValidatorExits = append(simulatedValidatorExits, exit)
 		}
 	}
+	if uint64(len(simulatedValidatorExits)) > params.BeaconConfig().MaxVoluntaryExits {
+		return nil, fmt.Errorf(
+			"%d validator exits in epoch %d exceed the maximum of %d voluntary exits per block",
+			len(simulatedValidatorExits),
+			slotNumber/params.BeaconConfig().SlotsPerEpoch-params.BeaconConfig().GenesisEpoch,
+			params.BeaconConfig().MaxVoluntaryExits,
+		)
+	}
 
 	return &SimulatedObjects{
 		simDeposit:          simulatedDeposit,
 		simProposerSlashing: simulatedProposerSlashing,
 		simAttesterSlashing: simulatedAttesterSlashing,
-		simValidatorExit:    simulatedValidatorExit,
+		simValidatorExits:   simulatedValidatorExits,
+		simAttestations:     sb.dueAttestations(sb.state.Slot + 1),
+	}, nil
+}
+
+// scheduledEth1Vote returns the eth1 block hash the block at the slot votes for, which is nil
+// unless the slot is in the voting period of the test case and not all its votes were cast yet.
+func (sb *SimulatedBackend) scheduledEth1Vote(testCase *StateTestCase, slot uint64) []byte {
+	vote := testCase.Config.Eth1DataVote
+	if vote == nil || eth1VotingPeriod(slot) != vote.VotingPeriod || sb.eth1VotesCast >= eth1VoteCount(vote) {
+		return nil
+	}
+	sb.eth1VotesCast++
+	return []byte(vote.BlockHash)
+}
+
+// eth1VotingPeriodSlots returns the number of slots of an eth1 data voting period.
+func eth1VotingPeriodSlots() uint64 {
+	return params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().EpochsPerEth1VotingPeriod
+}
+
+// eth1VotingPeriod returns the eth1 data voting period of the slot, counted from genesis.
+func eth1VotingPeriod(slot uint64) uint64 {
+	return (slot - params.BeaconConfig().GenesisSlot) / eth1VotingPeriodSlots()
+}
+
+// eth1VotingPeriodEnd returns the first slot after the eth1 data voting period.
+func eth1VotingPeriodEnd(period uint64) uint64 {
+	return params.BeaconConfig().GenesisSlot + (period+1)*eth1VotingPeriodSlots()
+}
+
+// eth1VoteCount returns the number of votes the test case casts, which defaults to the
+// smallest number of votes crossing the voting threshold.
+func eth1VoteCount(vote *StateTestEth1DataVote) uint64 {
+	if vote.VoteCount != 0 {
+		return vote.VoteCount
+	}
+	return eth1VotingPeriodSlots()/2 + 1
+}
+
+// validateEth1DataVote checks the eth1 data vote of the test case can be cast in full and its
+// voting period ends before the last slot of the test run.
+func validateEth1DataVote(testCase *StateTestCase) error {
+	vote := testCase.Config.Eth1DataVote
+	if vote == nil {
+		return nil
+	}
+	if len(vote.BlockHash) == 0 {
+		return errors.New("no block hash to vote for")
+	}
+	// There is no block at the genesis slot, which is the first slot of the first voting period.
+	blockSlots := eth1VotingPeriodSlots()
+	if vote.VotingPeriod == 0 {
+		blockSlots--
+	}
+	if eth1VoteCount(vote) > blockSlots {
+		return fmt.Errorf(
+			"%d votes exceed the %d blocks of voting period %d",
+			eth1VoteCount(vote),
+			blockSlots,
+			vote.VotingPeriod,
+		)
+	}
+	lastSlot := eth1VotingPeriodEnd(vote.VotingPeriod) - 1
+	if lastSlot > params.BeaconConfig().GenesisSlot+testCase.Config.NumSlots {
+		return fmt.Errorf(
+			"voting period %d ends at slot %d, after the %d slots of the test run",
+			vote.VotingPeriod,
+			lastSlot-params.BeaconConfig().GenesisSlot,
+			testCase.Config.NumSlots,
+		)
+	}
+	for _, deposit := range testCase.Config.Deposits {
+		if operationsApplied(testCase, deposit.Slot) && eth1VotingPeriod(deposit.Slot+1) == vote.VotingPeriod {
+			return fmt.Errorf(
+				"deposit at slot %d changes the deposit root voted for in voting period %d",
+				deposit.Slot-params.BeaconConfig().GenesisSlot,
+				vote.VotingPeriod,
+			)
+		}
+	}
+	return nil
+}
+
+// checkEth1DataVote checks the eth1 data voted for by the test case was adopted at the end of
+// its voting period if and only if its votes crossed the voting threshold, and that the votes
+// were reset for the next voting period either way.
+func (sb *SimulatedBackend) checkEth1DataVote(vote *StateTestEth1DataVote) error {
+	if sb.eth1VoteResult == nil {
+		return fmt.Errorf("eth1 data voting period %d did not end during the test run", vote.VotingPeriod)
+	}
+	if sb.eth1VotesCast != eth1VoteCount(vote) {
+		return fmt.Errorf(
+			"only %d of %d eth1 data votes were cast in voting period %d, too many of its slots were skipped",
+			sb.eth1VotesCast,
+			eth1VoteCount(vote),
+			vote.VotingPeriod,
+		)
+	}
+	adopted := bytes.Equal(sb.eth1VoteResult.latestBlockHash, []byte(vote.BlockHash))
+	if crossed := sb.eth1VotesCast*2 > eth1VotingPeriodSlots(); crossed && !adopted {
+		return fmt.Errorf(
+			"expected eth1 block hash %#x to be adopted after %d of %d votes, received %#x",
+			vote.BlockHash,
+			sb.eth1VotesCast,
+			eth1VotingPeriodSlots(),
+			sb.eth1VoteResult.latestBlockHash,
+		)
+	} else if !crossed && adopted {
+		return fmt.Errorf(
+			"expected eth1 block hash %#x not to be adopted after %d of %d votes",
+			vote.BlockHash,
+			sb.eth1VotesCast,
+			eth1VotingPeriodSlots(),
+		)
+	}
+	if sb.eth1VoteResult.pendingVotes != 0 {
+		return fmt.Errorf(
+			"expected eth1 data votes to be reset at the end of voting period %d, %d votes remain",
+			vote.VotingPeriod,
+			sb.eth1VoteResult.pendingVotes,
+		)
+	}
+	return nil
+}
+
+// createScheduledAttestations generates the attestations the test case schedules at the current
+// slot of the state and holds them until their inclusion slot.
+func (sb *SimulatedBackend) createScheduledAttestations(testCase *StateTestCase, privKeys []*bls.SecretKey) error {
+	for _, simAttestation := range testCase.Config.Attestations {
+		if simAttestation.Slot != sb.state.Slot {
+			continue
+		}
+		delay := simAttestation.InclusionDelay
+		if delay == 0 {
+			delay = params.BeaconConfig().MinAttestationInclusionDelay
+		}
+		if delay < params.BeaconConfig().MinAttestationInclusionDelay || delay > params.BeaconConfig().SlotsPerEpoch {
+			return fmt.Errorf(
+				"inclusion delay %d outside of the range of %d to %d slots",
+				delay,
+				params.BeaconConfig().MinAttestationInclusionDelay,
+				params.BeaconConfig().SlotsPerEpoch,
+			)
+		}
+		attestation, err := generateSignedAttestation(
+			sb.state,
+			sb.prevBlockRoots[len(sb.prevBlockRoots)-1],
+			simAttestation.CommitteeIndex,
+			privKeys,
+		)
+		if err != nil {
+			return fmt.Errorf("could not generate attestation: %v", err)
+		}
+		sb.attestations = append(sb.attestations, &simulatedAttestation{
+			config:        simAttestation,
+			attestation:   attestation,
+			inclusionSlot: simAttestation.Slot + delay,
+		})
+	}
+	return nil
+}
+
+// dueAttestations returns up to MaxAttestations of the held attestations which are due for inclusion
+// in the block at the slot. Attestations more than an epoch old can no longer be included and
+// are left out.
+func (sb *SimulatedBackend) dueAttestations(slot uint64) []*pb.Attestation {
+	due := []*pb.Attestation{}
+	for _, simAttestation := range sb.attestations {
+		if uint64(len(due)) == params.BeaconConfig().MaxAttestations {
+			break
+		}
+		if simAttestation.includedSlot != 0 || simAttestation.inclusionSlot > slot ||
+			simAttestation.attestation.Data.Slot+params.BeaconConfig().SlotsPerEpoch < slot {
+			continue
+		}
+		due = append(due, simAttestation.attestation)
+	}
+	return due
+}
+
+// markAttestationsIncluded records the attestations as included in the latest block.
+func (sb *SimulatedBackend) markAttestationsIncluded(attestations []*pb.Attestation) {
+	for _, attestation := range attestations {
+		for _, simAttestation := range sb.attestations {
+			if simAttestation.attestation == attestation {
+				simAttestation.includedSlot = sb.state.Slot
+			}
+		}
 	}
 }
 
 // compareTestCase compares the state in the simulated backend against the values in inputted test case. If
 // there are any discrepancies it returns an error.
 func (sb *SimulatedBackend) compareTestCase(testCase *StateTestCase) error {
+	if sb.skipEpochProcessing {
+		return errEpochProcessingSkipped
+	}
+	if sb.skipAttestationSignatures {
+		return errAttestationSignaturesSkipped
+	}
 	if sb.state.Slot != testCase.Results.Slot {
 		return fmt.Errorf(
 			"incorrect state slot after %d state transitions without blocks, wanted %d, received %d",
@@ -364,6 +1255,64 @@ func (sb *SimulatedBackend) compareTestCase(testCase *StateTestCase) error {
 			)
 		}
 	}
+	// Every proposer slashing included in a processed block must have slashed its proposer.
+	for _, pSlashing := range testCase.Config.ProposerSlashings {
+		if !operationsApplied(testCase, pSlashing.Slot) {
+			continue
+		}
+		if sb.state.ValidatorRegistry[pSlashing.ProposerIndex].SlashedEpoch == params.BeaconConfig().FarFutureEpoch {
+			return fmt.Errorf(
+				"expected proposer at index %d to have been slashed",
+				pSlashing.ProposerIndex,
+			)
+		}
+	}
+	// Every attester slashing included in a processed block must have slashed the validators
+	// present in both of its slashable attestations.
+	for _, aSlashing := range testCase.Config.AttesterSlashings {
+		if !operationsApplied(testCase, aSlashing.Slot) {
+			continue
+		}
+		slashedIndices := sliceutil.IntersectionUint64(
+			aSlashing.SlashableAttestation1ValidatorIndices,
+			aSlashing.SlashableAttestation2ValidatorIndices,
+		)
+		for _, idx := range slashedIndices {
+			if sb.state.ValidatorRegistry[idx].SlashedEpoch == params.BeaconConfig().FarFutureEpoch {
+				return fmt.Errorf(
+					"expected attester at index %d to have been slashed",
+					idx,
+				)
+			}
+		}
+	}
+	// Every validator exit included in a processed block must have initiated the exit of its validator.
+	for _, exit := range testCase.Config.ValidatorExits {
+		if !exitApplied(testCase, exit.Epoch) {
+			continue
+		}
+		if sb.state.ValidatorRegistry[exit.ValidatorIndex].StatusFlags != pb.Validator_INITIATED_EXIT {
+			return fmt.Errorf(
+				"expected validator at index %d to have exited",
+				exit.ValidatorIndex,
+			)
+		}
+	}
+	// Every attestation must have been included in the block at its inclusion slot, which determines
+	// the rewards for its inclusion, unless that block had no operations.
+	// The block at a slot is generated with the operations scheduled at the slot preceding it.
+	for _, simAttestation := range sb.attestations {
+		if simAttestation.inclusionSlot > sb.state.Slot || !operationsApplied(testCase, simAttestation.inclusionSlot-1) {
+			continue
+		}
+		if simAttestation.includedSlot != simAttestation.inclusionSlot {
+			return fmt.Errorf(
+				"expected attestation at slot %d to be included at slot %d",
+				simAttestation.config.Slot-params.BeaconConfig().GenesisSlot,
+				simAttestation.inclusionSlot-params.BeaconConfig().GenesisSlot,
+			)
+		}
+	}
 	for _, exited := range testCase.Results.ExitedValidators {
 		if sb.state.ValidatorRegistry[exited].StatusFlags != pb.Validator_INITIATED_EXIT {
 			return fmt.Errorf(
@@ -372,16 +1321,106 @@ func (sb *SimulatedBackend) compareTestCase(testCase *StateTestCase) error {
 			)
 		}
 	}
+	for _, expected := range testCase.Results.EffectiveBalances {
+		if expected.ValidatorIndex >= uint64(len(sb.state.ValidatorRegistry)) {
+			return fmt.Errorf(
+				"expected effective balance of validator at index %d outside of registry of size %d",
+				expected.ValidatorIndex,
+				len(sb.state.ValidatorRegistry),
+			)
+		}
+		if effectiveBalance := helpers.EffectiveBalance(sb.state, expected.ValidatorIndex); effectiveBalance != expected.EffectiveBalance {
+			return fmt.Errorf(
+				"incorrect effective balance of validator at index %d, wanted %d, received %d",
+				expected.ValidatorIndex,
+				expected.EffectiveBalance,
+				effectiveBalance,
+			)
+		}
+	}
+	// Every top up must have increased the balance of its validator by the deposited amount,
+	// which is capped at the max deposit amount unless excess deposits are enabled.
+	for _, topUp := range sb.topUps {
+		wanted := topUp.preBalance + topUp.amount
+		if !featureconfig.FeatureConfig().EnableExcessDeposits && wanted > params.BeaconConfig().MaxDepositAmount {
+			wanted = params.BeaconConfig().MaxDepositAmount
+		}
+		if topUp.postBalance != wanted {
+			return fmt.Errorf(
+				"expected top up of %d to change the balance of validator at index %d from %d to %d, received %d",
+				topUp.amount,
+				topUp.validatorIndex,
+				topUp.preBalance,
+				wanted,
+				topUp.postBalance,
+			)
+		}
+	}
+	if vote := testCase.Config.Eth1DataVote; vote != nil {
+		if err := sb.checkEth1DataVote(vote); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-func setTestConfig(testCase *StateTestCase) {
+// operationsApplied reports whether the operations scheduled at the slot were included in a block
+// during the test run, which is not the case for skipped slots and slots with an empty block body.
+func operationsApplied(testCase *StateTestCase, slot uint64) bool {
+	startSlot := params.BeaconConfig().GenesisSlot
+	return slot >= startSlot && slot < startSlot+testCase.Config.NumSlots &&
+		!sliceutil.IsInUint64(slot, testCase.Config.SkipSlots) &&
+		!sliceutil.IsInUint64(slot, testCase.Config.EmptyBodySlots)
+}
+
+// exitApplied reports whether the validator exits scheduled in the epoch were included in a block
+// during the test run, which is the case if operations were applied at any slot of the epoch.
+func exitApplied(testCase *StateTestCase, epoch uint64) bool {
+	startSlot := epoch * params.BeaconConfig().SlotsPerEpoch
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if operationsApplied(testCase, slot) {
+			return true
+		}
+	}
+	return false
+}
+
+// setTestConfig overrides the beacon config with the options of the test case
+// and returns a function restoring the config used prior to the test.
+func setTestConfig(testCase *StateTestCase) func() {
 	// We setup the initial configuration for running state
 	// transition tests below.
-	c := params.BeaconConfig()
+	snapshot := params.SnapshotConfig()
+	c := *snapshot
 	c.SlotsPerEpoch = testCase.Config.SlotsPerEpoch
 	c.DepositsForChainStart = testCase.Config.DepositsForChainStart
-	params.OverrideBeaconConfig(c)
+	if testCase.Config.SecondsPerSlot != 0 {
+		c.SecondsPerSlot = testCase.Config.SecondsPerSlot
+	}
+	if testCase.Config.BaseRewardQuotient != 0 {
+		c.BaseRewardQuotient = testCase.Config.BaseRewardQuotient
+	}
+	if testCase.Config.EpochsPerEth1VotingPeriod != 0 {
+		c.EpochsPerEth1VotingPeriod = testCase.Config.EpochsPerEth1VotingPeriod
+	}
+	params.OverrideBeaconConfig(&c)
+	return func() {
+		params.RestoreConfig(snapshot)
+	}
+}
+
+// genesisTime returns the time the simulated chain starts at, which is the fixed simulated
+// genesis time unless a genesis delay relative to the current time was set.
+func (sb *SimulatedBackend) genesisTime() time.Time {
+	if sb.relativeGenesis {
+		return time.Now().Add(sb.genesisDelay)
+	}
+	return simulatedGenesisTime()
+}
+
+// simulatedGenesisTime is the genesis time used by the simulated backend.
+func simulatedGenesisTime() time.Time {
+	return time.Date(2018, 9, 0, 0, 0, 0, 0, time.UTC)
 }
 
 func averageDuration(times []time.Duration) time.Duration {
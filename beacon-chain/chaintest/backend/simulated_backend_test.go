@@ -1,11 +1,29 @@
 package backend
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/utils"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/sirupsen/logrus"
+	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
 func init() {
@@ -58,6 +76,56 @@ func TestGenerateBlockAndAdvanceChain_IncreasesSlot(t *testing.T) {
 
 }
 
+func TestResetToGenesis_AdvancesLikeFreshBackend(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+	if err := backend.ResetToGenesis(); err == nil {
+		t.Error("Expected resetting a backend which was not set up to fail")
+	}
+
+	privKeys, err := backend.SetupBackend(64)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	genesisState := proto.Clone(backend.State()).(*pb.BeaconState)
+	// The run crosses an epoch boundary so the epoch processing is exercised as well.
+	advance := func() {
+		for i := uint64(0); i < params.BeaconConfig().SlotsPerEpoch+1; i++ {
+			if err := backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{}, privKeys); err != nil {
+				t.Fatalf("Could not advance the chain at slot %d: %v", i+1, err)
+			}
+		}
+	}
+	advance()
+	freshState := backend.State()
+	freshRoots := backend.prevBlockRoots
+
+	if err := backend.ResetToGenesis(); err != nil {
+		t.Fatalf("Could not reset backend to genesis %v", err)
+	}
+	if !proto.Equal(backend.State(), genesisState) {
+		t.Error("Expected the state to be reset to the genesis state")
+	}
+	if len(backend.InMemoryBlocks()) != 1 || len(backend.prevBlockRoots) != 1 || backend.prevBlockRoots[0] != freshRoots[0] {
+		t.Errorf("Expected only the genesis block to be tracked, received %d blocks", len(backend.InMemoryBlocks()))
+	}
+	if len(backend.historicalDeposits) != 64 {
+		t.Errorf("Wanted 64 historical deposits after reset, received %d", len(backend.historicalDeposits))
+	}
+
+	advance()
+	if !reflect.DeepEqual(backend.prevBlockRoots, freshRoots) {
+		t.Error("Expected the blocks generated after reset to match the blocks of the fresh run")
+	}
+	if !proto.Equal(backend.State(), freshState) {
+		t.Error("Expected the state after reset to match the state of the fresh run")
+	}
+}
+
 func TestGenerateNilBlockAndAdvanceChain_IncreasesSlot(t *testing.T) {
 	backend, err := NewSimulatedBackend()
 	if err != nil {
@@ -83,3 +151,1912 @@ func TestGenerateNilBlockAndAdvanceChain_IncreasesSlot(t *testing.T) {
 	}
 
 }
+
+func TestRunStateTransitionTest_InvokesSlotCallback(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	var slots []uint64
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              4,
+			SkipSlots:             []uint64{genesisSlot + 1},
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 4,
+			NumValidators: 64,
+		},
+		SlotCallback: func(state *pb.BeaconState) error {
+			slots = append(slots, state.Slot)
+			return nil
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test %v", err)
+	}
+	if len(slots) != int(testCase.Config.NumSlots) {
+		t.Fatalf("Expected callback to be invoked %d times, got %d", testCase.Config.NumSlots, len(slots))
+	}
+	for i, slot := range slots {
+		if slot != genesisSlot+uint64(i)+1 {
+			t.Errorf("Callback %d received state at slot %d, wanted %d", i, slot, genesisSlot+uint64(i)+1)
+		}
+	}
+}
+
+func TestRunStateTransitionTest_SavesSnapshotsAtInterval(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	var snapshotSlots []uint64
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              5,
+			SkipSlots:             []uint64{genesisSlot + 3},
+			SnapshotInterval:      2,
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 5,
+			NumValidators: 64,
+		},
+		SlotCallback: func(state *pb.BeaconState) error {
+			blockRoot := backend.prevBlockRoots[len(backend.prevBlockRoots)-1]
+			snapshot, err := backend.beaconDB.HistoricalStateFromSlot(context.Background(), state.Slot, blockRoot)
+			if err == nil && snapshot.Slot == state.Slot {
+				if !proto.Equal(snapshot, state) {
+					return fmt.Errorf("snapshot at slot %d does not match the state", state.Slot-genesisSlot)
+				}
+				snapshotSlots = append(snapshotSlots, state.Slot-genesisSlot)
+			}
+			return nil
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test %v", err)
+	}
+	// The snapshot at slot 4 is keyed by the block of slot 3, as slot 4 was skipped.
+	if !reflect.DeepEqual(snapshotSlots, []uint64{2, 4}) {
+		t.Errorf("Expected snapshots at slots [2 4], received %v", snapshotSlots)
+	}
+}
+
+func TestRunStateTransitionTest_OverridesSecondsPerSlot(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	var observed []uint64
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              2,
+			SecondsPerSlot:        secondsPerSlot + 10,
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 2,
+			NumValidators: 64,
+		},
+		SlotCallback: func(state *pb.BeaconState) error {
+			observed = append(observed, params.BeaconConfig().SecondsPerSlot)
+			return nil
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test %v", err)
+	}
+	for i, seconds := range observed {
+		if seconds != secondsPerSlot+10 {
+			t.Errorf("Slot %d ran with %d seconds per slot, wanted %d", i, seconds, secondsPerSlot+10)
+		}
+	}
+	if params.BeaconConfig().SecondsPerSlot != secondsPerSlot {
+		t.Errorf("Expected seconds per slot to be restored to %d, received %d", secondsPerSlot, params.BeaconConfig().SecondsPerSlot)
+	}
+	if params.BeaconConfig().DepositsForChainStart == 64 {
+		t.Error("Expected deposits for chain start override to be restored after the test run")
+	}
+}
+
+func TestRunStateTransitionTest_OverridesBaseRewardQuotient(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+
+	baseRewardQuotient := params.BeaconConfig().BaseRewardQuotient
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	var observed []uint64
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              2,
+			BaseRewardQuotient:    1,
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 2,
+			NumValidators: 64,
+		},
+		SlotCallback: func(state *pb.BeaconState) error {
+			observed = append(observed, params.BeaconConfig().BaseRewardQuotient)
+			return nil
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test %v", err)
+	}
+	if len(observed) != int(testCase.Config.NumSlots) {
+		t.Fatalf("Expected callback to be invoked %d times, got %d", testCase.Config.NumSlots, len(observed))
+	}
+	for i, quotient := range observed {
+		if quotient != 1 {
+			t.Errorf("Slot %d ran with base reward quotient %d, wanted 1", i, quotient)
+		}
+	}
+	if params.BeaconConfig().BaseRewardQuotient != baseRewardQuotient {
+		t.Errorf("Expected base reward quotient to be restored to %d, received %d", baseRewardQuotient, params.BeaconConfig().BaseRewardQuotient)
+	}
+}
+
+func TestRunStateTransitionTest_DebugLogsEverySlot(t *testing.T) {
+	hook := logTest.NewGlobal()
+	level := logrus.GetLevel()
+	logrus.SetLevel(logrus.DebugLevel)
+	defer logrus.SetLevel(level)
+
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              2,
+			SkipSlots:             []uint64{genesisSlot + 1},
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 2,
+			NumValidators: 64,
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test %v", err)
+	}
+	testutil.AssertLogsContain(t, hook, "Processed block")
+	testutil.AssertLogsContain(t, hook, "Skipped slot")
+}
+
+func TestRunStateTransitionTest_SlotCallbackErrorAbortsRun(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	wantedErr := errors.New("invariant broken")
+	calls := 0
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              4,
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 4,
+			NumValidators: 64,
+		},
+		SlotCallback: func(state *pb.BeaconState) error {
+			calls++
+			if state.Slot == genesisSlot+2 {
+				return wantedErr
+			}
+			return nil
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != wantedErr {
+		t.Fatalf("Expected run to abort with %v, received %v", wantedErr, err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected run to stop after 2 callbacks, got %d", calls)
+	}
+}
+
+func TestRunStateTransitionTest_TopUpDeposit(t *testing.T) {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	tests := []struct {
+		name          string
+		excessDeposit bool
+		wantedBalance uint64
+	}{
+		{
+			name:          "capped at max deposit amount",
+			wantedBalance: params.BeaconConfig().MaxDepositAmount,
+		},
+		{
+			name:          "excess deposits enabled",
+			excessDeposit: true,
+			wantedBalance: params.BeaconConfig().MaxDepositAmount + 1e9,
+		},
+	}
+	for _, tt := range tests {
+		featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+			EnableCrosslinks:     true,
+			EnableExcessDeposits: tt.excessDeposit,
+		})
+		backend, err := NewSimulatedBackend()
+		if err != nil {
+			t.Fatalf("Could not create a new simulated backend %v", err)
+		}
+		testCase := &StateTestCase{
+			Config: &StateTestConfig{
+				SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+				DepositsForChainStart: 64,
+				NumSlots:              2,
+				Deposits: []*StateTestDeposit{
+					{
+						Slot:           genesisSlot + 1,
+						Amount:         1e9,
+						MerkleIndex:    64,
+						TopUp:          true,
+						ValidatorIndex: 3,
+					},
+				},
+			},
+			Results: &StateTestResults{
+				Slot:          genesisSlot + 2,
+				NumValidators: 64,
+			},
+		}
+		if err := backend.RunStateTransitionTest(testCase); err != nil {
+			t.Fatalf("%s: could not run state transition test %v", tt.name, err)
+		}
+		if len(backend.topUps) != 1 {
+			t.Fatalf("%s: expected 1 recorded top up, received %d", tt.name, len(backend.topUps))
+		}
+		if backend.topUps[0].postBalance != tt.wantedBalance {
+			t.Errorf("%s: expected balance after top up %d, received %d", tt.name, tt.wantedBalance, backend.topUps[0].postBalance)
+		}
+		backend.Shutdown()
+	}
+	featureconfig.InitFeatureConfig(&featureconfig.FeatureFlagConfig{
+		EnableCrosslinks: true,
+	})
+}
+
+func TestRunStateTransitionTest_EmptyBodySlots(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              3,
+			EmptyBodySlots:        []uint64{genesisSlot + 1},
+			Deposits: []*StateTestDeposit{
+				{
+					Slot:        genesisSlot + 1,
+					Amount:      params.BeaconConfig().MaxDepositAmount,
+					MerkleIndex: 64,
+					Pubkey:      "new validator",
+				},
+			},
+			ProposerSlashings: []*StateTestProposerSlashing{
+				{
+					Slot:          genesisSlot + 1,
+					ProposerIndex: 10,
+				},
+			},
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 3,
+			NumValidators: 64,
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test %v", err)
+	}
+	for _, block := range backend.InMemoryBlocks() {
+		body := block.Body
+		if block.Slot == genesisSlot+2 &&
+			len(body.Deposits)+len(body.ProposerSlashings)+len(body.AttesterSlashings)+
+				len(body.Attestations)+len(body.VoluntaryExits) != 0 {
+			t.Errorf("Expected block at slot %d to have an empty body, received %v", block.Slot-genesisSlot, body)
+		}
+	}
+	if backend.State().ValidatorRegistry[10].SlashedEpoch != params.BeaconConfig().FarFutureEpoch {
+		t.Error("Expected the proposer slashing scheduled at an empty body slot not to be applied")
+	}
+}
+
+func TestCompareTestCase_EffectiveBalances(t *testing.T) {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	backend := &SimulatedBackend{
+		state: &pb.BeaconState{
+			Slot:              genesisSlot,
+			ValidatorRegistry: []*pb.Validator{{}, {}},
+			ValidatorBalances: []uint64{maxDeposit + 5e9, maxDeposit - 1e9},
+		},
+	}
+	tests := []struct {
+		expected  []*StateTestEffectiveBalance
+		wantedErr string
+	}{
+		{
+			expected: []*StateTestEffectiveBalance{
+				{ValidatorIndex: 0, EffectiveBalance: maxDeposit},
+				{ValidatorIndex: 1, EffectiveBalance: maxDeposit - 1e9},
+			},
+		},
+		{
+			expected: []*StateTestEffectiveBalance{
+				{ValidatorIndex: 0, EffectiveBalance: maxDeposit + 5e9},
+			},
+			wantedErr: "incorrect effective balance of validator at index 0",
+		},
+		{
+			expected: []*StateTestEffectiveBalance{
+				{ValidatorIndex: 2, EffectiveBalance: maxDeposit},
+			},
+			wantedErr: "outside of registry",
+		},
+	}
+	for _, tt := range tests {
+		testCase := &StateTestCase{
+			Config: &StateTestConfig{},
+			Results: &StateTestResults{
+				Slot:              genesisSlot,
+				NumValidators:     2,
+				EffectiveBalances: tt.expected,
+			},
+		}
+		err := backend.compareTestCase(testCase)
+		if tt.wantedErr == "" {
+			if err != nil {
+				t.Errorf("Unexpected error %v", err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.wantedErr) {
+			t.Errorf("Expected error containing %q, received %v", tt.wantedErr, err)
+		}
+	}
+}
+
+func TestCompareTestCase_TopUpBalanceMismatch(t *testing.T) {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	backend := &SimulatedBackend{
+		state: &pb.BeaconState{
+			Slot:              genesisSlot,
+			ValidatorRegistry: []*pb.Validator{{}},
+			ValidatorBalances: []uint64{params.BeaconConfig().MaxDepositAmount - 2e9},
+		},
+		topUps: []*topUpBalance{
+			{
+				validatorIndex: 0,
+				amount:         1e9,
+				preBalance:     params.BeaconConfig().MaxDepositAmount - 2e9,
+				postBalance:    params.BeaconConfig().MaxDepositAmount - 2e9,
+			},
+		},
+	}
+	testCase := &StateTestCase{
+		Config:  &StateTestConfig{},
+		Results: &StateTestResults{Slot: genesisSlot, NumValidators: 1},
+	}
+	if err := backend.compareTestCase(testCase); err == nil {
+		t.Error("Expected an error for a top up which did not increase the balance")
+	}
+}
+
+func TestSetupBeaconStateFromChainStart_MatchesDirectGenesis(t *testing.T) {
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	c.DepositsForChainStart = 100
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	initialDeposits, _, err := generateInitialSimulatedDeposits(100)
+	if err != nil {
+		t.Fatalf("Could not simulate initial validator deposits %v", err)
+	}
+
+	directBackend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer db.TeardownDB(directBackend.beaconDB)
+	if err := directBackend.setupBeaconStateAndGenesisBlock(initialDeposits); err != nil {
+		t.Fatalf("Could not set up beacon state directly %v", err)
+	}
+
+	chainStartBackend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer db.TeardownDB(chainStartBackend.beaconDB)
+	if err := chainStartBackend.setupBeaconStateFromChainStart(initialDeposits); err != nil {
+		t.Fatalf("Could not set up beacon state from chain start %v", err)
+	}
+
+	chainStartState := chainStartBackend.State()
+	if len(chainStartState.LatestEth1Data.DepositRootHash32) != 32 {
+		t.Errorf("Expected chain start eth1 data to contain the deposit root, received %v", chainStartState.LatestEth1Data)
+	}
+	if len(chainStartBackend.InMemoryBlocks()) != 1 || len(chainStartBackend.prevBlockRoots) != 1 {
+		t.Errorf("Expected only the genesis block to be tracked, received %d blocks", len(chainStartBackend.InMemoryBlocks()))
+	}
+
+	// The chain start path only differs from the direct path by the eth1 data
+	// recorded from the ChainStart log.
+	comparedState := proto.Clone(chainStartState).(*pb.BeaconState)
+	comparedState.LatestEth1Data = directBackend.State().LatestEth1Data
+	if !proto.Equal(comparedState, directBackend.State()) {
+		t.Errorf("Chain start genesis state does not match direct genesis state, wanted %v, received %v",
+			directBackend.State(), comparedState)
+	}
+}
+
+func TestSetupBeaconStateFromChainStart_SetupTwice(t *testing.T) {
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	c.DepositsForChainStart = 100
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer db.TeardownDB(backend.beaconDB)
+	for i := 0; i < 2; i++ {
+		initialDeposits, _, err := generateInitialSimulatedDeposits(100)
+		if err != nil {
+			t.Fatalf("Could not simulate initial validator deposits %v", err)
+		}
+		if err := backend.setupBeaconStateFromChainStart(initialDeposits); err != nil {
+			t.Fatalf("Could not set up beacon state from chain start on attempt %d: %v", i+1, err)
+		}
+		if len(backend.InMemoryBlocks()) != 1 || len(backend.prevBlockRoots) != 1 {
+			t.Errorf("Expected only the genesis block to be tracked, received %d blocks", len(backend.InMemoryBlocks()))
+		}
+	}
+}
+
+func TestSetGenesisDelay_GenesisInFuture(t *testing.T) {
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	c.DepositsForChainStart = 8
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	initialDeposits, _, err := generateInitialSimulatedDeposits(8)
+	if err != nil {
+		t.Fatalf("Could not simulate initial validator deposits %v", err)
+	}
+	delay := time.Hour
+	for _, chainStart := range []bool{false, true} {
+		backend, err := NewSimulatedBackend()
+		if err != nil {
+			t.Fatalf("Could not create a new simulated backend %v", err)
+		}
+		backend.SetGenesisDelay(delay)
+		before := time.Now().Add(delay).Unix()
+		if chainStart {
+			err = backend.setupBeaconStateFromChainStart(initialDeposits)
+		} else {
+			err = backend.setupBeaconStateAndGenesisBlock(initialDeposits)
+		}
+		if err != nil {
+			t.Fatalf("Could not set up beacon state %v", err)
+		}
+		after := time.Now().Add(delay).Unix()
+		genesisTime := int64(backend.State().GenesisTime)
+		if genesisTime < before || genesisTime > after {
+			t.Errorf("Chain start %v: wanted genesis time between %d and %d, received %d", chainStart, before, after, genesisTime)
+		}
+		db.TeardownDB(backend.beaconDB)
+	}
+}
+
+func TestSetupBeaconStateFromChainStart_BelowThreshold(t *testing.T) {
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	c.DepositsForChainStart = 100
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer db.TeardownDB(backend.beaconDB)
+
+	initialDeposits, _, err := generateInitialSimulatedDeposits(99)
+	if err != nil {
+		t.Fatalf("Could not simulate initial validator deposits %v", err)
+	}
+	want := "chain start was not triggered after 99 deposits, wanted at least 100"
+	if err := backend.setupBeaconStateFromChainStart(initialDeposits); err == nil || err.Error() != want {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestHashStateWithRetry_RecoversFromTransientFailure(t *testing.T) {
+	defer func() {
+		hashProto = hashutil.HashProto
+	}()
+	attempts := 0
+	hashProto = func(msg proto.Message) ([32]byte, error) {
+		attempts++
+		if attempts < maxStateHashAttempts {
+			return [32]byte{}, errors.New("out of memory")
+		}
+		return hashutil.HashProto(msg)
+	}
+
+	beaconState := &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot}
+	root, err := hashStateWithRetry(beaconState)
+	if err != nil {
+		t.Fatalf("Expected hashing to succeed on the last attempt, received %v", err)
+	}
+	wanted, err := hashutil.HashProto(beaconState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != wanted {
+		t.Errorf("Wanted state root %#x, received %#x", wanted, root)
+	}
+	if attempts != maxStateHashAttempts {
+		t.Errorf("Expected %d hashing attempts, received %d", maxStateHashAttempts, attempts)
+	}
+}
+
+func TestHashStateWithRetry_FailsAfterMaxAttempts(t *testing.T) {
+	defer func() {
+		hashProto = hashutil.HashProto
+	}()
+	attempts := 0
+	hashProto = func(msg proto.Message) ([32]byte, error) {
+		attempts++
+		return [32]byte{}, errors.New("out of memory")
+	}
+
+	_, err := hashStateWithRetry(&pb.BeaconState{})
+	want := "failed after 3 attempts: out of memory"
+	if err == nil || err.Error() != want {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+	if attempts != maxStateHashAttempts {
+		t.Errorf("Expected %d hashing attempts, received %d", maxStateHashAttempts, attempts)
+	}
+}
+
+func TestRunShuffleTest_VerifiesPermutation(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	input := []uint64{4, 6, 2, 6, 1, 4, 6, 2, 1, 5}
+	output, err := utils.ShuffleIndices(common.BytesToHash(nil), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testCase := &ShuffleTestCase{Input: input, Output: output, VerifyPermutation: true}
+	if err := backend.RunShuffleTest(testCase); err != nil {
+		t.Errorf("Expected a valid shuffle to pass, received %v", err)
+	}
+}
+
+func TestRunShuffleTest_BrokenShuffleNotPermutation(t *testing.T) {
+	defer func() {
+		shuffleIndices = utils.ShuffleIndices
+	}()
+	tests := []struct {
+		shuffle func([]uint64) []uint64
+		want    string
+	}{
+		{
+			// Overwrites the first element with the second one.
+			shuffle: func(list []uint64) []uint64 {
+				return append([]uint64{list[1]}, list[1:]...)
+			},
+			want: "element 2 at output index 1 is duplicated or not part of the input",
+		},
+		{
+			// Drops the last element.
+			shuffle: func(list []uint64) []uint64 {
+				return list[:len(list)-1]
+			},
+			want: "element 3 of the input is missing from the output",
+		},
+	}
+	for _, tt := range tests {
+		backend, err := NewSimulatedBackend()
+		if err != nil {
+			t.Fatalf("Could not create a new simulated backend %v", err)
+		}
+		shuffle := tt.shuffle
+		shuffleIndices = func(_ common.Hash, list []uint64) ([]uint64, error) {
+			return shuffle(append([]uint64{}, list...)), nil
+		}
+		input := []uint64{1, 2, 3}
+		// The expected output matches the broken shuffle, so only the permutation check can fail.
+		testCase := &ShuffleTestCase{Input: input, Output: tt.shuffle(append([]uint64{}, input...)), VerifyPermutation: true}
+		if err := backend.RunShuffleTest(testCase); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error containing %q, received %v", tt.want, err)
+		}
+	}
+}
+
+func TestGenerateSimulatedBlock_SignedProposerSlashing(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	proposerIndex := uint64(10)
+	simSlashing := &StateTestProposerSlashing{
+		ProposerIndex:  proposerIndex,
+		Proposal1Slot:  params.BeaconConfig().GenesisSlot + 1,
+		Proposal2Slot:  params.BeaconConfig().GenesisSlot + 1,
+		Proposal1Shard: 1,
+		Proposal2Shard: 1,
+		Proposal1Root:  "root",
+		Proposal2Root:  "root",
+	}
+	prevBlockRoot := backend.prevBlockRoots[len(backend.prevBlockRoots)-1]
+	block, _, err := generateSimulatedBlock(
+		backend.state,
+		prevBlockRoot,
+		backend.historicalDeposits,
+		&SimulatedObjects{simProposerSlashing: simSlashing},
+		privKeys,
+		false, /* skipEpochProcessing */
+	)
+	if err != nil {
+		t.Fatalf("Could not generate simulated block %v", err)
+	}
+
+	verifyConfig := &state.TransitionConfig{VerifySignatures: true}
+	tamperedState := proto.Clone(backend.state).(*pb.BeaconState)
+	tamperedBlock := proto.Clone(block).(*pb.BeaconBlock)
+	tamperedBlock.Body.ProposerSlashings[0].ProposalSignature_1 = privKeys[proposerIndex+1].Sign(
+		[]byte("root"), params.BeaconConfig().DomainProposal).Marshal()
+	// Re-sign the tampered block so that it passes proposer signature verification.
+	tamperedRoot, err := hashutil.HashBeaconBlock(tamperedBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockProposer, err := helpers.BeaconProposerIndex(backend.state, block.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tamperedBlock.Signature, err = signProposal(backend.state, &pb.ProposalSignedData{
+		Slot:            tamperedBlock.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: tamperedRoot[:],
+	}, privKeys[blockProposer])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "could not verify proposal 1 signature"
+	if _, err := state.ExecuteStateTransition(
+		context.Background(), tamperedState, tamperedBlock, prevBlockRoot, verifyConfig,
+	); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+
+	newState, err := state.ExecuteStateTransition(
+		context.Background(), backend.state, block, prevBlockRoot, verifyConfig,
+	)
+	if err != nil {
+		t.Fatalf("Could not execute state transition with signature verification %v", err)
+	}
+	if newState.ValidatorRegistry[proposerIndex].SlashedEpoch == params.BeaconConfig().FarFutureEpoch {
+		t.Errorf("Expected proposer at index %d to have been slashed", proposerIndex)
+	}
+}
+
+func TestGenerateSimulatedBlock_ProposerSignature(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	verifyConfig := &state.TransitionConfig{VerifySignatures: true}
+	for i := 0; i < 3; i++ {
+		prevBlockRoot := backend.prevBlockRoots[len(backend.prevBlockRoots)-1]
+		block, blockRoot, err := generateSimulatedBlock(
+			backend.state,
+			prevBlockRoot,
+			backend.historicalDeposits,
+			&SimulatedObjects{},
+			privKeys,
+			false, /* skipEpochProcessing */
+		)
+		if err != nil {
+			t.Fatalf("Could not generate simulated block %v", err)
+		}
+
+		proposerIdx, err := helpers.BeaconProposerIndex(backend.state, block.Slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tamperedBlock := proto.Clone(block).(*pb.BeaconBlock)
+		tamperedBlock.Signature = privKeys[(proposerIdx+1)%uint64(len(privKeys))].Sign(
+			blockRoot[:], params.BeaconConfig().DomainProposal).Marshal()
+		want := "could not verify proposer signature"
+		if _, err := state.ExecuteStateTransition(
+			context.Background(), proto.Clone(backend.state).(*pb.BeaconState), tamperedBlock, prevBlockRoot, verifyConfig,
+		); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error containing %q, received %v", want, err)
+		}
+
+		if _, err := state.ExecuteStateTransition(
+			context.Background(), proto.Clone(backend.state).(*pb.BeaconState), block, prevBlockRoot, verifyConfig,
+		); err != nil {
+			t.Fatalf("Could not execute state transition with signature verification at slot %d: %v",
+				block.Slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if err := backend.advanceChain(block, blockRoot, prevBlockRoot); err != nil {
+			t.Fatalf("Could not advance the chain %v", err)
+		}
+	}
+}
+
+func TestVerifyBlock_ValidBlock(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	prevBlockRoot := backend.prevBlockRoots[len(backend.prevBlockRoots)-1]
+	block, _, err := generateSimulatedBlock(
+		backend.state,
+		prevBlockRoot,
+		backend.historicalDeposits,
+		&SimulatedObjects{},
+		privKeys,
+		false, /* skipEpochProcessing */
+	)
+	if err != nil {
+		t.Fatalf("Could not generate simulated block %v", err)
+	}
+	preState := proto.Clone(backend.state).(*pb.BeaconState)
+	report, err := backend.VerifyBlock(block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.Valid() {
+		t.Errorf("Expected block to be valid, received %v", report.Err())
+	}
+	if !proto.Equal(backend.state, preState) {
+		t.Error("Expected verifying a block to leave the state of the backend unchanged")
+	}
+	if len(backend.inMemoryBlocks) != 1 {
+		t.Errorf("Expected verifying a block to not advance the chain, tracking %d blocks", len(backend.inMemoryBlocks))
+	}
+}
+
+func TestVerifyBlock_InvalidBlock(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	prevBlockRoot := backend.prevBlockRoots[len(backend.prevBlockRoots)-1]
+	block, _, err := generateSimulatedBlock(
+		backend.state,
+		prevBlockRoot,
+		backend.historicalDeposits,
+		&SimulatedObjects{},
+		privKeys,
+		false, /* skipEpochProcessing */
+	)
+	if err != nil {
+		t.Fatalf("Could not generate simulated block %v", err)
+	}
+
+	// Tampering with the state root changes the block root, so the proposal signature no
+	// longer verifies either, while the parent root and randao reveal are left valid.
+	tamperedStateRoot := proto.Clone(block).(*pb.BeaconBlock)
+	tamperedStateRoot.StateRootHash32 = []byte("tampered")
+	report, err := backend.VerifyBlock(tamperedStateRoot, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.StateRootErr == nil || report.ProposerSignatureErr == nil {
+		t.Errorf("Expected state root and proposer signature errors, received %+v", report)
+	}
+	if report.ParentRootErr != nil || report.RandaoRevealErr != nil || report.SlotErr != nil {
+		t.Errorf("Expected parent root, randao reveal and slot to be valid, received %+v", report)
+	}
+
+	// Rotating the keys gives every validator the key of another validator.
+	otherKeys := append(privKeys[1:len(privKeys):len(privKeys)], privKeys[0])
+	tamperedParent := proto.Clone(block).(*pb.BeaconBlock)
+	tamperedParent.ParentRootHash32 = []byte("unknown parent")
+	report, err = backend.VerifyBlock(tamperedParent, otherKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Valid() {
+		t.Fatal("Expected block with an unknown parent to be invalid")
+	}
+	want := "does not match the root of the latest block"
+	if report.ParentRootErr == nil || !strings.Contains(report.ParentRootErr.Error(), want) {
+		t.Errorf("Expected parent root error containing %q, received %v", want, report.ParentRootErr)
+	}
+	if report.RandaoRevealErr == nil {
+		t.Error("Expected randao reveal to not match the reveal of a different proposer key")
+	}
+}
+
+func TestGenerateBlockAndAdvanceChain_StateRootMismatch(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	prevBlockRoot := backend.prevBlockRoots[len(backend.prevBlockRoots)-1]
+	block, blockRoot, err := generateSimulatedBlock(
+		backend.state,
+		prevBlockRoot,
+		backend.historicalDeposits,
+		&SimulatedObjects{},
+		privKeys,
+		false, /* skipEpochProcessing */
+	)
+	if err != nil {
+		t.Fatalf("Could not generate simulated block %v", err)
+	}
+	block.StateRootHash32 = []byte("bad state root")
+	numBlocks := len(backend.inMemoryBlocks)
+	slot := backend.state.Slot
+	stateRoot, err := hashutil.HashProto(backend.state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "state root of block at slot 1 does not match the state after transition"
+	if err := backend.advanceChain(block, blockRoot, prevBlockRoot); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+	if len(backend.inMemoryBlocks) != numBlocks {
+		t.Errorf("Expected block with mismatched state root to not be added, wanted %d blocks, received %d",
+			numBlocks, len(backend.inMemoryBlocks))
+	}
+	if backend.state.Slot != slot {
+		t.Errorf("Expected the rejected block to leave the state at slot %d, received %d", slot, backend.state.Slot)
+	}
+	postRoot, err := hashutil.HashProto(backend.state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if postRoot != stateRoot {
+		t.Errorf("Expected the rejected block to leave the state unchanged, root %#x != %#x", postRoot, stateRoot)
+	}
+}
+
+func TestApplyBlock_AdvancesChain(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	for i := 0; i < 2; i++ {
+		block, blockRoot, err := generateSimulatedBlock(
+			backend.state,
+			backend.prevBlockRoots[len(backend.prevBlockRoots)-1],
+			backend.historicalDeposits,
+			&SimulatedObjects{},
+			privKeys,
+			false, /* skipEpochProcessing */
+		)
+		if err != nil {
+			t.Fatalf("Could not generate simulated block %v", err)
+		}
+		if err := backend.ApplyBlock(block); err != nil {
+			t.Fatalf("Could not apply block %v", err)
+		}
+		if backend.state.Slot != block.Slot {
+			t.Errorf("Wanted state at slot %d, received %d", block.Slot, backend.state.Slot)
+		}
+		if backend.prevBlockRoots[len(backend.prevBlockRoots)-1] != blockRoot {
+			t.Errorf("Expected the root of the applied block to be tracked as the latest root")
+		}
+	}
+	if err := backend.checkBlockRoots(0); err != nil {
+		t.Errorf("Expected block roots to be consistent after applying blocks, received %v", err)
+	}
+}
+
+func TestApplyBlock_InvalidBlockLeavesBackendUnchanged(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	block, _, err := generateSimulatedBlock(
+		backend.state,
+		backend.prevBlockRoots[len(backend.prevBlockRoots)-1],
+		backend.historicalDeposits,
+		&SimulatedObjects{},
+		privKeys,
+		false, /* skipEpochProcessing */
+	)
+	if err != nil {
+		t.Fatalf("Could not generate simulated block %v", err)
+	}
+	tests := []struct {
+		tamper func(*pb.BeaconBlock)
+		want   string
+	}{
+		{
+			tamper: func(blk *pb.BeaconBlock) { blk.Slot++ },
+			want:   "expected block at slot 1, received slot 2",
+		},
+		{
+			tamper: func(blk *pb.BeaconBlock) { blk.ParentRootHash32 = []byte{'A'} },
+			want:   "does not match the root of the latest block",
+		},
+		{
+			tamper: func(blk *pb.BeaconBlock) { blk.StateRootHash32 = []byte{'B'} },
+			want:   "does not match the state after transition",
+		},
+	}
+	prevState := proto.Clone(backend.state).(*pb.BeaconState)
+	numBlocks := len(backend.inMemoryBlocks)
+	for _, tt := range tests {
+		tampered := proto.Clone(block).(*pb.BeaconBlock)
+		tt.tamper(tampered)
+		if err := backend.ApplyBlock(tampered); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error containing %q, received %v", tt.want, err)
+		}
+		if !proto.Equal(backend.state, prevState) {
+			t.Error("Expected the state to be left unchanged by a rejected block")
+		}
+		if len(backend.inMemoryBlocks) != numBlocks || len(backend.prevBlockRoots) != numBlocks {
+			t.Errorf("Expected rejected block to not be added, wanted %d blocks, received %d",
+				numBlocks, len(backend.inMemoryBlocks))
+		}
+	}
+	if err := backend.ApplyBlock(block); err != nil {
+		t.Errorf("Expected untampered block to apply after rejections, received %v", err)
+	}
+}
+
+func TestGenerateBlockAndAdvanceChain_BlockRootsStayConsistent(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	for i := 0; i < 4; i++ {
+		if i == 2 {
+			if err := backend.GenerateNilBlockAndAdvanceChain(); err != nil {
+				t.Fatalf("Could not advance the chain with a nil block %v", err)
+			}
+			continue
+		}
+		if err := backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and advance the chain %v", err)
+		}
+		if err := backend.checkBlockRoots(0); err != nil {
+			t.Errorf("Expected block roots to be consistent after advancement %d, received %v", i, err)
+		}
+	}
+
+	backend.prevBlockRoots = append(backend.prevBlockRoots, [32]byte{'A'})
+	want := "tracking 4 in memory blocks but 5 block roots"
+	if err := backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{}, privKeys); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+	backend.prevBlockRoots = backend.prevBlockRoots[:len(backend.prevBlockRoots)-1]
+	backend.prevBlockRoots[len(backend.prevBlockRoots)-1] = [32]byte{'B'}
+	want = "does not match its tracked root"
+	if err := backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{}, privKeys); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestVerifyGenesisBlockRoot_DetectsTamperedGenesisBlock(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	if _, err := backend.SetupBackend(100); err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+
+	genesisBlock := backend.inMemoryBlocks[0]
+	var stateRoot [32]byte
+	copy(stateRoot[:], genesisBlock.StateRootHash32)
+	if err := backend.verifyGenesisBlockRoot(stateRoot); err != nil {
+		t.Fatalf("Expected genesis block root to verify, received %v", err)
+	}
+
+	tests := []struct {
+		tamper func(*pb.BeaconBlock)
+		want   string
+	}{
+		{
+			tamper: func(blk *pb.BeaconBlock) { blk.ParentRootHash32 = []byte{'A'} },
+			want:   "root of stored genesis block",
+		},
+		{
+			tamper: func(blk *pb.BeaconBlock) { blk.Slot++ },
+			want:   "genesis block is at slot 1, wanted slot 0",
+		},
+	}
+	for _, tt := range tests {
+		backend.inMemoryBlocks[0] = proto.Clone(genesisBlock).(*pb.BeaconBlock)
+		tt.tamper(backend.inMemoryBlocks[0])
+		if err := backend.verifyGenesisBlockRoot(stateRoot); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error containing %q, received %v", tt.want, err)
+		}
+	}
+
+	// A genesis block built from a different state root no longer matches the tracked root,
+	// even when the stored block is consistent with it.
+	backend.inMemoryBlocks[0] = genesisBlock
+	want := "recomputed genesis block root"
+	if err := backend.verifyGenesisBlockRoot([32]byte{'B'}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestSkipEpochProcessing_AdvancesWithoutEpochTransition(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	privKeys, err := backend.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	defer backend.Shutdown()
+	defer db.TeardownDB(backend.beaconDB)
+	backend.SkipEpochProcessing(true)
+
+	// Stop right before the slot whose transition runs epoch processing.
+	for backend.state.Slot < params.BeaconConfig().GenesisSlot+params.BeaconConfig().SlotsPerEpoch-2 {
+		if err := backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and advance the chain %v", err)
+		}
+	}
+	prevBlockRoot := backend.prevBlockRoots[len(backend.prevBlockRoots)-1]
+	fullState, err := state.ExecuteStateTransition(
+		context.Background(),
+		proto.Clone(backend.state).(*pb.BeaconState),
+		nil,
+		prevBlockRoot,
+		state.DefaultConfig(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := backend.GenerateNilBlockAndAdvanceChain(); err != nil {
+		t.Fatalf("Could not advance the chain with a nil block %v", err)
+	}
+	if proto.Equal(backend.state, fullState) {
+		t.Error("Expected the state to differ from the one resulting from epoch processing")
+	}
+	wantSlot := backend.state.Slot + 1
+	// Blocks are still generated and applied consistently past the epoch boundary.
+	if err := backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{}, privKeys); err != nil {
+		t.Fatalf("Could not generate block and advance the chain past the epoch boundary %v", err)
+	}
+	if backend.state.Slot != wantSlot {
+		t.Errorf("Wanted slot %d, received %d", wantSlot, backend.state.Slot)
+	}
+}
+
+func TestSkipEpochProcessing_RefusesStateTransitionTests(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	backend.SkipEpochProcessing(true)
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			DepositsForChainStart: 8,
+			NumSlots:              2,
+			SlotsPerEpoch:         4,
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != errEpochProcessingSkipped {
+		t.Errorf("Expected error %v, received %v", errEpochProcessingSkipped, err)
+	}
+	if err := backend.compareTestCase(testCase); err != errEpochProcessingSkipped {
+		t.Errorf("Expected error %v, received %v", errEpochProcessingSkipped, err)
+	}
+}
+
+// includeAttestation generates an attestation at the current slot and advances the chain until
+// it is included in a block, forging its signature if requested.
+func includeAttestation(backend *SimulatedBackend, privKeys []*bls.SecretKey, forged bool) error {
+	att, err := generateSignedAttestation(backend.state, backend.prevBlockRoots[len(backend.prevBlockRoots)-1], 0, privKeys)
+	if err != nil {
+		return err
+	}
+	if forged {
+		att.AggregateSignature = privKeys[0].Sign([]byte("forged"), params.BeaconConfig().DomainAttestation).Marshal()
+	}
+	for i := uint64(1); i < params.BeaconConfig().MinAttestationInclusionDelay; i++ {
+		if err := backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{}, privKeys); err != nil {
+			return err
+		}
+	}
+	return backend.GenerateBlockAndAdvanceChain(&SimulatedObjects{simAttestations: []*pb.Attestation{att}}, privKeys)
+}
+
+func TestSkipAttestationSignatures_AppliesForgedAttestations(t *testing.T) {
+	tests := []struct {
+		forged  bool
+		skip    bool
+		wantErr bool
+	}{
+		{forged: false, skip: false, wantErr: false},
+		{forged: true, skip: false, wantErr: true},
+		{forged: true, skip: true, wantErr: false},
+	}
+	for _, tt := range tests {
+		backend, err := NewSimulatedBackend()
+		if err != nil {
+			t.Fatalf("Could not create a new simulated backend %v", err)
+		}
+		privKeys, err := backend.SetupBackend(64)
+		if err != nil {
+			t.Fatalf("Could not set up backend %v", err)
+		}
+		backend.SkipAttestationSignatures(tt.skip)
+		err = includeAttestation(backend, privKeys, tt.forged)
+		want := "could not verify attestation signature"
+		if tt.wantErr && (err == nil || !strings.Contains(err.Error(), want)) {
+			t.Errorf("forged=%v skip=%v: expected error containing %q, received %v", tt.forged, tt.skip, want, err)
+		}
+		if !tt.wantErr && err != nil {
+			t.Errorf("forged=%v skip=%v: could not include attestation %v", tt.forged, tt.skip, err)
+		}
+		backend.Shutdown()
+		db.TeardownDB(backend.beaconDB)
+	}
+}
+
+func TestSkipAttestationSignatures_RefusesStateTransitionTests(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	backend.SkipAttestationSignatures(true)
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			DepositsForChainStart: 8,
+			NumSlots:              2,
+			SlotsPerEpoch:         4,
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != errAttestationSignaturesSkipped {
+		t.Errorf("Expected error %v, received %v", errAttestationSignaturesSkipped, err)
+	}
+	if err := backend.compareTestCase(testCase); err != errAttestationSignaturesSkipped {
+		t.Errorf("Expected error %v, received %v", errAttestationSignaturesSkipped, err)
+	}
+}
+
+func BenchmarkGenerateBlockAndAdvanceChain_AttestationSignatures(b *testing.B) {
+	for _, skip := range []bool{false, true} {
+		name := "verify"
+		if skip {
+			name = "skip"
+		}
+		b.Run(name, func(b *testing.B) {
+			backend, err := NewSimulatedBackendWithStorage(db.MemoryStorage)
+			if err != nil {
+				b.Fatalf("Could not create a new simulated backend %v", err)
+			}
+			defer backend.Shutdown()
+			defer db.TeardownDB(backend.beaconDB)
+			privKeys, err := backend.SetupBackend(64)
+			if err != nil {
+				b.Fatalf("Could not set up backend %v", err)
+			}
+			backend.SkipAttestationSignatures(skip)
+
+			// Every block includes the attestation made MinAttestationInclusionDelay slots earlier.
+			var pending []*pb.Attestation
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				att, err := generateSignedAttestation(backend.state, backend.prevBlockRoots[len(backend.prevBlockRoots)-1], 0, privKeys)
+				if err != nil {
+					b.Fatal(err)
+				}
+				pending = append(pending, att)
+				objects := &SimulatedObjects{}
+				if uint64(len(pending)) > params.BeaconConfig().MinAttestationInclusionDelay {
+					objects.simAttestations = pending[:1]
+					pending = pending[1:]
+				}
+				b.StartTimer()
+				if err := backend.GenerateBlockAndAdvanceChain(objects, privKeys); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckEpochBalanceDirection_NoParticipation(t *testing.T) {
+	validators := make([]*pb.Validator, 8)
+	balances := make([]uint64, len(validators))
+	for i := range validators {
+		validators[i] = &pb.Validator{
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+			SlashedEpoch:    params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	preEpochState := &pb.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 2*params.BeaconConfig().SlotsPerEpoch - 1,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}
+
+	penalized := proto.Clone(preEpochState).(*pb.BeaconState)
+	penalized.ValidatorBalances[0]--
+	if err := checkEpochBalanceDirection(preEpochState, penalized); err != nil {
+		t.Errorf("Expected balance decrease without participation to pass, received %v", err)
+	}
+
+	rewarded := proto.Clone(preEpochState).(*pb.BeaconState)
+	rewarded.ValidatorBalances[0]++
+	want := "total balance increased"
+	if err := checkEpochBalanceDirection(preEpochState, rewarded); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestRunStateTransitionTest_VerifyEpochRewards(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	numSlots := 2 * params.BeaconConfig().SlotsPerEpoch
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              numSlots,
+			VerifyEpochRewards:    true,
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + numSlots,
+			NumValidators: 64,
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test with epoch rewards verification %v", err)
+	}
+}
+
+func TestRunStateTransitionTest_SurroundVoteAttesterSlashing(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	numSlots := slotsPerEpoch + 2
+	// The first configured attestation is surrounded by the second one, which
+	// spans the epoch boundary crossed during the test.
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         slotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              numSlots,
+			AttesterSlashings: []*StateTestAttesterSlashing{
+				{
+					Slot:                                  genesisSlot + slotsPerEpoch + 1,
+					SlashableAttestation1JustifiedEpoch:   genesisEpoch + 1,
+					SlashableAttestation1TargetEpoch:      genesisEpoch + 1,
+					SlashableAttestation1CustodyBitField:  "\x80",
+					SlashableAttestation1ValidatorIndices: []uint64{1, 2, 3},
+					SlashableAttestation2JustifiedEpoch:   genesisEpoch,
+					SlashableAttestation2TargetEpoch:      genesisEpoch + 2,
+					SlashableAttestation2CustodyBitField:  "\x80",
+					SlashableAttestation2ValidatorIndices: []uint64{2, 3, 4},
+				},
+			},
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + numSlots,
+			NumValidators: 64,
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test with surround vote slashing %v", err)
+	}
+	for _, idx := range []uint64{2, 3} {
+		if backend.state.ValidatorRegistry[idx].SlashedEpoch == params.BeaconConfig().FarFutureEpoch {
+			t.Errorf("Expected validator at index %d to have been slashed", idx)
+		}
+	}
+	for _, idx := range []uint64{1, 4} {
+		if backend.state.ValidatorRegistry[idx].SlashedEpoch != params.BeaconConfig().FarFutureEpoch {
+			t.Errorf("Expected validator at index %d to not have been slashed", idx)
+		}
+	}
+}
+
+func TestRunStateTransitionTest_MultipleValidatorExitsInEpoch(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	exitedIndices := []uint64{3, 9, 27}
+	exits := make([]*StateTestValidatorExit, len(exitedIndices))
+	for i, idx := range exitedIndices {
+		exits[i] = &StateTestValidatorExit{Epoch: genesisEpoch, ValidatorIndex: idx}
+	}
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         slotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              2,
+			ValidatorExits:        exits,
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 2,
+			NumValidators: 64,
+		},
+	}
+	if err := backend.RunStateTransitionTest(testCase); err != nil {
+		t.Fatalf("Could not run state transition test with multiple exits %v", err)
+	}
+	block := backend.inMemoryBlocks[len(backend.inMemoryBlocks)-1]
+	if len(block.Body.VoluntaryExits) != len(exitedIndices) {
+		t.Fatalf("Expected %d voluntary exits in block, received %d", len(exitedIndices), len(block.Body.VoluntaryExits))
+	}
+	for _, exit := range block.Body.VoluntaryExits {
+		if len(exit.Signature) == 0 {
+			t.Errorf("Expected exit of validator at index %d to be signed", exit.ValidatorIndex)
+		}
+	}
+	for _, idx := range exitedIndices {
+		if backend.state.ValidatorRegistry[idx].StatusFlags != pb.Validator_INITIATED_EXIT {
+			t.Errorf("Expected validator at index %d to have exited", idx)
+		}
+	}
+}
+
+func TestRunStateTransitionTest_TooManyValidatorExitsInEpoch(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	exits := make([]*StateTestValidatorExit, params.BeaconConfig().MaxVoluntaryExits+1)
+	for i := range exits {
+		exits[i] = &StateTestValidatorExit{Epoch: genesisEpoch, ValidatorIndex: uint64(i)}
+	}
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              1,
+			ValidatorExits:        exits,
+		},
+		Results: &StateTestResults{
+			Slot:          params.BeaconConfig().GenesisSlot + 1,
+			NumValidators: 64,
+		},
+	}
+	want := "exceed the maximum of"
+	if err := backend.RunStateTransitionTest(testCase); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestRunStateTransitionTest_AttestationInclusionDelay(t *testing.T) {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	minDelay := params.BeaconConfig().MinAttestationInclusionDelay
+	attestationSlot := genesisSlot + 1
+	immediateSlot := attestationSlot + minDelay
+	delayedSlot := attestationSlot + 2*minDelay
+	// The attestation is made in the genesis epoch, and the proposer of the block including it
+	// is rewarded for the inclusion when the following epoch is processed.
+	numSlots := 2 * params.BeaconConfig().SlotsPerEpoch
+	runWithDelay := func(delay uint64) (*simulatedAttestation, *pb.BeaconState, map[uint64]uint64) {
+		backend, err := NewSimulatedBackend()
+		if err != nil {
+			t.Fatalf("Could not create a new simulated backend %v", err)
+		}
+		defer backend.Shutdown()
+		c := params.BeaconConfig()
+		depositsForChainStart := c.DepositsForChainStart
+		defer func() {
+			c.DepositsForChainStart = depositsForChainStart
+		}()
+		proposers := make(map[uint64]uint64)
+		testCase := &StateTestCase{
+			Config: &StateTestConfig{
+				SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+				DepositsForChainStart: 64,
+				NumSlots:              numSlots,
+				Attestations: []*StateTestAttestation{
+					{Slot: attestationSlot, InclusionDelay: delay},
+				},
+			},
+			Results: &StateTestResults{
+				Slot:          genesisSlot + numSlots,
+				NumValidators: 64,
+			},
+			SlotCallback: func(state *pb.BeaconState) error {
+				if state.Slot != immediateSlot && state.Slot != delayedSlot {
+					return nil
+				}
+				proposer, err := helpers.BeaconProposerIndex(state, state.Slot)
+				if err != nil {
+					return err
+				}
+				proposers[state.Slot] = proposer
+				return nil
+			},
+		}
+		if err := backend.RunStateTransitionTest(testCase); err != nil {
+			t.Fatalf("Could not run state transition test with inclusion delay %d: %v", delay, err)
+		}
+		if len(backend.attestations) != 1 {
+			t.Fatalf("Expected 1 simulated attestation, received %d", len(backend.attestations))
+		}
+		return backend.attestations[0], backend.state, proposers
+	}
+
+	immediate, immediateState, proposers := runWithDelay(0)
+	if immediate.includedSlot != immediateSlot {
+		t.Errorf(
+			"Expected attestation to be included at slot %d by default, received %d",
+			immediateSlot-genesisSlot,
+			immediate.includedSlot-genesisSlot,
+		)
+	}
+	delayed, delayedState, delayedProposers := runWithDelay(2 * minDelay)
+	if delayed.includedSlot != delayedSlot {
+		t.Errorf(
+			"Expected attestation to be included at slot %d, received %d",
+			delayedSlot-genesisSlot,
+			delayed.includedSlot-genesisSlot,
+		)
+	}
+	if !reflect.DeepEqual(proposers, delayedProposers) || proposers[immediateSlot] == proposers[delayedSlot] {
+		t.Fatalf("Expected the same distinct proposers in both runs, received %v and %v", proposers, delayedProposers)
+	}
+	// Only the proposer of the block including the attestation is rewarded for its inclusion.
+	immediateProposer := proposers[immediateSlot]
+	if immediateState.ValidatorBalances[immediateProposer] <= delayedState.ValidatorBalances[immediateProposer] {
+		t.Errorf(
+			"Expected proposer %d of slot %d to be rewarded for including the attestation, received balance %d and %d without it",
+			immediateProposer,
+			immediateSlot-genesisSlot,
+			immediateState.ValidatorBalances[immediateProposer],
+			delayedState.ValidatorBalances[immediateProposer],
+		)
+	}
+	delayedProposer := proposers[delayedSlot]
+	if delayedState.ValidatorBalances[delayedProposer] <= immediateState.ValidatorBalances[delayedProposer] {
+		t.Errorf(
+			"Expected proposer %d of slot %d to be rewarded for including the attestation, received balance %d and %d without it",
+			delayedProposer,
+			delayedSlot-genesisSlot,
+			delayedState.ValidatorBalances[delayedProposer],
+			immediateState.ValidatorBalances[delayedProposer],
+		)
+	}
+}
+
+func TestRunStateTransitionTest_AttestationInclusionDelayTooLong(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	c := params.BeaconConfig()
+	depositsForChainStart := c.DepositsForChainStart
+	defer func() {
+		c.DepositsForChainStart = depositsForChainStart
+	}()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:         params.BeaconConfig().SlotsPerEpoch,
+			DepositsForChainStart: 64,
+			NumSlots:              2,
+			Attestations: []*StateTestAttestation{
+				{Slot: genesisSlot + 1, InclusionDelay: params.BeaconConfig().SlotsPerEpoch + 1},
+			},
+		},
+		Results: &StateTestResults{
+			Slot:          genesisSlot + 2,
+			NumValidators: 64,
+		},
+	}
+	want := "inclusion delay"
+	if err := backend.RunStateTransitionTest(testCase); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestGenerateAttesterSlashing_NotSlashable(t *testing.T) {
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	simSlashing := &StateTestAttesterSlashing{
+		SlashableAttestation1JustifiedEpoch: genesisEpoch,
+		SlashableAttestation1TargetEpoch:    genesisEpoch + 1,
+		SlashableAttestation2JustifiedEpoch: genesisEpoch + 1,
+		SlashableAttestation2TargetEpoch:    genesisEpoch + 2,
+	}
+	want := "neither a double vote nor surround vote"
+	if _, err := generateAttesterSlashing(simSlashing); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestRunForkChoiceTest_ImpossibleCommitteeSize(t *testing.T) {
+	tests := []struct {
+		config *ForkChoiceTestConfig
+		want   string
+	}{
+		{
+			config: &ForkChoiceTestConfig{ValidatorCount: 4, CycleLength: 4, ShardCount: 1, MinCommitteeSize: 8},
+			want:   "4 validators cannot fill a committee of the min committee size 8",
+		},
+		{
+			config: &ForkChoiceTestConfig{ValidatorCount: 4, CycleLength: 4, ShardCount: 1},
+			want:   "min committee size must be greater than zero",
+		},
+	}
+	for _, tt := range tests {
+		backend, err := NewSimulatedBackend()
+		if err != nil {
+			t.Fatalf("Could not create a new simulated backend %v", err)
+		}
+		err = backend.RunForkChoiceTest(&ForkChoiceTestCase{Config: tt.config})
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error containing %q, received %v", tt.want, err)
+		}
+	}
+}
+
+func TestRunForkChoiceTest_RestoresConfig(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	prevConfig := params.SnapshotConfig()
+	testCase := &ForkChoiceTestCase{
+		Config: &ForkChoiceTestConfig{
+			ValidatorCount:   16,
+			CycleLength:      prevConfig.SlotsPerEpoch * 2,
+			ShardCount:       prevConfig.ShardCount + 1,
+			MinCommitteeSize: 4,
+		},
+	}
+	if err := backend.RunForkChoiceTest(testCase); err != nil {
+		t.Fatalf("Could not run fork choice test %v", err)
+	}
+	if !reflect.DeepEqual(params.BeaconConfig(), prevConfig) {
+		t.Errorf("Expected beacon config to be restored after the fork choice test, wanted %v, received %v",
+			prevConfig, params.BeaconConfig())
+	}
+}
+
+func TestVerifySimulatedDeposits_InvalidProofOfPossession(t *testing.T) {
+	deposits, _, err := generateInitialSimulatedDeposits(2)
+	if err != nil {
+		t.Fatalf("Could not generate initial deposits %v", err)
+	}
+	if err := verifySimulatedDeposits(deposits); err != nil {
+		t.Fatalf("Expected generated deposits to verify, received %v", err)
+	}
+
+	// Replace the proof of possession of the second deposit with the one of the first deposit.
+	firstInput, err := helpers.DecodeDepositInput(deposits[0].DepositData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondInput, err := helpers.DecodeDepositInput(deposits[1].DepositData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondInput.ProofOfPossession = firstInput.ProofOfPossession
+	deposits[1].DepositData, err = helpers.EncodeDepositData(secondInput, params.BeaconConfig().MaxDepositAmount, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "proof of possession of deposit 1 did not verify"
+	if err := verifySimulatedDeposits(deposits); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestVerifySimulatedDeposits_IdentifiesBadDepositAfterFailedBatch(t *testing.T) {
+	deposits, privKeys, err := generateInitialSimulatedDeposits(8)
+	if err != nil {
+		t.Fatalf("Could not generate initial deposits %v", err)
+	}
+	// Sign the wrong data with the key of the sixth deposit, so its signature alone
+	// makes the aggregated signature of the deposits fail.
+	badInput, err := helpers.DecodeDepositInput(deposits[5].DepositData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	badInput.ProofOfPossession = privKeys[5].Sign([]byte("wrong data"), params.BeaconConfig().DomainDeposit).Marshal()
+	deposits[5].DepositData, err = helpers.EncodeDepositData(badInput, params.BeaconConfig().MaxDepositAmount, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "proof of possession of deposit 5 did not verify"
+	if err := verifySimulatedDeposits(deposits); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestRunStateTransitionTests_AggregatesResults(t *testing.T) {
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	newTestCase := func(secondsPerSlot uint64, numValidators int) *StateTestCase {
+		return &StateTestCase{
+			Config: &StateTestConfig{
+				SlotsPerEpoch:         slotsPerEpoch,
+				DepositsForChainStart: 64,
+				NumSlots:              2,
+				SecondsPerSlot:        secondsPerSlot,
+			},
+			Results: &StateTestResults{
+				Slot:          genesisSlot + 2,
+				NumValidators: numValidators,
+			},
+		}
+	}
+	testCases := []*StateTestCase{
+		newTestCase(0, 64),
+		newTestCase(secondsPerSlot+10, 64),
+		newTestCase(0, 65),
+		newTestCase(secondsPerSlot+10, 64),
+	}
+	// Every test case must observe the config it overrides, even when run next to others.
+	for _, testCase := range testCases {
+		wanted := testCase.Config.SecondsPerSlot
+		if wanted == 0 {
+			wanted = secondsPerSlot
+		}
+		testCase.SlotCallback = func(state *pb.BeaconState) error {
+			if params.BeaconConfig().SecondsPerSlot != wanted {
+				return fmt.Errorf("expected %d seconds per slot, received %d", wanted, params.BeaconConfig().SecondsPerSlot)
+			}
+			return nil
+		}
+	}
+
+	summary := RunStateTransitionTests(testCases, 2)
+	if summary.Passed != 3 || summary.Failed != 1 {
+		t.Fatalf("Expected 3 passed and 1 failed test cases, received %d passed and %d failed", summary.Passed, summary.Failed)
+	}
+	if summary.Results[2].Err == nil {
+		t.Error("Expected test case with the wrong number of validators to fail")
+	}
+	if err := summary.Err(); err == nil || !strings.Contains(err.Error(), "1 of 4 state test cases failed") {
+		t.Errorf("Unexpected summary error, received %v", err)
+	}
+	if params.BeaconConfig().SecondsPerSlot != secondsPerSlot {
+		t.Errorf("Expected seconds per slot to be restored to %d, received %d", secondsPerSlot, params.BeaconConfig().SecondsPerSlot)
+	}
+}
+
+func TestRunStateTransitionTest_Eth1DataVote(t *testing.T) {
+	tests := []struct {
+		name      string
+		voteCount uint64
+		wantVotes uint64
+		wantHash  []byte
+	}{
+		{name: "crosses threshold", voteCount: 0, wantVotes: 33, wantHash: []byte("eth1 block")},
+		{name: "below threshold", voteCount: 32, wantVotes: 32},
+	}
+	for _, tt := range tests {
+		backend, err := NewSimulatedBackend()
+		if err != nil {
+			t.Fatalf("Could not create a new simulated backend %v", err)
+		}
+		genesisSlot := params.BeaconConfig().GenesisSlot
+		testCase := &StateTestCase{
+			Config: &StateTestConfig{
+				SlotsPerEpoch:             params.BeaconConfig().SlotsPerEpoch,
+				EpochsPerEth1VotingPeriod: 1,
+				DepositsForChainStart:     64,
+				NumSlots:                  params.BeaconConfig().SlotsPerEpoch + 2,
+				// Skipping a slot of the voting period only delays the votes.
+				SkipSlots: []uint64{genesisSlot + 8},
+				Eth1DataVote: &StateTestEth1DataVote{
+					BlockHash: "eth1 block",
+					VoteCount: tt.voteCount,
+				},
+			},
+			Results: &StateTestResults{
+				Slot:          genesisSlot + params.BeaconConfig().SlotsPerEpoch + 2,
+				NumValidators: 64,
+			},
+		}
+		if err := backend.RunStateTransitionTest(testCase); err != nil {
+			t.Fatalf("%s: could not run state transition test %v", tt.name, err)
+		}
+		if backend.eth1VotesCast != tt.wantVotes {
+			t.Errorf("%s: wanted %d votes cast, received %d", tt.name, tt.wantVotes, backend.eth1VotesCast)
+		}
+		if tt.wantHash != nil && !bytes.Equal(backend.State().LatestEth1Data.BlockHash32, tt.wantHash) {
+			t.Errorf("%s: wanted latest eth1 block hash %#x, received %#x", tt.name, tt.wantHash, backend.State().LatestEth1Data.BlockHash32)
+		}
+		if tt.wantHash == nil && bytes.Equal(backend.State().LatestEth1Data.BlockHash32, []byte("eth1 block")) {
+			t.Errorf("%s: expected eth1 block hash not to be adopted", tt.name)
+		}
+		backend.Shutdown()
+	}
+}
+
+func TestRunStateTransitionTest_Eth1DataVoteOutsideRun(t *testing.T) {
+	backend, err := NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer backend.Shutdown()
+	testCase := &StateTestCase{
+		Config: &StateTestConfig{
+			SlotsPerEpoch:             params.BeaconConfig().SlotsPerEpoch,
+			EpochsPerEth1VotingPeriod: 1,
+			DepositsForChainStart:     64,
+			NumSlots:                  params.BeaconConfig().SlotsPerEpoch / 2,
+			Eth1DataVote: &StateTestEth1DataVote{
+				BlockHash: "eth1 block",
+			},
+		},
+	}
+	want := "after the 32 slots of the test run"
+	if err := backend.RunStateTransitionTest(testCase); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
@@ -0,0 +1,84 @@
+package backend
+
+import (
+	"fmt"
+	"time"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// simulatedPOWChain mimics the chain start behavior of the powchain service
+// by collecting deposit data and firing a ChainStart event over its feed once
+// the number of deposits reaches the configured chain start threshold.
+type simulatedPOWChain struct {
+	chainStartFeed     *event.Feed
+	chainStartDeposits [][]byte
+	chainStarted       bool
+	chainStartETH1Data *pb.Eth1Data
+	genesisTime        time.Time
+}
+
+// newSimulatedPOWChain creates a simulated powchain which uses the given
+// time as the timestamp of its ChainStart log.
+func newSimulatedPOWChain(genesisTime time.Time) *simulatedPOWChain {
+	return &simulatedPOWChain{
+		chainStartFeed:     new(event.Feed),
+		chainStartDeposits: [][]byte{},
+		chainStartETH1Data: &pb.Eth1Data{},
+		genesisTime:        genesisTime,
+	}
+}
+
+// ChainStartFeed returns a feed that is written to
+// whenever the simulated deposit contract fires a ChainStart log.
+func (p *simulatedPOWChain) ChainStartFeed() *event.Feed {
+	return p.chainStartFeed
+}
+
+// ChainStartDeposits returns a slice of validator deposit data processed
+// by the simulated deposit contract before the ChainStart log.
+func (p *simulatedPOWChain) ChainStartDeposits() [][]byte {
+	return p.chainStartDeposits
+}
+
+// ChainStartETH1Data returns the eth1 data at chainstart.
+func (p *simulatedPOWChain) ChainStartETH1Data() *pb.Eth1Data {
+	return p.chainStartETH1Data
+}
+
+// ProcessDeposit registers the deposit data with the simulated deposit contract,
+// firing the ChainStart log once the chain start threshold has been reached.
+// Deposits received after chain start are not tracked.
+func (p *simulatedPOWChain) ProcessDeposit(depositData []byte) error {
+	if p.chainStarted {
+		return nil
+	}
+	p.chainStartDeposits = append(p.chainStartDeposits, depositData)
+	if uint64(len(p.chainStartDeposits)) < params.BeaconConfig().DepositsForChainStart {
+		return nil
+	}
+	return p.processChainStartLog()
+}
+
+// processChainStartLog computes the chain start deposit root in the same way
+// as the deposit contract and notifies subscribers of the chain start time.
+func (p *simulatedPOWChain) processChainStartLog() error {
+	depositTrie, err := trieutil.GenerateTrieFromItems(
+		p.chainStartDeposits,
+		int(params.BeaconConfig().DepositContractTreeDepth),
+	)
+	if err != nil {
+		return fmt.Errorf("could not generate deposit trie from chain start deposits: %v", err)
+	}
+	depositRoot := depositTrie.Root()
+	p.chainStartETH1Data = &pb.Eth1Data{
+		BlockHash32:       make([]byte, 32),
+		DepositRootHash32: depositRoot[:],
+	}
+	p.chainStarted = true
+	p.chainStartFeed.Send(p.genesisTime)
+	return nil
+}
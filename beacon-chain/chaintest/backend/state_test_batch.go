@@ -0,0 +1,120 @@
+package backend
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// StateTestCaseResult is the outcome of a single state test case run as part of a batch.
+type StateTestCaseResult struct {
+	TestCase *StateTestCase
+	Err      error
+}
+
+// StateTestBatchSummary aggregates the results of a batch of state test cases, in the
+// order the test cases were given.
+type StateTestBatchSummary struct {
+	Results []*StateTestCaseResult
+	Passed  int
+	Failed  int
+}
+
+// Err returns an error describing every failed test case of the batch, or nil if all of
+// them passed.
+func (s *StateTestBatchSummary) Err() error {
+	if s.Failed == 0 {
+		return nil
+	}
+	failures := make([]string, 0, s.Failed)
+	for i, result := range s.Results {
+		if result.Err != nil {
+			failures = append(failures, fmt.Sprintf("test case %d: %v", i, result.Err))
+		}
+	}
+	return fmt.Errorf("%d of %d state test cases failed: %s", s.Failed, len(s.Results), strings.Join(failures, "; "))
+}
+
+// stateTestConfigKey holds the beacon config values a state test case overrides. Test cases
+// with equal keys can share a single override of the global beacon config.
+type stateTestConfigKey struct {
+	slotsPerEpoch         uint64
+	depositsForChainStart uint64
+	secondsPerSlot        uint64
+	baseRewardQuotient    uint64
+	epochsPerEth1Period   uint64
+}
+
+func configKey(testCase *StateTestCase) stateTestConfigKey {
+	return stateTestConfigKey{
+		slotsPerEpoch:         testCase.Config.SlotsPerEpoch,
+		depositsForChainStart: testCase.Config.DepositsForChainStart,
+		secondsPerSlot:        testCase.Config.SecondsPerSlot,
+		baseRewardQuotient:    testCase.Config.BaseRewardQuotient,
+		epochsPerEth1Period:   testCase.Config.EpochsPerEth1VotingPeriod,
+	}
+}
+
+// RunStateTransitionTests runs independent state test cases, each on its own simulated
+// backend and database, with up to parallelism test cases running at the same time.
+//
+// The beacon config is global to the process, so test cases are grouped by the config
+// options they override. Groups run one after another with the config overridden once
+// for the whole group, and only test cases of the same group run concurrently.
+func RunStateTransitionTests(testCases []*StateTestCase, parallelism int) *StateTestBatchSummary {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	summary := &StateTestBatchSummary{
+		Results: make([]*StateTestCaseResult, len(testCases)),
+	}
+	var keys []stateTestConfigKey
+	groups := make(map[stateTestConfigKey][]int)
+	for i, testCase := range testCases {
+		key := configKey(testCase)
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], i)
+	}
+
+	for _, key := range keys {
+		group := groups[key]
+		restoreConfig := setTestConfig(testCases[group[0]])
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, parallelism)
+		for _, idx := range group {
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(idx int) {
+				defer wg.Done()
+				defer func() { <-sem }()
+				summary.Results[idx] = &StateTestCaseResult{
+					TestCase: testCases[idx],
+					Err:      runIsolatedStateTest(testCases[idx]),
+				}
+			}(idx)
+		}
+		wg.Wait()
+		restoreConfig()
+	}
+
+	for _, result := range summary.Results {
+		if result.Err != nil {
+			summary.Failed++
+		} else {
+			summary.Passed++
+		}
+	}
+	return summary
+}
+
+// runIsolatedStateTest runs the test case on a simulated backend of its own, whose
+// database is torn down once the run completes.
+func runIsolatedStateTest(testCase *StateTestCase) error {
+	sb, err := NewSimulatedBackend()
+	if err != nil {
+		return fmt.Errorf("could not create simulated backend: %v", err)
+	}
+	return sb.runStateTransitionTest(testCase)
+}
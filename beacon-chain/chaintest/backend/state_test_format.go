@@ -1,5 +1,9 @@
 package backend
 
+import (
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
 // StateTest --
 type StateTest struct {
 	Title     string
@@ -14,6 +18,9 @@ type StateTest struct {
 type StateTestCase struct {
 	Config  *StateTestConfig  `yaml:"config"`
 	Results *StateTestResults `yaml:"results"`
+	// SlotCallback is optional and, if set, is invoked with the resulting state after
+	// every slot transition of the test run. Returning an error aborts the run.
+	SlotCallback func(state *pb.BeaconState) error `yaml:"-"`
 }
 
 // StateTestConfig --
@@ -24,18 +31,59 @@ type StateTestConfig struct {
 	ProposerSlashings     []*StateTestProposerSlashing `yaml:"proposer_slashings"`
 	AttesterSlashings     []*StateTestAttesterSlashing `yaml:"attester_slashings"`
 	ValidatorExits        []*StateTestValidatorExit    `yaml:"validator_exits"`
+	Attestations          []*StateTestAttestation      `yaml:"attestations"`
 	SlotsPerEpoch         uint64                       `yaml:"slots_per_epoch"`
 	ShardCount            uint64                       `yaml:"shard_count"`
 	DepositsForChainStart uint64                       `yaml:"deposits_for_chain_start"`
 	NumSlots              uint64                       `yaml:"num_slots"`
+	VerifyEpochRewards    bool                         `yaml:"verify_epoch_rewards"`
+	// SecondsPerSlot is optional and, if set, overrides the slot duration for the test run.
+	SecondsPerSlot uint64 `yaml:"seconds_per_slot"`
+	// BaseRewardQuotient is optional and, if set, overrides the quotient the base reward of
+	// validators is derived from for the test run.
+	BaseRewardQuotient uint64 `yaml:"base_reward_quotient"`
+	// SkipDepositVerification disables checking the proofs of possession of the initial
+	// deposits, which speeds up the setup of benchmarks with large validator registries.
+	SkipDepositVerification bool `yaml:"skip_deposit_verification"`
+	// EmptyBodySlots lists slots at which a block with no operations is processed, even if
+	// operations are scheduled for them, to isolate the slot advancement path.
+	EmptyBodySlots []uint64 `yaml:"empty_body_slots"`
+	// SnapshotInterval is optional and, if set, saves the state as a historical state keyed
+	// by the latest block root every SnapshotInterval slots, to inspect intermediate points
+	// of long runs.
+	SnapshotInterval uint64 `yaml:"snapshot_interval"`
+	// EpochsPerEth1VotingPeriod is optional and, if set, overrides the number of epochs of an
+	// eth1 data voting period for the test run.
+	EpochsPerEth1VotingPeriod uint64 `yaml:"epochs_per_eth1_voting_period"`
+	// Eth1DataVote is optional and, if set, has blocks of a voting period vote for an eth1
+	// block hash, and checks the state adopts it once the votes cross the voting threshold.
+	Eth1DataVote *StateTestEth1DataVote `yaml:"eth1_data_vote"`
 }
 
 // StateTestDeposit --
+//
+// If top up is set, the deposit uses the public key and withdrawal credentials of the
+// existing validator at the validator index and increases its balance instead of adding
+// a new validator to the registry.
 type StateTestDeposit struct {
-	Slot        uint64 `yaml:"slot"`
-	Amount      uint64 `yaml:"amount"`
-	MerkleIndex uint64 `yaml:"merkle_index"`
-	Pubkey      string `yaml:"pubkey"`
+	Slot           uint64 `yaml:"slot"`
+	Amount         uint64 `yaml:"amount"`
+	MerkleIndex    uint64 `yaml:"merkle_index"`
+	Pubkey         string `yaml:"pubkey"`
+	TopUp          bool   `yaml:"top_up"`
+	ValidatorIndex uint64 `yaml:"validator_index"`
+}
+
+// StateTestEth1DataVote --
+//
+// The voting period is counted from genesis. Its first vote count blocks vote for the block
+// hash, and if vote count is 0, just enough blocks vote for it to cross the threshold. Skipped
+// slots cast no vote, and no deposit may be scheduled in the voting period, as a deposit changes
+// the deposit root the blocks vote for.
+type StateTestEth1DataVote struct {
+	VotingPeriod uint64 `yaml:"voting_period"`
+	BlockHash    string `yaml:"block_hash"`
+	VoteCount    uint64 `yaml:"vote_count"`
 }
 
 // StateTestProposerSlashing --
@@ -51,16 +99,22 @@ type StateTestProposerSlashing struct {
 }
 
 // StateTestAttesterSlashing --
+//
+// The justified epoch of each slashable attestation acts as its source epoch. If a target
+// epoch is set, the attestation slot is derived from the start slot of that epoch, which
+// allows configuring surround votes that span epoch boundaries.
 type StateTestAttesterSlashing struct {
 	Slot                                  uint64   `yaml:"slot"`
 	SlashableAttestation1Slot             uint64   `yaml:"slashable_attestation_1_slot"`
 	SlashableAttestation1JustifiedEpoch   uint64   `yaml:"slashable_attestation_1_justified_epoch"`
 	SlashableAttestation1ValidatorIndices []uint64 `yaml:"slashable_attestation_1_validator_indices"`
 	SlashableAttestation1CustodyBitField  string   `yaml:"slashable_attestation_1_custody_bitfield"`
+	SlashableAttestation1TargetEpoch      uint64   `yaml:"slashable_attestation_1_target_epoch"`
 	SlashableAttestation2Slot             uint64   `yaml:"slashable_attestation_2_slot"`
 	SlashableAttestation2JustifiedEpoch   uint64   `yaml:"slashable_attestation_2_justified_epoch"`
 	SlashableAttestation2ValidatorIndices []uint64 `yaml:"slashable_attestation_2_validator_indices"`
 	SlashableAttestation2CustodyBitField  string   `yaml:"slashable_attestation_2_custody_bitfield"`
+	SlashableAttestation2TargetEpoch      uint64   `yaml:"slashable_attestation_2_target_epoch"`
 }
 
 // StateTestValidatorExit --
@@ -69,10 +123,35 @@ type StateTestValidatorExit struct {
 	ValidatorIndex uint64 `yaml:"validator_index"`
 }
 
+// StateTestAttestation --
+//
+// The attestation is made by every member of a crosslink committee at the slot, the committee
+// index selecting one of the committees of the slot. It is held by the backend and included
+// in the block at the slot plus the inclusion delay, which defaults to the minimum attestation
+// inclusion delay. If that block is skipped or has an empty body, the attestation is included
+// in the next block it is still valid for.
+type StateTestAttestation struct {
+	Slot           uint64 `yaml:"slot"`
+	CommitteeIndex uint64 `yaml:"committee_index"`
+	InclusionDelay uint64 `yaml:"inclusion_delay"`
+}
+
 // StateTestResults --
 type StateTestResults struct {
 	Slot              uint64
 	NumValidators     int      `yaml:"num_validators"`
 	SlashedValidators []uint64 `yaml:"slashed_validators"`
 	ExitedValidators  []uint64 `yaml:"exited_validators"`
+	// EffectiveBalances is optional and lists validators whose effective balance at the
+	// end of the run is checked.
+	EffectiveBalances []*StateTestEffectiveBalance `yaml:"effective_balances"`
+}
+
+// StateTestEffectiveBalance --
+//
+// Penalties and rewards are applied to balances at epoch boundaries, so the run must cross
+// the boundary following an operation for its effect on the effective balance to show.
+type StateTestEffectiveBalance struct {
+	ValidatorIndex   uint64 `yaml:"validator_index"`
+	EffectiveBalance uint64 `yaml:"effective_balance"`
 }
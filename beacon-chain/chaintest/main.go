@@ -68,7 +68,9 @@ func readTestsFromYaml(yamlDir string) ([]interface{}, error) {
 	return tests, nil
 }
 
-func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
+// runTests runs every test on the simulated backend. If parallelism is greater than one, the
+// cases of each state test are run concurrently, every one on a backend of its own.
+func runTests(tests []interface{}, sb *backend.SimulatedBackend, parallelism int) error {
 	for _, tt := range tests {
 		switch typedTest := tt.(type) {
 		case *backend.ForkChoiceTest:
@@ -99,6 +101,15 @@ func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
 			log.Infof("Test Suite: %v", typedTest.TestSuite)
 			log.Infof("Fork: %v", typedTest.Fork)
 			log.Infof("Version: %v", typedTest.Version)
+			if parallelism > 1 {
+				summary := backend.RunStateTransitionTests(typedTest.TestCases, parallelism)
+				log.Infof("%d test cases passed, %d failed", summary.Passed, summary.Failed)
+				if err := summary.Err(); err != nil {
+					return fmt.Errorf("chain test failed: %v", err)
+				}
+				log.Info("Test PASSED")
+				break
+			}
 			for _, testCase := range typedTest.TestCases {
 				if err := sb.RunStateTransitionTest(testCase); err != nil {
 					return fmt.Errorf("chain test failed: %v", err)
@@ -115,8 +126,16 @@ func runTests(tests []interface{}, sb *backend.SimulatedBackend) error {
 
 func main() {
 	var yamlDir = flag.String("tests-dir", "", "path to directory of yaml tests")
+	var verbosity = flag.String("verbosity", "info", "logging verbosity (debug, info=default, warn, error, fatal, panic)")
+	var parallelism = flag.Int("parallelism", 1, "number of state test cases to run concurrently")
 	flag.Parse()
 
+	level, err := log.ParseLevel(*verbosity)
+	if err != nil {
+		log.Fatalf("Could not parse verbosity: %v", err)
+	}
+	log.SetLevel(level)
+
 	customFormatter := new(prefixed.TextFormatter)
 	customFormatter.TimestampFormat = "2006-01-02 15:04:05"
 	customFormatter.FullTimestamp = true
@@ -135,7 +154,7 @@ func main() {
 	log.Info("----Running Tests----")
 	startTime := time.Now()
 
-	err = runTests(tests, sb)
+	err = runTests(tests, sb, *parallelism)
 	if err != nil {
 		log.Fatalf("Test failed %v", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/chaintest/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 )
 
@@ -24,7 +25,7 @@ func TestFromYaml_Pass(t *testing.T) {
 		t.Fatalf("Could not create backend: %v", err)
 	}
 
-	if err := runTests(tests, sb); err != nil {
+	if err := runTests(tests, sb, 1); err != nil {
 		t.Errorf("Failed to run yaml tests %v", err)
 	}
 }
@@ -35,14 +36,14 @@ func BenchmarkStateTestFromYaml(b *testing.B) {
 		b.Fatalf("Failed to read yaml files: %v", err)
 	}
 
-	sb, err := backend.NewSimulatedBackend()
+	sb, err := backend.NewSimulatedBackendWithStorage(db.MemoryStorage)
 	if err != nil {
 		b.Fatalf("Could not create backend: %v", err)
 	}
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		if err := runTests(tests, sb); err != nil {
+		if err := runTests(tests, sb, 1); err != nil {
 			b.Errorf("Failed to run yaml tests %v", err)
 		}
 	}
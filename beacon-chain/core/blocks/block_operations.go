@@ -27,12 +27,31 @@ import (
 // the correct proposer created an incoming beacon block during state
 // transition processing.
 //
-// WIP - this is stubbed out until BLS is integrated into Prysm.
+// Official spec definition for proposer signature verification:
+//   Let proposal = Proposal(block.slot, BEACON_CHAIN_SHARD_NUMBER, signed_root(block, "signature"), block.signature).
+//   Verify that bls_verify(pubkey=state.validator_registry[get_beacon_proposer_index(state, state.slot)].pubkey,
+//     message_hash=signed_root(proposal, "signature"), signature=proposal.signature,
+//     domain=get_domain(state.fork, get_current_epoch(state), DOMAIN_PROPOSAL)).
 func VerifyProposerSignature(
-	_ *pb.BeaconBlock,
+	beaconState *pb.BeaconState,
+	block *pb.BeaconBlock,
 ) error {
-
-	return nil
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		return fmt.Errorf("could not get proposer index: %v", err)
+	}
+	// The block root ignores the block signature.
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return fmt.Errorf("could not tree hash block: %v", err)
+	}
+	proposal := &pb.ProposalSignedData{
+		Slot:            block.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: blockRoot[:],
+	}
+	proposer := beaconState.ValidatorRegistry[proposerIdx]
+	return verifyProposalSignature(beaconState, proposer, proposal, block.Signature)
 }
 
 // ProcessEth1DataInBlock is an operation performed on each
@@ -171,7 +190,7 @@ func ProcessProposerSlashings(
 	}
 	var err error
 	for idx, slashing := range body.ProposerSlashings {
-		if err = verifyProposerSlashing(slashing, verifySignatures); err != nil {
+		if err = verifyProposerSlashing(beaconState, slashing, verifySignatures); err != nil {
 			return nil, fmt.Errorf("could not verify proposer slashing #%d: %v", idx, err)
 		}
 		proposer := registry[slashing.ProposerIndex]
@@ -187,6 +206,7 @@ func ProcessProposerSlashings(
 }
 
 func verifyProposerSlashing(
+	beaconState *pb.BeaconState,
 	slashing *pb.ProposerSlashing,
 	verifySignatures bool,
 ) error {
@@ -214,8 +234,40 @@ func verifyProposerSlashing(
 		return fmt.Errorf("slashing proposal data block roots do not match: %#x, %#x", root1, root2)
 	}
 	if verifySignatures {
-		// TODO(#258): Verify BLS according to the specification in the "Proposer Slashings"
-		return nil
+		proposer := beaconState.ValidatorRegistry[slashing.ProposerIndex]
+		if err := verifyProposalSignature(beaconState, proposer, slashing.ProposalData_1, slashing.ProposalSignature_1); err != nil {
+			return fmt.Errorf("could not verify proposal 1 signature: %v", err)
+		}
+		if err := verifyProposalSignature(beaconState, proposer, slashing.ProposalData_2, slashing.ProposalSignature_2); err != nil {
+			return fmt.Errorf("could not verify proposal 2 signature: %v", err)
+		}
+	}
+	return nil
+}
+
+// Verify that bls_verify(pubkey=proposer.pubkey, message_hash=hash_tree_root(proposal_data),
+//   signature=proposal_signature, domain=get_domain(state.fork, slot_to_epoch(proposal_data.slot), DOMAIN_PROPOSAL))
+func verifyProposalSignature(
+	beaconState *pb.BeaconState,
+	proposer *pb.Validator,
+	proposal *pb.ProposalSignedData,
+	signature []byte,
+) error {
+	pub, err := bls.PublicKeyFromBytes(proposer.Pubkey)
+	if err != nil {
+		return fmt.Errorf("could not deserialize proposer public key: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(signature)
+	if err != nil {
+		return fmt.Errorf("could not deserialize proposal signature: %v", err)
+	}
+	proposalRoot, err := hashutil.HashProto(proposal)
+	if err != nil {
+		return fmt.Errorf("could not tree hash proposal data: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(proposal.Slot), params.BeaconConfig().DomainProposal)
+	if !sig.Verify(proposalRoot[:], pub, domain) {
+		return errors.New("proposal signature did not verify")
 	}
 	return nil
 }
@@ -292,7 +344,7 @@ func verifyAttesterSlashing(slashing *pb.AttesterSlashing, verifySignatures bool
 	// Proof of Stake literature and the Ethereum 2.0 specification. Below, we verify that either this is the case
 	// or that the two attestations are a "surround vote" instead.
 	isSameTarget := helpers.SlotToEpoch(data1.Slot) == helpers.SlotToEpoch(data2.Slot)
-	if !(isSameTarget || isSurroundVote(data1, data2)) {
+	if !(isSameTarget || IsSurroundVote(data1, data2)) {
 		return errors.New("attester slashing is not a double vote nor surround vote")
 	}
 	if err := verifySlashableAttestation(slashableAttestation1, verifySignatures); err != nil {
@@ -361,11 +413,11 @@ func verifySlashableAttestation(att *pb.SlashableAttestation, verifySignatures b
 	return nil
 }
 
-// isSurroundVote checks if attestation 1's source epoch is smaller than attestation 2
+// IsSurroundVote checks if attestation 1's source epoch is smaller than attestation 2
 // while simultaneously checking if its target epoch is greater than that of attestation 2.
 // This is a Casper FFG slashing condition. This is known as "surrounding" a vote
 // in Casper Proof of Stake literature.
-func isSurroundVote(data1 *pb.AttestationData, data2 *pb.AttestationData) bool {
+func IsSurroundVote(data1 *pb.AttestationData, data2 *pb.AttestationData) bool {
 	sourceEpoch1 := data1.JustifiedEpoch
 	sourceEpoch2 := data2.JustifiedEpoch
 	targetEpoch1 := helpers.SlotToEpoch(data1.Slot)
@@ -493,6 +545,13 @@ func VerifyAttestation(beaconState *pb.BeaconState, att *pb.Attestation, verifyS
 	// 2.) attestation.data.latest_crosslink
 	// 	equals state.latest_crosslinks[attestation.data.shard]
 	shard := att.Data.Shard
+	if shard >= uint64(len(beaconState.LatestCrosslinks)) {
+		return fmt.Errorf(
+			"attestation shard %d out of range of %d shards",
+			shard,
+			len(beaconState.LatestCrosslinks),
+		)
+	}
 	crosslink := &pb.Crosslink{
 		CrosslinkDataRootHash32: att.Data.CrosslinkDataRootHash32,
 		Epoch:                   helpers.SlotToEpoch(att.Data.Slot),
@@ -517,20 +576,57 @@ func VerifyAttestation(beaconState *pb.BeaconState, att *pb.Attestation, verifyS
 		)
 	}
 	if verifySignatures {
-		// TODO(#258): Integrate BLS signature verification for attestation.
-		// assert bls_verify_multiple(
-		//   pubkeys=[
-		//	 bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
-		//   bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
-		//   ],
-		//   message_hash=[
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
-		//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
-		//   ],
-		//   signature=attestation.aggregate_signature,
-		//   domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
-		// )
-		return nil
+		if err := verifyAttestationSignature(beaconState, att); err != nil {
+			return fmt.Errorf("could not verify attestation signature: %v", err)
+		}
+	}
+	return nil
+}
+
+// Verify that bls_verify_multiple(
+//   pubkeys=[
+//	 bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_0_participants]),
+//   bls_aggregate_pubkeys([state.validator_registry[i].pubkey for i in custody_bit_1_participants]),
+//   ],
+//   message_hash=[
+//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b0)),
+//   hash_tree_root(AttestationDataAndCustodyBit(data=attestation.data, custody_bit=0b1)),
+//   ],
+//   signature=attestation.aggregate_signature,
+//   domain=get_domain(state.fork, slot_to_epoch(attestation.data.slot), DOMAIN_ATTESTATION),
+// )
+// Custody bits are always 0 in phase 0, so every participant is expected to have signed
+// the custody bit 0 message.
+func verifyAttestationSignature(beaconState *pb.BeaconState, att *pb.Attestation) error {
+	participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+	if err != nil {
+		return fmt.Errorf("could not get attestation participants: %v", err)
+	}
+	if len(participants) == 0 {
+		return errors.New("no committee member participated in the attestation")
+	}
+	pubKeys := make([]*bls.PublicKey, len(participants))
+	for i, idx := range participants {
+		pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[idx].Pubkey)
+		if err != nil {
+			return fmt.Errorf("could not deserialize validator %d public key: %v", idx, err)
+		}
+		pubKeys[i] = pub
+	}
+	sig, err := bls.SignatureFromBytes(att.AggregateSignature)
+	if err != nil {
+		return fmt.Errorf("could not deserialize aggregate signature: %v", err)
+	}
+	messageRoot, err := hashutil.HashProto(&pb.AttestationDataAndCustodyBit{
+		Data:       att.Data,
+		CustodyBit: false,
+	})
+	if err != nil {
+		return fmt.Errorf("could not tree hash attestation data: %v", err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+	if !sig.VerifyAggregate(pubKeys, messageRoot[:], domain) {
+		return errors.New("aggregate signature did not verify")
 	}
 	return nil
 }
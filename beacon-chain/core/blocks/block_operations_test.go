@@ -15,9 +15,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/forkutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -129,6 +131,65 @@ func TestProcessBlockRandao_SignatureVerifiesAndUpdatesLatestStateMixes(t *testi
 	}
 }
 
+func signedTestBlock(t *testing.T, beaconState *pb.BeaconState, privKey *bls.SecretKey) *pb.BeaconBlock {
+	block := &pb.BeaconBlock{
+		Slot:             beaconState.Slot,
+		ParentRootHash32: []byte{'A'},
+		Body:             &pb.BeaconBlockBody{},
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposalRoot, err := hashutil.HashProto(&pb.ProposalSignedData{
+		Slot:            block.Slot,
+		Shard:           params.BeaconConfig().BeaconChainShardNumber,
+		BlockRootHash32: blockRoot[:],
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(beaconState.Slot), params.BeaconConfig().DomainProposal)
+	block.Signature = privKey.Sign(proposalRoot[:], domain).Marshal()
+	return block
+}
+
+func TestVerifyProposerSignature_IncorrectProposerFailsVerification(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// We make the next validator's index sign the block instead of the proposer.
+	block := signedTestBlock(t, beaconState, privKeys[(proposerIdx+1)%uint64(len(privKeys))])
+
+	want := "proposal signature did not verify"
+	if err := blocks.VerifyProposerSignature(beaconState, block); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %v, received %v", want, err)
+	}
+}
+
+func TestVerifyProposerSignature_SignatureVerifies(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := signedTestBlock(t, beaconState, privKeys[proposerIdx])
+
+	if err := blocks.VerifyProposerSignature(beaconState, block); err != nil {
+		t.Errorf("Unexpected error verifying proposer signature: %v", err)
+	}
+}
+
 func TestProcessEth1Data_SameRootHash(t *testing.T) {
 	beaconState := &pb.BeaconState{
 		Eth1DataVotes: []*pb.Eth1DataVote{
@@ -399,6 +460,106 @@ func TestProcessProposerSlashings_AppliesCorrectStatus(t *testing.T) {
 	}
 }
 
+// signedProposerSlashing returns a slashing of two proposals of the same slot, shard and block
+// root, signed by the given keys under the proposal domain.
+func signedProposerSlashing(
+	t *testing.T,
+	beaconState *pb.BeaconState,
+	proposerIdx uint64,
+	priv1 *bls.SecretKey,
+	priv2 *bls.SecretKey,
+) *pb.ProposerSlashing {
+	slashing := &pb.ProposerSlashing{
+		ProposerIndex: proposerIdx,
+		ProposalData_1: &pb.ProposalSignedData{
+			Slot:            beaconState.Slot,
+			Shard:           1,
+			BlockRootHash32: []byte{0, 1, 0},
+		},
+		ProposalData_2: &pb.ProposalSignedData{
+			Slot:            beaconState.Slot,
+			Shard:           1,
+			BlockRootHash32: []byte{0, 1, 0},
+		},
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(beaconState.Slot), params.BeaconConfig().DomainProposal)
+	root1, err := hashutil.HashProto(slashing.ProposalData_1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root2, err := hashutil.HashProto(slashing.ProposalData_2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashing.ProposalSignature_1 = priv1.Sign(root1[:], domain).Marshal()
+	slashing.ProposalSignature_2 = priv2.Sign(root2[:], domain).Marshal()
+	return slashing
+}
+
+func TestProcessProposerSlashings_SignaturesVerify(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx := uint64(1)
+	slashing := signedProposerSlashing(t, beaconState, proposerIdx, privKeys[proposerIdx], privKeys[proposerIdx])
+	block := &pb.BeaconBlock{
+		Body: &pb.BeaconBlockBody{
+			ProposerSlashings: []*pb.ProposerSlashing{slashing},
+		},
+	}
+
+	newState, err := blocks.ProcessProposerSlashings(beaconState, block, true)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	wantEpoch := helpers.CurrentEpoch(newState) + params.BeaconConfig().LatestSlashedExitLength
+	if newState.ValidatorRegistry[proposerIdx].SlashedEpoch != wantEpoch {
+		t.Errorf("Expected proposer to be slashed at epoch %d, received %d",
+			wantEpoch, newState.ValidatorRegistry[proposerIdx].SlashedEpoch)
+	}
+}
+
+func TestProcessProposerSlashings_IncorrectSignatureFailsVerification(t *testing.T) {
+	deposits, privKeys := setupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx := uint64(1)
+	proposerKey := privKeys[proposerIdx]
+	otherKey := privKeys[proposerIdx+1]
+
+	tests := []struct {
+		priv1 *bls.SecretKey
+		priv2 *bls.SecretKey
+		want  string
+	}{
+		{
+			priv1: otherKey,
+			priv2: proposerKey,
+			want:  "could not verify proposal 1 signature: proposal signature did not verify",
+		},
+		{
+			priv1: proposerKey,
+			priv2: otherKey,
+			want:  "could not verify proposal 2 signature: proposal signature did not verify",
+		},
+	}
+	for _, tt := range tests {
+		slashing := signedProposerSlashing(t, beaconState, proposerIdx, tt.priv1, tt.priv2)
+		block := &pb.BeaconBlock{
+			Body: &pb.BeaconBlockBody{
+				ProposerSlashings: []*pb.ProposerSlashing{slashing},
+			},
+		}
+		if _, err := blocks.ProcessProposerSlashings(beaconState, block, true); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error %q, received %v", tt.want, err)
+		}
+	}
+}
+
 func TestProcessAttesterSlashings_ThresholdReached(t *testing.T) {
 	slashings := make([]*pb.AttesterSlashing, params.BeaconConfig().MaxAttesterSlashings+1)
 	registry := []*pb.Validator{}
@@ -1001,6 +1162,136 @@ func TestProcessBlockAttestations_CreatePendingAttestations(t *testing.T) {
 	}
 }
 
+// committeeAttestation returns an attestation of the first committee at the genesis slot, in which
+// every member of the committee participates, along with the private keys of the committee members.
+func committeeAttestation(t *testing.T, beaconState *pb.BeaconState, privKeys []*bls.SecretKey) (*pb.Attestation, []*bls.SecretKey) {
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, params.BeaconConfig().GenesisSlot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0]
+	if len(committee.Committee) < 2 {
+		t.Fatalf("Expected a committee of at least 2 validators, received %d", len(committee.Committee))
+	}
+	signers := make([]*bls.SecretKey, len(committee.Committee))
+	for i, idx := range committee.Committee {
+		signers[i] = privKeys[idx]
+	}
+	bitfield := bitutil.FillBitfield(len(committee.Committee))
+	att := &pb.Attestation{
+		Data: &pb.AttestationData{
+			Slot:                     params.BeaconConfig().GenesisSlot,
+			Shard:                    committee.Shard,
+			JustifiedEpoch:           beaconState.JustifiedEpoch,
+			JustifiedBlockRootHash32: beaconState.JustifiedRoot,
+			LatestCrosslink:          beaconState.LatestCrosslinks[committee.Shard],
+			CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
+		},
+		AggregationBitfield: bitfield,
+		CustodyBitfield:     make([]byte, len(bitfield)),
+	}
+	return att, signers
+}
+
+// signAttestation sets the aggregate signature of the signers over the attestation data and
+// custody bit 0 under the given domain.
+func signAttestation(t *testing.T, beaconState *pb.BeaconState, att *pb.Attestation, signers []*bls.SecretKey, domain uint64) {
+	messageRoot, err := hashutil.HashProto(&pb.AttestationDataAndCustodyBit{
+		Data:       att.Data,
+		CustodyBit: false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domainVersion := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), domain)
+	sigs := make([]*bls.Signature, len(signers))
+	for i, priv := range signers {
+		sigs[i] = priv.Sign(messageRoot[:], domainVersion)
+	}
+	att.AggregateSignature = bls.AggregateSignatures(sigs).Marshal()
+}
+
+func attestationSignatureTestState(t *testing.T) (*pb.BeaconState, []*bls.SecretKey) {
+	deposits, privKeys := setupInitialDeposits(t, int(params.BeaconConfig().SlotsPerEpoch*2))
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &pb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot = params.BeaconConfig().GenesisSlot + params.BeaconConfig().MinAttestationInclusionDelay
+	return beaconState, privKeys
+}
+
+func TestVerifyAttestation_AggregateSignatureVerifies(t *testing.T) {
+	helpers.RestartCommitteeCache()
+	defer helpers.RestartCommitteeCache()
+	beaconState, privKeys := attestationSignatureTestState(t)
+	att, signers := committeeAttestation(t, beaconState, privKeys)
+	signAttestation(t, beaconState, att, signers, params.BeaconConfig().DomainAttestation)
+
+	if err := blocks.VerifyAttestation(beaconState, att, true); err != nil {
+		t.Errorf("Expected attestation signature to verify, received error: %v", err)
+	}
+}
+
+func TestVerifyAttestation_WrongSignerFailsVerification(t *testing.T) {
+	helpers.RestartCommitteeCache()
+	defer helpers.RestartCommitteeCache()
+	beaconState, privKeys := attestationSignatureTestState(t)
+	att, signers := committeeAttestation(t, beaconState, privKeys)
+	// Replace the first committee member's key with the key of a validator outside the committee.
+	for _, priv := range privKeys {
+		isMember := false
+		for _, signer := range signers {
+			if priv == signer {
+				isMember = true
+				break
+			}
+		}
+		if !isMember {
+			signers[0] = priv
+			break
+		}
+	}
+	signAttestation(t, beaconState, att, signers, params.BeaconConfig().DomainAttestation)
+
+	want := "aggregate signature did not verify"
+	if err := blocks.VerifyAttestation(beaconState, att, true); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestVerifyAttestation_WrongDomainFailsVerification(t *testing.T) {
+	helpers.RestartCommitteeCache()
+	defer helpers.RestartCommitteeCache()
+	beaconState, privKeys := attestationSignatureTestState(t)
+	att, signers := committeeAttestation(t, beaconState, privKeys)
+	signAttestation(t, beaconState, att, signers, params.BeaconConfig().DomainProposal)
+
+	want := "aggregate signature did not verify"
+	if err := blocks.VerifyAttestation(beaconState, att, true); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestVerifyAttestation_BitfieldNotMatchingSignersFailsVerification(t *testing.T) {
+	helpers.RestartCommitteeCache()
+	defer helpers.RestartCommitteeCache()
+	beaconState, privKeys := attestationSignatureTestState(t)
+	att, signers := committeeAttestation(t, beaconState, privKeys)
+	signAttestation(t, beaconState, att, signers, params.BeaconConfig().DomainAttestation)
+	// Every committee member signed, but only the first one is marked as a participant.
+	bitfield, err := bitutil.SetBitfield(0, len(signers))
+	if err != nil {
+		t.Fatal(err)
+	}
+	att.AggregationBitfield = bitfield
+
+	want := "aggregate signature did not verify"
+	if err := blocks.VerifyAttestation(beaconState, att, true); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
 func TestProcessValidatorDeposits_ThresholdReached(t *testing.T) {
 	block := &pb.BeaconBlock{
 		Body: &pb.BeaconBlockBody{
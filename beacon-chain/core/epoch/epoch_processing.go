@@ -400,6 +400,8 @@ func UpdateLatestRandaoMixes(state *pb.BeaconState) (*pb.BeaconState, error) {
 		return nil, fmt.Errorf("could not get randaoMix mix: %v", err)
 	}
 
-	state.LatestRandaoMixes[nextEpoch] = randaoMix
+	// The mix is copied, as the randao reveals of the next epoch are XORed into its mix in place
+	// and would otherwise also change the mix of the current epoch.
+	state.LatestRandaoMixes[nextEpoch] = append([]byte{}, randaoMix...)
 	return state, nil
 }
@@ -525,6 +525,21 @@ func TestUpdateLatestSlashedBalances_UpdatesBalances(t *testing.T) {
 	}
 }
 
+func TestUpdateLatestRandaoMixes_CopiesMix(t *testing.T) {
+	mixes := make([][]byte, params.BeaconConfig().LatestRandaoMixesLength)
+	mixes[0] = []byte{'A'}
+	state := &pb.BeaconState{LatestRandaoMixes: mixes}
+	newState, err := UpdateLatestRandaoMixes(state)
+	if err != nil {
+		t.Fatalf("could not update latest randao mixes: %v", err)
+	}
+	// Block processing XORs randao reveals into the mix of the current epoch in place.
+	newState.LatestRandaoMixes[1][0] ^= 0xFF
+	if !bytes.Equal(newState.LatestRandaoMixes[0], []byte{'A'}) {
+		t.Errorf("Expected the mix of the previous epoch to be unchanged, received %v", newState.LatestRandaoMixes[0])
+	}
+}
+
 func TestUpdateLatestRandaoMixes_UpdatesRandao(t *testing.T) {
 	tests := []struct {
 		epoch uint64
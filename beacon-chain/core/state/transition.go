@@ -135,8 +135,7 @@ func ProcessBlock(
 
 	// Verify block signature.
 	if config.VerifySignatures {
-		// TODO(#781): Verify Proposer Signature.
-		if err := b.VerifyProposerSignature(block); err != nil {
+		if err := b.VerifyProposerSignature(state, block); err != nil {
 			return nil, fmt.Errorf("could not verify proposer signature: %v", err)
 		}
 	}
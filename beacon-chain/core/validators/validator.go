@@ -317,38 +317,27 @@ func UpdateRegistry(state *pb.BeaconState) (*pb.BeaconState, error) {
 	totalBalance := helpers.TotalBalance(state, activeValidatorIndices)
 
 	// The maximum balance churn in Gwei (for deposits and exits separately).
-	maxBalChurn := maxBalanceChurn(totalBalance)
+	maxBalChurn := MaxBalanceChurn(totalBalance)
 
-	var balChurn uint64
 	var err error
 	vStore.Lock()
 	defer vStore.Unlock()
-	for idx, validator := range state.ValidatorRegistry {
-		// Activate validators within the allowable balance churn.
-		if validator.ActivationEpoch == params.BeaconConfig().FarFutureEpoch &&
-			state.ValidatorBalances[idx] >= params.BeaconConfig().MaxDepositAmount &&
-			!helpers.IsActiveValidator(validator, currentEpoch) {
-			balChurn += helpers.EffectiveBalance(state, uint64(idx))
-			log.WithFields(logrus.Fields{
-				"index":               idx,
-				"currentBalanceChurn": balChurn,
-				"maxBalanceChurn":     maxBalChurn,
-				"currentEpoch":        currentEpoch - params.BeaconConfig().GenesisEpoch,
-			}).Info("Attempting to activate validator")
-
-			if balChurn > maxBalChurn {
-				break
-			}
-			state, err = ActivateValidator(state, uint64(idx), false)
-			if err != nil {
-				return nil, fmt.Errorf("could not activate validator %d: %v", idx, err)
-			}
-			vStore.activatedValidators[updatedEpoch] =
-				append(vStore.activatedValidators[updatedEpoch], uint64(idx))
+	// Activate validators within the allowable balance churn.
+	for _, idx := range ActivationQueue(state) {
+		log.WithFields(logrus.Fields{
+			"index":           idx,
+			"maxBalanceChurn": maxBalChurn,
+			"currentEpoch":    currentEpoch - params.BeaconConfig().GenesisEpoch,
+		}).Info("Activating validator")
+		state, err = ActivateValidator(state, idx, false)
+		if err != nil {
+			return nil, fmt.Errorf("could not activate validator %d: %v", idx, err)
 		}
+		vStore.activatedValidators[updatedEpoch] =
+			append(vStore.activatedValidators[updatedEpoch], idx)
 	}
 
-	balChurn = 0
+	var balChurn uint64
 	for idx, validator := range state.ValidatorRegistry {
 		// Exit validators within the allowable balance churn.
 		if validator.ExitEpoch == params.BeaconConfig().FarFutureEpoch &&
@@ -366,6 +355,32 @@ func UpdateRegistry(state *pb.BeaconState) (*pb.BeaconState, error) {
 	return state, nil
 }
 
+// ActivationQueue returns the indices of the validators UpdateRegistry activates when the registry
+// is updated at the current epoch of the state. Validators waiting for activation with a balance of
+// at least MAX_DEPOSIT_AMOUNT are activated in the order of their index, until the activations
+// would exceed the maximum balance churn.
+func ActivationQueue(state *pb.BeaconState) []uint64 {
+	currentEpoch := helpers.CurrentEpoch(state)
+	activeValidatorIndices := helpers.ActiveValidatorIndices(
+		state.ValidatorRegistry, currentEpoch)
+	maxBalChurn := MaxBalanceChurn(helpers.TotalBalance(state, activeValidatorIndices))
+
+	var balChurn uint64
+	queue := make([]uint64, 0)
+	for idx, validator := range state.ValidatorRegistry {
+		if validator.ActivationEpoch == params.BeaconConfig().FarFutureEpoch &&
+			state.ValidatorBalances[idx] >= params.BeaconConfig().MaxDepositAmount &&
+			!helpers.IsActiveValidator(validator, currentEpoch) {
+			balChurn += helpers.EffectiveBalance(state, uint64(idx))
+			if balChurn > maxBalChurn {
+				break
+			}
+			queue = append(queue, uint64(idx))
+		}
+	}
+	return queue
+}
+
 // ProcessPenaltiesAndExits prepares the validators and the slashed validators
 // for withdrawal.
 //
@@ -528,14 +543,14 @@ func allValidatorsIndices(state *pb.BeaconState) []uint64 {
 	return validatorIndices
 }
 
-// maxBalanceChurn returns the maximum balance churn in Gwei,
+// MaxBalanceChurn returns the maximum balance churn in Gwei,
 // this determines how many validators can be rotated
 // in and out of the validator pool.
 // Spec pseudocode definition:
 //     max_balance_churn = max(
 //        MAX_DEPOSIT_AMOUNT,
 //        total_balance // (2 * MAX_BALANCE_CHURN_QUOTIENT))
-func maxBalanceChurn(totalBalance uint64) uint64 {
+func MaxBalanceChurn(totalBalance uint64) uint64 {
 	maxBalanceChurn := totalBalance / (2 * params.BeaconConfig().MaxBalanceChurnQuotient)
 	if maxBalanceChurn > params.BeaconConfig().MaxDepositAmount {
 		return maxBalanceChurn
@@ -670,6 +670,48 @@ func TestUpdateRegistry_Activations(t *testing.T) {
 	}
 }
 
+func TestActivationQueue_BoundedByBalanceChurn(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	// The balance churn of the active validators allows activating 4 validators.
+	activeCount := 8 * params.BeaconConfig().MaxBalanceChurnQuotient
+	state := &pb.BeaconState{Slot: params.BeaconConfig().GenesisSlot}
+	for i := uint64(0); i < activeCount; i++ {
+		state.ValidatorRegistry = append(state.ValidatorRegistry, &pb.Validator{
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			ExitEpoch:       farFuture,
+		})
+		state.ValidatorBalances = append(state.ValidatorBalances, maxDeposit)
+	}
+	pendingBalances := []uint64{maxDeposit, maxDeposit - 1, maxDeposit, maxDeposit, maxDeposit, maxDeposit, maxDeposit}
+	for _, balance := range pendingBalances {
+		state.ValidatorRegistry = append(state.ValidatorRegistry, &pb.Validator{
+			ActivationEpoch: farFuture,
+			ExitEpoch:       farFuture,
+		})
+		state.ValidatorBalances = append(state.ValidatorBalances, balance)
+	}
+
+	// The pending validator without a full deposit is not eligible for activation.
+	wanted := []uint64{activeCount, activeCount + 2, activeCount + 3, activeCount + 4}
+	queue := ActivationQueue(state)
+	if !reflect.DeepEqual(queue, wanted) {
+		t.Errorf("Wanted activation queue %v, received %v", wanted, queue)
+	}
+
+	newState, err := UpdateRegistry(state)
+	if err != nil {
+		t.Fatalf("could not update validator registry: %v", err)
+	}
+	activationEpoch := helpers.EntryExitEffectEpoch(helpers.CurrentEpoch(state))
+	for i := activeCount; i < uint64(len(newState.ValidatorRegistry)); i++ {
+		activated := newState.ValidatorRegistry[i].ActivationEpoch == activationEpoch
+		if activated != (i <= activeCount+4 && i != activeCount+1) {
+			t.Errorf("Validator %d activated %t by the registry update, expected the activation queue %v", i, activated, wanted)
+		}
+	}
+}
+
 func TestUpdateRegistry_Exits(t *testing.T) {
 	epoch := uint64(5)
 	exitEpoch := helpers.EntryExitEffectEpoch(epoch)
@@ -719,7 +761,7 @@ func TestMaxBalanceChurn_OK(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		churn := maxBalanceChurn(tt.totalBalance)
+		churn := MaxBalanceChurn(tt.totalBalance)
 		if tt.maxBalanceChurn != churn {
 			t.Errorf("MaxBalanceChurn was not an expected value. Wanted: %d, got: %d",
 				tt.maxBalanceChurn, churn)
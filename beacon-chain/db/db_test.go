@@ -53,3 +53,30 @@ func TestClearDB(t *testing.T) {
 		t.Fatalf("db wasnt cleared %v", err)
 	}
 }
+
+func TestSetupDBWithStorage_SyncsOnlyDiskStorage(t *testing.T) {
+	diskDB, err := SetupDBWithStorage(DiskStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer TeardownDB(diskDB)
+	if diskDB.db.NoSync {
+		t.Error("Expected disk storage to sync writes")
+	}
+	if !strings.HasPrefix(diskDB.DatabasePath, os.TempDir()) {
+		t.Errorf("Expected disk storage database in %s, received %s", os.TempDir(), diskDB.DatabasePath)
+	}
+
+	memoryDB, err := SetupDBWithStorage(MemoryStorage)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer TeardownDB(memoryDB)
+	if !memoryDB.db.NoSync {
+		t.Error("Expected memory storage to skip syncing writes")
+	}
+	dir := MemoryStorage.TempDir(os.TempDir())
+	if !strings.HasPrefix(memoryDB.DatabasePath, dir) {
+		t.Errorf("Expected memory storage database in %s, received %s", dir, memoryDB.DatabasePath)
+	}
+}
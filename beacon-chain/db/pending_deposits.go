@@ -66,6 +66,23 @@ func (db *BeaconDB) PendingDeposits(ctx context.Context, beforeBlk *big.Int) []*
 	return deposits
 }
 
+// PendingDepositBlockNumber returns the proof of work block number in which the
+// pending deposit with the given merkle tree index was included. If no such
+// deposit exists then this method returns nil.
+func (db *BeaconDB) PendingDepositBlockNumber(ctx context.Context, merkleTreeIndex uint64) *big.Int {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PendingDepositBlockNumber")
+	defer span.End()
+	db.depositsLock.RLock()
+	defer db.depositsLock.RUnlock()
+
+	for _, ctnr := range db.pendingDeposits {
+		if ctnr.deposit.MerkleTreeIndex == merkleTreeIndex {
+			return ctnr.block
+		}
+	}
+	return nil
+}
+
 // RemovePendingDeposit from the database. The deposit is indexed by the
 // MerkleTreeIndex. This method does nothing if deposit ptr is nil.
 func (db *BeaconDB) RemovePendingDeposit(ctx context.Context, d *pb.Deposit) {
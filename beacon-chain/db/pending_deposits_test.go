@@ -158,3 +158,18 @@ func TestPrunePendingDeposits_OK(t *testing.T) {
 	}
 
 }
+
+func TestPendingDepositBlockNumber_OK(t *testing.T) {
+	db := BeaconDB{}
+	db.pendingDeposits = []*depositContainer{
+		{block: big.NewInt(2), deposit: &pb.Deposit{MerkleTreeIndex: 1}},
+		{block: big.NewInt(4), deposit: &pb.Deposit{MerkleTreeIndex: 3}},
+	}
+
+	if blk := db.PendingDepositBlockNumber(context.Background(), 3); blk == nil || blk.Cmp(big.NewInt(4)) != 0 {
+		t.Errorf("Unexpected block number. got=%v want=%d", blk, 4)
+	}
+	if blk := db.PendingDepositBlockNumber(context.Background(), 5); blk != nil {
+		t.Errorf("Expected nil block number for unknown deposit, got=%v", blk)
+	}
+}
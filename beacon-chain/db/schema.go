@@ -31,6 +31,7 @@ var (
 	justifiedStateLookupKey = []byte("justified-state")
 	finalizedBlockLookupKey = []byte("finalized-block")
 	justifiedBlockLookupKey = []byte("justified-block")
+	genesisStateLookupKey   = []byte("genesis-state")
 
 	// DB internal use
 	cleanupHistoryBucket = []byte("cleanup-history-bucket")
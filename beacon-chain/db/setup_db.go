@@ -8,17 +8,58 @@ import (
 	"path"
 )
 
+// memoryBackedDir is a tmpfs mount available on most linux systems.
+const memoryBackedDir = "/dev/shm"
+
+// Storage selects where a test BeaconDB keeps its data.
+type Storage int
+
+const (
+	// DiskStorage keeps the database in a temporary directory on disk and syncs every write.
+	DiskStorage Storage = iota
+	// MemoryStorage keeps the database on a memory backed file system when one is available and
+	// does not sync writes, so benchmarks measure CPU cost rather than disk I/O.
+	MemoryStorage
+)
+
+// TempDir returns the directory databases of the storage kind are created in, defaultDir for
+// disk storage or when no memory backed file system is available.
+func (s Storage) TempDir(defaultDir string) string {
+	if s == MemoryStorage {
+		if info, err := os.Stat(memoryBackedDir); err == nil && info.IsDir() {
+			return memoryBackedDir
+		}
+	}
+	return defaultDir
+}
+
+// NewDBWithStorage initializes a new DB at dirPath configured for the storage kind.
+func NewDBWithStorage(dirPath string, storage Storage) (*BeaconDB, error) {
+	db, err := NewDB(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	db.db.NoSync = storage == MemoryStorage
+	return db, nil
+}
+
 // SetupDB instantiates and returns a simulated backend BeaconDB instance.
 func SetupDB() (*BeaconDB, error) {
+	return SetupDBWithStorage(DiskStorage)
+}
+
+// SetupDBWithStorage instantiates and returns a simulated backend BeaconDB instance using
+// the given storage kind.
+func SetupDBWithStorage(storage Storage) (*BeaconDB, error) {
 	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
 	if err != nil {
 		return nil, fmt.Errorf("could not generate random file path: %v", err)
 	}
-	path := path.Join(os.TempDir(), fmt.Sprintf("/%d", randPath))
+	path := path.Join(storage.TempDir(os.TempDir()), fmt.Sprintf("/%d", randPath))
 	if err := os.RemoveAll(path); err != nil {
 		return nil, fmt.Errorf("failed to remove directory: %v", err)
 	}
-	return NewDB(path)
+	return NewDBWithStorage(path, storage)
 }
 
 // TeardownDB cleans up a simulated backend BeaconDB instance.
@@ -87,6 +87,10 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 			}
 		}
 
+		if err := chainInfo.Put(genesisStateLookupKey, stateEnc); err != nil {
+			return err
+		}
+
 		// Putting in finalized state.
 		if err := chainInfo.Put(finalizedStateLookupKey, stateEnc); err != nil {
 			return err
@@ -283,6 +287,27 @@ func (db *BeaconDB) FinalizedState() (*pb.BeaconState, error) {
 	return beaconState, err
 }
 
+// GenesisState retrieves the genesis state the db was initialized with. It returns nil
+// if the db was not initialized from a genesis state.
+func (db *BeaconDB) GenesisState(ctx context.Context) (*pb.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.GenesisState")
+	defer span.End()
+
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx *bolt.Tx) error {
+		chainInfo := tx.Bucket(chainInfoBucket)
+		encState := chainInfo.Get(genesisStateLookupKey)
+		if encState == nil {
+			return nil
+		}
+
+		var err error
+		beaconState, err = createState(encState)
+		return err
+	})
+	return beaconState, err
+}
+
 // HistoricalStateFromSlot retrieves the state that is closest to the input slot,
 // while being smaller than or equal to the input slot.
 func (db *BeaconDB) HistoricalStateFromSlot(ctx context.Context, slot uint64, blockRoot [32]byte) (*pb.BeaconState, error) {
@@ -88,6 +88,49 @@ func TestInitializeState_OK(t *testing.T) {
 	}
 }
 
+func TestGenesisState_CanRetrieve(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisState, err := db.GenesisState(ctx)
+	if err != nil {
+		t.Fatalf("Unable to retrieve genesis state: %v", err)
+	}
+	if genesisState != nil {
+		t.Errorf("Expected no genesis state before initialization, received %v", genesisState)
+	}
+
+	deposits, _ := setupInitialDeposits(t, 10)
+	eth1Data := &pb.Eth1Data{
+		DepositRootHash32: []byte("deposit root"),
+		BlockHash32:       []byte("block hash"),
+	}
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, eth1Data); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatalf("Failed to retrieve head state: %v", err)
+	}
+	// Advancing the head must not change the genesis state.
+	headState.Slot++
+	if err := db.SaveState(ctx, headState); err != nil {
+		t.Fatalf("Unable to save state: %v", err)
+	}
+
+	genesisState, err = db.GenesisState(ctx)
+	if err != nil {
+		t.Fatalf("Unable to retrieve genesis state: %v", err)
+	}
+	if genesisState.Slot != params.BeaconConfig().GenesisSlot {
+		t.Errorf("Expected genesis state at slot %d, received %d", params.BeaconConfig().GenesisSlot, genesisState.Slot)
+	}
+	if !proto.Equal(genesisState.LatestEth1Data, eth1Data) {
+		t.Errorf("Expected genesis eth1 data %v, received %v", eth1Data, genesisState.LatestEth1Data)
+	}
+}
+
 func TestFinalizeState_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
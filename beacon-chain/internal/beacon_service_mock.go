@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceServer,BeaconService_LatestAttestationServer,BeaconService_WaitForChainStartServer)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceServer,BeaconService_LatestAttestationServer,BeaconService_WaitForChainStartServer,BeaconService_BlockStreamServer,BeaconService_SlotTickStreamServer,BeaconService_AggregateAttestationStreamServer)
 
 // Package internal is a generated GoMock package.
 package internal
@@ -38,149 +38,1073 @@ func (m *MockBeaconServiceServer) EXPECT() *MockBeaconServiceServerMockRecorder
 	return m.recorder
 }
 
+// ActiveBalance mocks base method
+func (m *MockBeaconServiceServer) ActiveBalance(arg0 context.Context, arg1 *v10.ActiveBalanceRequest) (*v10.ActiveBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveBalance", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ActiveBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveBalance indicates an expected call of ActiveBalance
+func (mr *MockBeaconServiceServerMockRecorder) ActiveBalance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveBalance", reflect.TypeOf((*MockBeaconServiceServer)(nil).ActiveBalance), arg0, arg1)
+}
+
+// ActiveValidators mocks base method
+func (m *MockBeaconServiceServer) ActiveValidators(arg0 context.Context, arg1 *v10.ActiveValidatorsRequest) (*v10.ActiveValidatorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActiveValidators", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ActiveValidatorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveValidators indicates an expected call of ActiveValidators
+func (mr *MockBeaconServiceServerMockRecorder) ActiveValidators(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveValidators", reflect.TypeOf((*MockBeaconServiceServer)(nil).ActiveValidators), arg0, arg1)
+}
+
+// AggregateAttestationStream mocks base method
+func (m *MockBeaconServiceServer) AggregateAttestationStream(arg0 *v10.AggregateStreamRequest, arg1 v10.BeaconService_AggregateAttestationStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregateAttestationStream", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AggregateAttestationStream indicates an expected call of AggregateAttestationStream
+func (mr *MockBeaconServiceServerMockRecorder) AggregateAttestationStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateAttestationStream", reflect.TypeOf((*MockBeaconServiceServer)(nil).AggregateAttestationStream), arg0, arg1)
+}
+
+// AttestationTargetCache mocks base method
+func (m *MockBeaconServiceServer) AttestationTargetCache(arg0 context.Context, arg1 *types.Empty) (*v10.AttestationTargetCacheResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttestationTargetCache", arg0, arg1)
+	ret0, _ := ret[0].(*v10.AttestationTargetCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttestationTargetCache indicates an expected call of AttestationTargetCache
+func (mr *MockBeaconServiceServerMockRecorder) AttestationTargetCache(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestationTargetCache", reflect.TypeOf((*MockBeaconServiceServer)(nil).AttestationTargetCache), arg0, arg1)
+}
+
+// AttestingBalances mocks base method
+func (m *MockBeaconServiceServer) AttestingBalances(arg0 context.Context, arg1 *types.Empty) (*v10.AttestingBalancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttestingBalances", arg0, arg1)
+	ret0, _ := ret[0].(*v10.AttestingBalancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttestingBalances indicates an expected call of AttestingBalances
+func (mr *MockBeaconServiceServerMockRecorder) AttestingBalances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestingBalances", reflect.TypeOf((*MockBeaconServiceServer)(nil).AttestingBalances), arg0, arg1)
+}
+
+// BeaconCommittee mocks base method
+func (m *MockBeaconServiceServer) BeaconCommittee(arg0 context.Context, arg1 *v10.BeaconCommitteeRequest) (*v10.BeaconCommitteeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BeaconCommittee", arg0, arg1)
+	ret0, _ := ret[0].(*v10.BeaconCommitteeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeaconCommittee indicates an expected call of BeaconCommittee
+func (mr *MockBeaconServiceServerMockRecorder) BeaconCommittee(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconCommittee", reflect.TypeOf((*MockBeaconServiceServer)(nil).BeaconCommittee), arg0, arg1)
+}
+
+// BlockAttestations mocks base method
+func (m *MockBeaconServiceServer) BlockAttestations(arg0 context.Context, arg1 *v10.BlockByRootRequest) (*v10.BlockAttestationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockAttestations", arg0, arg1)
+	ret0, _ := ret[0].(*v10.BlockAttestationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockAttestations indicates an expected call of BlockAttestations
+func (mr *MockBeaconServiceServerMockRecorder) BlockAttestations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockAttestations", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlockAttestations), arg0, arg1)
+}
+
+// BlockOperationCounts mocks base method
+func (m *MockBeaconServiceServer) BlockOperationCounts(arg0 context.Context, arg1 *v10.BlockByRootRequest) (*v10.BlockOperationCountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockOperationCounts", arg0, arg1)
+	ret0, _ := ret[0].(*v10.BlockOperationCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockOperationCounts indicates an expected call of BlockOperationCounts
+func (mr *MockBeaconServiceServerMockRecorder) BlockOperationCounts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOperationCounts", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlockOperationCounts), arg0, arg1)
+}
+
+// BlockStream mocks base method
+func (m *MockBeaconServiceServer) BlockStream(arg0 *v10.BlockStreamRequest, arg1 v10.BeaconService_BlockStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockStream", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockStream indicates an expected call of BlockStream
+func (mr *MockBeaconServiceServerMockRecorder) BlockStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockStream", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlockStream), arg0, arg1)
+}
+
 // BlockTree mocks base method
-func (m *MockBeaconServiceServer) BlockTree(arg0 context.Context, arg1 *types.Empty) (*v10.BlockTreeResponse, error) {
+func (m *MockBeaconServiceServer) BlockTree(arg0 context.Context, arg1 *v10.BlockTreeRequest) (*v10.BlockTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockTree", arg0, arg1)
+	ret0, _ := ret[0].(*v10.BlockTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockTree indicates an expected call of BlockTree
+func (mr *MockBeaconServiceServerMockRecorder) BlockTree(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTree", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlockTree), arg0, arg1)
+}
+
+// BlockTreeBySlots mocks base method
+func (m *MockBeaconServiceServer) BlockTreeBySlots(arg0 context.Context, arg1 *v10.TreeBlockSlotRequest) (*v10.BlockTreeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockTreeBySlots", arg0, arg1)
+	ret0, _ := ret[0].(*v10.BlockTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockTreeBySlots indicates an expected call of BlockTreeBySlots
+func (mr *MockBeaconServiceServerMockRecorder) BlockTreeBySlots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTreeBySlots", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlockTreeBySlots), arg0, arg1)
+}
+
+// BlocksBySlot mocks base method
+func (m *MockBeaconServiceServer) BlocksBySlot(arg0 context.Context, arg1 *v10.BlocksBySlotRequest) (*v10.BlocksBySlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlocksBySlot", arg0, arg1)
+	ret0, _ := ret[0].(*v10.BlocksBySlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlocksBySlot indicates an expected call of BlocksBySlot
+func (mr *MockBeaconServiceServerMockRecorder) BlocksBySlot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlocksBySlot", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlocksBySlot), arg0, arg1)
+}
+
+// CanonicalHead mocks base method
+func (m *MockBeaconServiceServer) CanonicalHead(arg0 context.Context, arg1 *types.Empty) (*v1.BeaconBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanonicalHead", arg0, arg1)
+	ret0, _ := ret[0].(*v1.BeaconBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanonicalHead indicates an expected call of CanonicalHead
+func (mr *MockBeaconServiceServerMockRecorder) CanonicalHead(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceServer)(nil).CanonicalHead), arg0, arg1)
+}
+
+// ChurnLimit mocks base method
+func (m *MockBeaconServiceServer) ChurnLimit(arg0 context.Context, arg1 *types.Empty) (*v10.ChurnLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChurnLimit", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ChurnLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChurnLimit indicates an expected call of ChurnLimit
+func (mr *MockBeaconServiceServerMockRecorder) ChurnLimit(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChurnLimit", reflect.TypeOf((*MockBeaconServiceServer)(nil).ChurnLimit), arg0, arg1)
+}
+
+// Crosslinks mocks base method
+func (m *MockBeaconServiceServer) Crosslinks(arg0 context.Context, arg1 *types.Empty) (*v10.CrosslinksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Crosslinks", arg0, arg1)
+	ret0, _ := ret[0].(*v10.CrosslinksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Crosslinks indicates an expected call of Crosslinks
+func (mr *MockBeaconServiceServerMockRecorder) Crosslinks(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Crosslinks", reflect.TypeOf((*MockBeaconServiceServer)(nil).Crosslinks), arg0, arg1)
+}
+
+// DepositStatus mocks base method
+func (m *MockBeaconServiceServer) DepositStatus(arg0 context.Context, arg1 *v10.DepositStatusRequest) (*v10.DepositStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DepositStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v10.DepositStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DepositStatus indicates an expected call of DepositStatus
+func (mr *MockBeaconServiceServerMockRecorder) DepositStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositStatus", reflect.TypeOf((*MockBeaconServiceServer)(nil).DepositStatus), arg0, arg1)
+}
+
+// DetectedSlashings mocks base method
+func (m *MockBeaconServiceServer) DetectedSlashings(arg0 context.Context, arg1 *types.Empty) (*v10.DetectedSlashingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetectedSlashings", arg0, arg1)
+	ret0, _ := ret[0].(*v10.DetectedSlashingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectedSlashings indicates an expected call of DetectedSlashings
+func (mr *MockBeaconServiceServerMockRecorder) DetectedSlashings(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectedSlashings", reflect.TypeOf((*MockBeaconServiceServer)(nil).DetectedSlashings), arg0, arg1)
+}
+
+// EpochAttestationStats mocks base method
+func (m *MockBeaconServiceServer) EpochAttestationStats(arg0 context.Context, arg1 *v10.EpochAttestationStatsRequest) (*v10.EpochAttestationStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpochAttestationStats", arg0, arg1)
+	ret0, _ := ret[0].(*v10.EpochAttestationStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpochAttestationStats indicates an expected call of EpochAttestationStats
+func (mr *MockBeaconServiceServerMockRecorder) EpochAttestationStats(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpochAttestationStats", reflect.TypeOf((*MockBeaconServiceServer)(nil).EpochAttestationStats), arg0, arg1)
+}
+
+// EpochShuffling mocks base method
+func (m *MockBeaconServiceServer) EpochShuffling(arg0 context.Context, arg1 *v10.EpochShufflingRequest) (*v10.EpochShufflingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EpochShuffling", arg0, arg1)
+	ret0, _ := ret[0].(*v10.EpochShufflingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpochShuffling indicates an expected call of EpochShuffling
+func (mr *MockBeaconServiceServerMockRecorder) EpochShuffling(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpochShuffling", reflect.TypeOf((*MockBeaconServiceServer)(nil).EpochShuffling), arg0, arg1)
+}
+
+// Eth1Data mocks base method
+func (m *MockBeaconServiceServer) Eth1Data(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1DataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eth1Data", arg0, arg1)
+	ret0, _ := ret[0].(*v10.Eth1DataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1Data indicates an expected call of Eth1Data
+func (mr *MockBeaconServiceServerMockRecorder) Eth1Data(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1Data), arg0, arg1)
+}
+
+// Eth1DataVotes mocks base method
+func (m *MockBeaconServiceServer) Eth1DataVotes(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1DataVotesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eth1DataVotes", arg0, arg1)
+	ret0, _ := ret[0].(*v10.Eth1DataVotesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1DataVotes indicates an expected call of Eth1DataVotes
+func (mr *MockBeaconServiceServerMockRecorder) Eth1DataVotes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1DataVotes", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1DataVotes), arg0, arg1)
+}
+
+// Eth1FollowStatus mocks base method
+func (m *MockBeaconServiceServer) Eth1FollowStatus(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1FollowStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eth1FollowStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v10.Eth1FollowStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1FollowStatus indicates an expected call of Eth1FollowStatus
+func (mr *MockBeaconServiceServerMockRecorder) Eth1FollowStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1FollowStatus", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1FollowStatus), arg0, arg1)
+}
+
+// Eth1VoteCandidates mocks base method
+func (m *MockBeaconServiceServer) Eth1VoteCandidates(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1VoteCandidatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eth1VoteCandidates", arg0, arg1)
+	ret0, _ := ret[0].(*v10.Eth1VoteCandidatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1VoteCandidates indicates an expected call of Eth1VoteCandidates
+func (mr *MockBeaconServiceServerMockRecorder) Eth1VoteCandidates(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1VoteCandidates", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1VoteCandidates), arg0, arg1)
+}
+
+// FinalityDistance mocks base method
+func (m *MockBeaconServiceServer) FinalityDistance(arg0 context.Context, arg1 *types.Empty) (*v10.FinalityDistanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinalityDistance", arg0, arg1)
+	ret0, _ := ret[0].(*v10.FinalityDistanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinalityDistance indicates an expected call of FinalityDistance
+func (mr *MockBeaconServiceServerMockRecorder) FinalityDistance(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalityDistance", reflect.TypeOf((*MockBeaconServiceServer)(nil).FinalityDistance), arg0, arg1)
+}
+
+// ForkChoiceStore mocks base method
+func (m *MockBeaconServiceServer) ForkChoiceStore(arg0 context.Context, arg1 *types.Empty) (*v10.ForkChoiceStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkChoiceStore", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ForkChoiceStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkChoiceStore indicates an expected call of ForkChoiceStore
+func (mr *MockBeaconServiceServerMockRecorder) ForkChoiceStore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkChoiceStore", reflect.TypeOf((*MockBeaconServiceServer)(nil).ForkChoiceStore), arg0, arg1)
+}
+
+// ForkData mocks base method
+func (m *MockBeaconServiceServer) ForkData(arg0 context.Context, arg1 *types.Empty) (*v1.Fork, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForkData", arg0, arg1)
+	ret0, _ := ret[0].(*v1.Fork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkData indicates an expected call of ForkData
+func (mr *MockBeaconServiceServerMockRecorder) ForkData(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkData", reflect.TypeOf((*MockBeaconServiceServer)(nil).ForkData), arg0, arg1)
+}
+
+// GenesisDepositRoot mocks base method
+func (m *MockBeaconServiceServer) GenesisDepositRoot(arg0 context.Context, arg1 *types.Empty) (*v10.GenesisDepositRootResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenesisDepositRoot", arg0, arg1)
+	ret0, _ := ret[0].(*v10.GenesisDepositRootResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenesisDepositRoot indicates an expected call of GenesisDepositRoot
+func (mr *MockBeaconServiceServerMockRecorder) GenesisDepositRoot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenesisDepositRoot", reflect.TypeOf((*MockBeaconServiceServer)(nil).GenesisDepositRoot), arg0, arg1)
+}
+
+// GenesisValidators mocks base method
+func (m *MockBeaconServiceServer) GenesisValidators(arg0 context.Context, arg1 *v10.GenesisValidatorsRequest) (*v10.GenesisValidatorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenesisValidators", arg0, arg1)
+	ret0, _ := ret[0].(*v10.GenesisValidatorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenesisValidators indicates an expected call of GenesisValidators
+func (mr *MockBeaconServiceServerMockRecorder) GenesisValidators(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenesisValidators", reflect.TypeOf((*MockBeaconServiceServer)(nil).GenesisValidators), arg0, arg1)
+}
+
+// HistoricalBlockRoots mocks base method
+func (m *MockBeaconServiceServer) HistoricalBlockRoots(arg0 context.Context, arg1 *v10.HistoricalBlockRootsRequest) (*v10.HistoricalBlockRootsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistoricalBlockRoots", arg0, arg1)
+	ret0, _ := ret[0].(*v10.HistoricalBlockRootsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HistoricalBlockRoots indicates an expected call of HistoricalBlockRoots
+func (mr *MockBeaconServiceServerMockRecorder) HistoricalBlockRoots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoricalBlockRoots", reflect.TypeOf((*MockBeaconServiceServer)(nil).HistoricalBlockRoots), arg0, arg1)
+}
+
+// JustifiedCheckpointHistory mocks base method
+func (m *MockBeaconServiceServer) JustifiedCheckpointHistory(arg0 context.Context, arg1 *v10.JustifiedHistoryRequest) (*v10.JustifiedHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JustifiedCheckpointHistory", arg0, arg1)
+	ret0, _ := ret[0].(*v10.JustifiedHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JustifiedCheckpointHistory indicates an expected call of JustifiedCheckpointHistory
+func (mr *MockBeaconServiceServerMockRecorder) JustifiedCheckpointHistory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JustifiedCheckpointHistory", reflect.TypeOf((*MockBeaconServiceServer)(nil).JustifiedCheckpointHistory), arg0, arg1)
+}
+
+// LastFinalizedSlot mocks base method
+func (m *MockBeaconServiceServer) LastFinalizedSlot(arg0 context.Context, arg1 *types.Empty) (*v10.LastFinalizedSlotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LastFinalizedSlot", arg0, arg1)
+	ret0, _ := ret[0].(*v10.LastFinalizedSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastFinalizedSlot indicates an expected call of LastFinalizedSlot
+func (mr *MockBeaconServiceServerMockRecorder) LastFinalizedSlot(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastFinalizedSlot", reflect.TypeOf((*MockBeaconServiceServer)(nil).LastFinalizedSlot), arg0, arg1)
+}
+
+// LatestAttestation mocks base method
+func (m *MockBeaconServiceServer) LatestAttestation(arg0 *types.Empty, arg1 v10.BeaconService_LatestAttestationServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LatestAttestation", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LatestAttestation indicates an expected call of LatestAttestation
+func (mr *MockBeaconServiceServerMockRecorder) LatestAttestation(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAttestation", reflect.TypeOf((*MockBeaconServiceServer)(nil).LatestAttestation), arg0, arg1)
+}
+
+// MissedAttesters mocks base method
+func (m *MockBeaconServiceServer) MissedAttesters(arg0 context.Context, arg1 *v10.MissedAttestersRequest) (*v10.MissedAttestersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MissedAttesters", arg0, arg1)
+	ret0, _ := ret[0].(*v10.MissedAttestersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MissedAttesters indicates an expected call of MissedAttesters
+func (mr *MockBeaconServiceServerMockRecorder) MissedAttesters(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MissedAttesters", reflect.TypeOf((*MockBeaconServiceServer)(nil).MissedAttesters), arg0, arg1)
+}
+
+// NextEth1VotingPeriod mocks base method
+func (m *MockBeaconServiceServer) NextEth1VotingPeriod(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1VotingPeriodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextEth1VotingPeriod", arg0, arg1)
+	ret0, _ := ret[0].(*v10.Eth1VotingPeriodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextEth1VotingPeriod indicates an expected call of NextEth1VotingPeriod
+func (mr *MockBeaconServiceServerMockRecorder) NextEth1VotingPeriod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextEth1VotingPeriod", reflect.TypeOf((*MockBeaconServiceServer)(nil).NextEth1VotingPeriod), arg0, arg1)
+}
+
+// PendingDepositCount mocks base method
+func (m *MockBeaconServiceServer) PendingDepositCount(arg0 context.Context, arg1 *types.Empty) (*v10.PendingDepositCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingDepositCount", arg0, arg1)
+	ret0, _ := ret[0].(*v10.PendingDepositCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingDepositCount indicates an expected call of PendingDepositCount
+func (mr *MockBeaconServiceServerMockRecorder) PendingDepositCount(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDepositCount", reflect.TypeOf((*MockBeaconServiceServer)(nil).PendingDepositCount), arg0, arg1)
+}
+
+// PendingDeposits mocks base method
+func (m *MockBeaconServiceServer) PendingDeposits(arg0 context.Context, arg1 *v10.PendingDepositsRequest) (*v10.PendingDepositsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PendingDeposits", arg0, arg1)
+	ret0, _ := ret[0].(*v10.PendingDepositsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingDeposits indicates an expected call of PendingDeposits
+func (mr *MockBeaconServiceServerMockRecorder) PendingDeposits(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceServer)(nil).PendingDeposits), arg0, arg1)
+}
+
+// ProposedBlock mocks base method
+func (m *MockBeaconServiceServer) ProposedBlock(arg0 context.Context, arg1 *v10.ProposedBlockRequest) (*v10.ProposedBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposedBlock", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ProposedBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposedBlock indicates an expected call of ProposedBlock
+func (mr *MockBeaconServiceServerMockRecorder) ProposedBlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposedBlock", reflect.TypeOf((*MockBeaconServiceServer)(nil).ProposedBlock), arg0, arg1)
+}
+
+// ProposerReward mocks base method
+func (m *MockBeaconServiceServer) ProposerReward(arg0 context.Context, arg1 *v10.BlockByRootRequest) (*v10.ProposerRewardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProposerReward", arg0, arg1)
+	ret0, _ := ret[0].(*v10.ProposerRewardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposerReward indicates an expected call of ProposerReward
+func (mr *MockBeaconServiceServerMockRecorder) ProposerReward(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposerReward", reflect.TypeOf((*MockBeaconServiceServer)(nil).ProposerReward), arg0, arg1)
+}
+
+// SkippedSlots mocks base method
+func (m *MockBeaconServiceServer) SkippedSlots(arg0 context.Context, arg1 *v10.SkippedSlotsRequest) (*v10.SkippedSlotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkippedSlots", arg0, arg1)
+	ret0, _ := ret[0].(*v10.SkippedSlotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SkippedSlots indicates an expected call of SkippedSlots
+func (mr *MockBeaconServiceServerMockRecorder) SkippedSlots(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkippedSlots", reflect.TypeOf((*MockBeaconServiceServer)(nil).SkippedSlots), arg0, arg1)
+}
+
+// SlotAttestationCoverage mocks base method
+func (m *MockBeaconServiceServer) SlotAttestationCoverage(arg0 context.Context, arg1 *v10.SlotCoverageRequest) (*v10.SlotCoverageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlotAttestationCoverage", arg0, arg1)
+	ret0, _ := ret[0].(*v10.SlotCoverageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlotAttestationCoverage indicates an expected call of SlotAttestationCoverage
+func (mr *MockBeaconServiceServerMockRecorder) SlotAttestationCoverage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlotAttestationCoverage", reflect.TypeOf((*MockBeaconServiceServer)(nil).SlotAttestationCoverage), arg0, arg1)
+}
+
+// SlotTickStream mocks base method
+func (m *MockBeaconServiceServer) SlotTickStream(arg0 *types.Empty, arg1 v10.BeaconService_SlotTickStreamServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlotTickStream", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SlotTickStream indicates an expected call of SlotTickStream
+func (mr *MockBeaconServiceServerMockRecorder) SlotTickStream(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlotTickStream", reflect.TypeOf((*MockBeaconServiceServer)(nil).SlotTickStream), arg0, arg1)
+}
+
+// StateSchemaInfo mocks base method
+func (m *MockBeaconServiceServer) StateSchemaInfo(arg0 context.Context, arg1 *types.Empty) (*v10.StateSchemaInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StateSchemaInfo", arg0, arg1)
+	ret0, _ := ret[0].(*v10.StateSchemaInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateSchemaInfo indicates an expected call of StateSchemaInfo
+func (mr *MockBeaconServiceServerMockRecorder) StateSchemaInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateSchemaInfo", reflect.TypeOf((*MockBeaconServiceServer)(nil).StateSchemaInfo), arg0, arg1)
+}
+
+// SyncStatus mocks base method
+func (m *MockBeaconServiceServer) SyncStatus(arg0 context.Context, arg1 *types.Empty) (*v10.SyncStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v10.SyncStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStatus indicates an expected call of SyncStatus
+func (mr *MockBeaconServiceServerMockRecorder) SyncStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockBeaconServiceServer)(nil).SyncStatus), arg0, arg1)
+}
+
+// TotalDeposited mocks base method
+func (m *MockBeaconServiceServer) TotalDeposited(arg0 context.Context, arg1 *types.Empty) (*v10.TotalDepositedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalDeposited", arg0, arg1)
+	ret0, _ := ret[0].(*v10.TotalDepositedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalDeposited indicates an expected call of TotalDeposited
+func (mr *MockBeaconServiceServerMockRecorder) TotalDeposited(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalDeposited", reflect.TypeOf((*MockBeaconServiceServer)(nil).TotalDeposited), arg0, arg1)
+}
+
+// UpcomingActivations mocks base method
+func (m *MockBeaconServiceServer) UpcomingActivations(arg0 context.Context, arg1 *types.Empty) (*v10.UpcomingActivationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpcomingActivations", arg0, arg1)
+	ret0, _ := ret[0].(*v10.UpcomingActivationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpcomingActivations indicates an expected call of UpcomingActivations
+func (mr *MockBeaconServiceServerMockRecorder) UpcomingActivations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpcomingActivations", reflect.TypeOf((*MockBeaconServiceServer)(nil).UpcomingActivations), arg0, arg1)
+}
+
+// WaitForChainStart mocks base method
+func (m *MockBeaconServiceServer) WaitForChainStart(arg0 *types.Empty, arg1 v10.BeaconService_WaitForChainStartServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForChainStart", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForChainStart indicates an expected call of WaitForChainStart
+func (mr *MockBeaconServiceServerMockRecorder) WaitForChainStart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForChainStart", reflect.TypeOf((*MockBeaconServiceServer)(nil).WaitForChainStart), arg0, arg1)
+}
+
+// MockBeaconService_LatestAttestationServer is a mock of BeaconService_LatestAttestationServer interface
+type MockBeaconService_LatestAttestationServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_LatestAttestationServerMockRecorder
+}
+
+// MockBeaconService_LatestAttestationServerMockRecorder is the mock recorder for MockBeaconService_LatestAttestationServer
+type MockBeaconService_LatestAttestationServerMockRecorder struct {
+	mock *MockBeaconService_LatestAttestationServer
+}
+
+// NewMockBeaconService_LatestAttestationServer creates a new mock instance
+func NewMockBeaconService_LatestAttestationServer(ctrl *gomock.Controller) *MockBeaconService_LatestAttestationServer {
+	mock := &MockBeaconService_LatestAttestationServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_LatestAttestationServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_LatestAttestationServer) EXPECT() *MockBeaconService_LatestAttestationServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockBeaconService_LatestAttestationServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_LatestAttestationServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconService_LatestAttestationServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_LatestAttestationServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).RecvMsg), arg0)
+}
+
+// Send mocks base method
+func (m *MockBeaconService_LatestAttestationServer) Send(arg0 *v1.Attestation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockBeaconService_LatestAttestationServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockBeaconService_LatestAttestationServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconService_LatestAttestationServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockBeaconService_LatestAttestationServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockBeaconService_LatestAttestationServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SetTrailer), arg0)
+}
+
+// MockBeaconService_WaitForChainStartServer is a mock of BeaconService_WaitForChainStartServer interface
+type MockBeaconService_WaitForChainStartServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_WaitForChainStartServerMockRecorder
+}
+
+// MockBeaconService_WaitForChainStartServerMockRecorder is the mock recorder for MockBeaconService_WaitForChainStartServer
+type MockBeaconService_WaitForChainStartServerMockRecorder struct {
+	mock *MockBeaconService_WaitForChainStartServer
+}
+
+// NewMockBeaconService_WaitForChainStartServer creates a new mock instance
+func NewMockBeaconService_WaitForChainStartServer(ctrl *gomock.Controller) *MockBeaconService_WaitForChainStartServer {
+	mock := &MockBeaconService_WaitForChainStartServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_WaitForChainStartServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_WaitForChainStartServer) EXPECT() *MockBeaconService_WaitForChainStartServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockBeaconService_WaitForChainStartServer) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockTree", arg0, arg1)
-	ret0, _ := ret[0].(*v10.BlockTreeResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// BlockTree indicates an expected call of BlockTree
-func (mr *MockBeaconServiceServerMockRecorder) BlockTree(arg0, arg1 interface{}) *gomock.Call {
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTree", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlockTree), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).Context))
 }
 
-// BlockTreeBySlots mocks base method
-func (m *MockBeaconServiceServer) BlockTreeBySlots(arg0 context.Context, arg1 *v10.TreeBlockSlotRequest) (*v10.BlockTreeResponse, error) {
+// RecvMsg mocks base method
+func (m *MockBeaconService_WaitForChainStartServer) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockTreeBySlots", arg0, arg1)
-	ret0, _ := ret[0].(*v10.BlockTreeResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// BlockTreeBySlots indicates an expected call of BlockTreeBySlots
-func (mr *MockBeaconServiceServerMockRecorder) BlockTreeBySlots(arg0, arg1 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTreeBySlots", reflect.TypeOf((*MockBeaconServiceServer)(nil).BlockTreeBySlots), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).RecvMsg), arg0)
 }
 
-// CanonicalHead mocks base method
-func (m *MockBeaconServiceServer) CanonicalHead(arg0 context.Context, arg1 *types.Empty) (*v1.BeaconBlock, error) {
+// Send mocks base method
+func (m *MockBeaconService_WaitForChainStartServer) Send(arg0 *v10.ChainStartResponse) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CanonicalHead", arg0, arg1)
-	ret0, _ := ret[0].(*v1.BeaconBlock)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CanonicalHead indicates an expected call of CanonicalHead
-func (mr *MockBeaconServiceServerMockRecorder) CanonicalHead(arg0, arg1 interface{}) *gomock.Call {
+// Send indicates an expected call of Send
+func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceServer)(nil).CanonicalHead), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).Send), arg0)
 }
 
-// Eth1Data mocks base method
-func (m *MockBeaconServiceServer) Eth1Data(arg0 context.Context, arg1 *types.Empty) (*v10.Eth1DataResponse, error) {
+// SendHeader mocks base method
+func (m *MockBeaconService_WaitForChainStartServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Eth1Data", arg0, arg1)
-	ret0, _ := ret[0].(*v10.Eth1DataResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Eth1Data indicates an expected call of Eth1Data
-func (mr *MockBeaconServiceServerMockRecorder) Eth1Data(arg0, arg1 interface{}) *gomock.Call {
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1Data), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SendHeader), arg0)
 }
 
-// ForkData mocks base method
-func (m *MockBeaconServiceServer) ForkData(arg0 context.Context, arg1 *types.Empty) (*v1.Fork, error) {
+// SendMsg mocks base method
+func (m *MockBeaconService_WaitForChainStartServer) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ForkData", arg0, arg1)
-	ret0, _ := ret[0].(*v1.Fork)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ForkData indicates an expected call of ForkData
-func (mr *MockBeaconServiceServerMockRecorder) ForkData(arg0, arg1 interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkData", reflect.TypeOf((*MockBeaconServiceServer)(nil).ForkData), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SendMsg), arg0)
 }
 
-// LatestAttestation mocks base method
-func (m *MockBeaconServiceServer) LatestAttestation(arg0 *types.Empty, arg1 v10.BeaconService_LatestAttestationServer) error {
+// SetHeader mocks base method
+func (m *MockBeaconService_WaitForChainStartServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LatestAttestation", arg0, arg1)
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// LatestAttestation indicates an expected call of LatestAttestation
-func (mr *MockBeaconServiceServerMockRecorder) LatestAttestation(arg0, arg1 interface{}) *gomock.Call {
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAttestation", reflect.TypeOf((*MockBeaconServiceServer)(nil).LatestAttestation), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SetHeader), arg0)
 }
 
-// PendingDeposits mocks base method
-func (m *MockBeaconServiceServer) PendingDeposits(arg0 context.Context, arg1 *types.Empty) (*v10.PendingDepositsResponse, error) {
+// SetTrailer mocks base method
+func (m *MockBeaconService_WaitForChainStartServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PendingDeposits", arg0, arg1)
-	ret0, _ := ret[0].(*v10.PendingDepositsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
-// PendingDeposits indicates an expected call of PendingDeposits
-func (mr *MockBeaconServiceServerMockRecorder) PendingDeposits(arg0, arg1 interface{}) *gomock.Call {
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceServer)(nil).PendingDeposits), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SetTrailer), arg0)
 }
 
-// WaitForChainStart mocks base method
-func (m *MockBeaconServiceServer) WaitForChainStart(arg0 *types.Empty, arg1 v10.BeaconService_WaitForChainStartServer) error {
+// MockBeaconService_BlockStreamServer is a mock of BeaconService_BlockStreamServer interface
+type MockBeaconService_BlockStreamServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_BlockStreamServerMockRecorder
+}
+
+// MockBeaconService_BlockStreamServerMockRecorder is the mock recorder for MockBeaconService_BlockStreamServer
+type MockBeaconService_BlockStreamServerMockRecorder struct {
+	mock *MockBeaconService_BlockStreamServer
+}
+
+// NewMockBeaconService_BlockStreamServer creates a new mock instance
+func NewMockBeaconService_BlockStreamServer(ctrl *gomock.Controller) *MockBeaconService_BlockStreamServer {
+	mock := &MockBeaconService_BlockStreamServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_BlockStreamServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_BlockStreamServer) EXPECT() *MockBeaconService_BlockStreamServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method
+func (m *MockBeaconService_BlockStreamServer) Context() context.Context {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "WaitForChainStart", arg0, arg1)
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_BlockStreamServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_BlockStreamServer)(nil).Context))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconService_BlockStreamServer) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// WaitForChainStart indicates an expected call of WaitForChainStart
-func (mr *MockBeaconServiceServerMockRecorder) WaitForChainStart(arg0, arg1 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_BlockStreamServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForChainStart", reflect.TypeOf((*MockBeaconServiceServer)(nil).WaitForChainStart), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_BlockStreamServer)(nil).RecvMsg), arg0)
 }
 
-// MockBeaconService_LatestAttestationServer is a mock of BeaconService_LatestAttestationServer interface
-type MockBeaconService_LatestAttestationServer struct {
+// Send mocks base method
+func (m *MockBeaconService_BlockStreamServer) Send(arg0 *v1.BeaconBlock) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send
+func (mr *MockBeaconService_BlockStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_BlockStreamServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method
+func (m *MockBeaconService_BlockStreamServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader
+func (mr *MockBeaconService_BlockStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_BlockStreamServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconService_BlockStreamServer) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_BlockStreamServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_BlockStreamServer)(nil).SendMsg), arg0)
+}
+
+// SetHeader mocks base method
+func (m *MockBeaconService_BlockStreamServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader
+func (mr *MockBeaconService_BlockStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_BlockStreamServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method
+func (m *MockBeaconService_BlockStreamServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer
+func (mr *MockBeaconService_BlockStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_BlockStreamServer)(nil).SetTrailer), arg0)
+}
+
+// MockBeaconService_SlotTickStreamServer is a mock of BeaconService_SlotTickStreamServer interface
+type MockBeaconService_SlotTickStreamServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockBeaconService_LatestAttestationServerMockRecorder
+	recorder *MockBeaconService_SlotTickStreamServerMockRecorder
 }
 
-// MockBeaconService_LatestAttestationServerMockRecorder is the mock recorder for MockBeaconService_LatestAttestationServer
-type MockBeaconService_LatestAttestationServerMockRecorder struct {
-	mock *MockBeaconService_LatestAttestationServer
+// MockBeaconService_SlotTickStreamServerMockRecorder is the mock recorder for MockBeaconService_SlotTickStreamServer
+type MockBeaconService_SlotTickStreamServerMockRecorder struct {
+	mock *MockBeaconService_SlotTickStreamServer
 }
 
-// NewMockBeaconService_LatestAttestationServer creates a new mock instance
-func NewMockBeaconService_LatestAttestationServer(ctrl *gomock.Controller) *MockBeaconService_LatestAttestationServer {
-	mock := &MockBeaconService_LatestAttestationServer{ctrl: ctrl}
-	mock.recorder = &MockBeaconService_LatestAttestationServerMockRecorder{mock}
+// NewMockBeaconService_SlotTickStreamServer creates a new mock instance
+func NewMockBeaconService_SlotTickStreamServer(ctrl *gomock.Controller) *MockBeaconService_SlotTickStreamServer {
+	mock := &MockBeaconService_SlotTickStreamServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_SlotTickStreamServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockBeaconService_LatestAttestationServer) EXPECT() *MockBeaconService_LatestAttestationServerMockRecorder {
+func (m *MockBeaconService_SlotTickStreamServer) EXPECT() *MockBeaconService_SlotTickStreamServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method
-func (m *MockBeaconService_LatestAttestationServer) Context() context.Context {
+func (m *MockBeaconService_SlotTickStreamServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -188,13 +1112,13 @@ func (m *MockBeaconService_LatestAttestationServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context
-func (mr *MockBeaconService_LatestAttestationServerMockRecorder) Context() *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_SlotTickStreamServer)(nil).Context))
 }
 
 // RecvMsg mocks base method
-func (m *MockBeaconService_LatestAttestationServer) RecvMsg(arg0 interface{}) error {
+func (m *MockBeaconService_SlotTickStreamServer) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -202,13 +1126,13 @@ func (m *MockBeaconService_LatestAttestationServer) RecvMsg(arg0 interface{}) er
 }
 
 // RecvMsg indicates an expected call of RecvMsg
-func (mr *MockBeaconService_LatestAttestationServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).RecvMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_SlotTickStreamServer)(nil).RecvMsg), arg0)
 }
 
 // Send mocks base method
-func (m *MockBeaconService_LatestAttestationServer) Send(arg0 *v1.Attestation) error {
+func (m *MockBeaconService_SlotTickStreamServer) Send(arg0 *v10.SlotTick) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
@@ -216,13 +1140,13 @@ func (m *MockBeaconService_LatestAttestationServer) Send(arg0 *v1.Attestation) e
 }
 
 // Send indicates an expected call of Send
-func (mr *MockBeaconService_LatestAttestationServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_SlotTickStreamServer)(nil).Send), arg0)
 }
 
 // SendHeader mocks base method
-func (m *MockBeaconService_LatestAttestationServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockBeaconService_SlotTickStreamServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -230,13 +1154,13 @@ func (m *MockBeaconService_LatestAttestationServer) SendHeader(arg0 metadata.MD)
 }
 
 // SendHeader indicates an expected call of SendHeader
-func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_SlotTickStreamServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method
-func (m *MockBeaconService_LatestAttestationServer) SendMsg(arg0 interface{}) error {
+func (m *MockBeaconService_SlotTickStreamServer) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -244,13 +1168,13 @@ func (m *MockBeaconService_LatestAttestationServer) SendMsg(arg0 interface{}) er
 }
 
 // SendMsg indicates an expected call of SendMsg
-func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SendMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_SlotTickStreamServer)(nil).SendMsg), arg0)
 }
 
 // SetHeader mocks base method
-func (m *MockBeaconService_LatestAttestationServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockBeaconService_SlotTickStreamServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -258,48 +1182,48 @@ func (m *MockBeaconService_LatestAttestationServer) SetHeader(arg0 metadata.MD)
 }
 
 // SetHeader indicates an expected call of SetHeader
-func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_SlotTickStreamServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method
-func (m *MockBeaconService_LatestAttestationServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockBeaconService_SlotTickStreamServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer
-func (mr *MockBeaconService_LatestAttestationServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_LatestAttestationServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_SlotTickStreamServer)(nil).SetTrailer), arg0)
 }
 
-// MockBeaconService_WaitForChainStartServer is a mock of BeaconService_WaitForChainStartServer interface
-type MockBeaconService_WaitForChainStartServer struct {
+// MockBeaconService_AggregateAttestationStreamServer is a mock of BeaconService_AggregateAttestationStreamServer interface
+type MockBeaconService_AggregateAttestationStreamServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockBeaconService_WaitForChainStartServerMockRecorder
+	recorder *MockBeaconService_AggregateAttestationStreamServerMockRecorder
 }
 
-// MockBeaconService_WaitForChainStartServerMockRecorder is the mock recorder for MockBeaconService_WaitForChainStartServer
-type MockBeaconService_WaitForChainStartServerMockRecorder struct {
-	mock *MockBeaconService_WaitForChainStartServer
+// MockBeaconService_AggregateAttestationStreamServerMockRecorder is the mock recorder for MockBeaconService_AggregateAttestationStreamServer
+type MockBeaconService_AggregateAttestationStreamServerMockRecorder struct {
+	mock *MockBeaconService_AggregateAttestationStreamServer
 }
 
-// NewMockBeaconService_WaitForChainStartServer creates a new mock instance
-func NewMockBeaconService_WaitForChainStartServer(ctrl *gomock.Controller) *MockBeaconService_WaitForChainStartServer {
-	mock := &MockBeaconService_WaitForChainStartServer{ctrl: ctrl}
-	mock.recorder = &MockBeaconService_WaitForChainStartServerMockRecorder{mock}
+// NewMockBeaconService_AggregateAttestationStreamServer creates a new mock instance
+func NewMockBeaconService_AggregateAttestationStreamServer(ctrl *gomock.Controller) *MockBeaconService_AggregateAttestationStreamServer {
+	mock := &MockBeaconService_AggregateAttestationStreamServer{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_AggregateAttestationStreamServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockBeaconService_WaitForChainStartServer) EXPECT() *MockBeaconService_WaitForChainStartServerMockRecorder {
+func (m *MockBeaconService_AggregateAttestationStreamServer) EXPECT() *MockBeaconService_AggregateAttestationStreamServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method
-func (m *MockBeaconService_WaitForChainStartServer) Context() context.Context {
+func (m *MockBeaconService_AggregateAttestationStreamServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -307,13 +1231,13 @@ func (m *MockBeaconService_WaitForChainStartServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context
-func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) Context() *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamServer)(nil).Context))
 }
 
 // RecvMsg mocks base method
-func (m *MockBeaconService_WaitForChainStartServer) RecvMsg(arg0 interface{}) error {
+func (m *MockBeaconService_AggregateAttestationStreamServer) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -321,13 +1245,13 @@ func (m *MockBeaconService_WaitForChainStartServer) RecvMsg(arg0 interface{}) er
 }
 
 // RecvMsg indicates an expected call of RecvMsg
-func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamServerMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).RecvMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamServer)(nil).RecvMsg), arg0)
 }
 
 // Send mocks base method
-func (m *MockBeaconService_WaitForChainStartServer) Send(arg0 *v10.ChainStartResponse) error {
+func (m *MockBeaconService_AggregateAttestationStreamServer) Send(arg0 *v1.Attestation) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
@@ -335,13 +1259,13 @@ func (m *MockBeaconService_WaitForChainStartServer) Send(arg0 *v10.ChainStartRes
 }
 
 // Send indicates an expected call of Send
-func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamServer)(nil).Send), arg0)
 }
 
 // SendHeader mocks base method
-func (m *MockBeaconService_WaitForChainStartServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockBeaconService_AggregateAttestationStreamServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -349,13 +1273,13 @@ func (m *MockBeaconService_WaitForChainStartServer) SendHeader(arg0 metadata.MD)
 }
 
 // SendHeader indicates an expected call of SendHeader
-func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method
-func (m *MockBeaconService_WaitForChainStartServer) SendMsg(arg0 interface{}) error {
+func (m *MockBeaconService_AggregateAttestationStreamServer) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -363,13 +1287,13 @@ func (m *MockBeaconService_WaitForChainStartServer) SendMsg(arg0 interface{}) er
 }
 
 // SendMsg indicates an expected call of SendMsg
-func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamServerMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SendMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamServer)(nil).SendMsg), arg0)
 }
 
 // SetHeader mocks base method
-func (m *MockBeaconService_WaitForChainStartServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockBeaconService_AggregateAttestationStreamServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -377,19 +1301,19 @@ func (m *MockBeaconService_WaitForChainStartServer) SetHeader(arg0 metadata.MD)
 }
 
 // SetHeader indicates an expected call of SetHeader
-func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method
-func (m *MockBeaconService_WaitForChainStartServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockBeaconService_AggregateAttestationStreamServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer
-func (mr *MockBeaconService_WaitForChainStartServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_WaitForChainStartServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamServer)(nil).SetTrailer), arg0)
 }
@@ -14,15 +14,20 @@ import (
 
 // SetupDB instantiates and returns a BeaconDB instance.
 func SetupDB(t testing.TB) *db.BeaconDB {
+	return SetupDBWithStorage(t, db.DiskStorage)
+}
+
+// SetupDBWithStorage instantiates and returns a BeaconDB instance using the given storage kind.
+func SetupDBWithStorage(t testing.TB, storage db.Storage) *db.BeaconDB {
 	randPath, err := rand.Int(rand.Reader, big.NewInt(1000000))
 	if err != nil {
 		t.Fatalf("Could not generate random file path: %v", err)
 	}
-	path := path.Join(testutil.TempDir(), fmt.Sprintf("/%d", randPath))
+	path := path.Join(storage.TempDir(testutil.TempDir()), fmt.Sprintf("/%d", randPath))
 	if err := os.RemoveAll(path); err != nil {
 		t.Fatalf("Failed to remove directory: %v", err)
 	}
-	db, err := db.NewDB(path)
+	db, err := db.NewDBWithStorage(path, storage)
 	if err != nil {
 		t.Fatalf("Could not setup DB: %v", err)
 	}
@@ -36,6 +36,21 @@ func (m *MockValidatorServiceServer) EXPECT() *MockValidatorServiceServerMockRec
 	return m.recorder
 }
 
+// AggregatePublicKey mocks base method
+func (m *MockValidatorServiceServer) AggregatePublicKey(arg0 context.Context, arg1 *v1.AggregatePublicKeyRequest) (*v1.AggregatePublicKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AggregatePublicKey", arg0, arg1)
+	ret0, _ := ret[0].(*v1.AggregatePublicKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregatePublicKey indicates an expected call of AggregatePublicKey
+func (mr *MockValidatorServiceServerMockRecorder) AggregatePublicKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregatePublicKey", reflect.TypeOf((*MockValidatorServiceServer)(nil).AggregatePublicKey), arg0, arg1)
+}
+
 // CommitteeAssignment mocks base method
 func (m *MockValidatorServiceServer) CommitteeAssignment(arg0 context.Context, arg1 *v1.CommitteeAssignmentsRequest) (*v1.CommitteeAssignmentResponse, error) {
 	m.ctrl.T.Helper()
@@ -66,6 +81,96 @@ func (mr *MockValidatorServiceServerMockRecorder) ExitedValidators(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitedValidators", reflect.TypeOf((*MockValidatorServiceServer)(nil).ExitedValidators), arg0, arg1)
 }
 
+// ValidatePubkey mocks base method
+func (m *MockValidatorServiceServer) ValidatePubkey(arg0 context.Context, arg1 *v1.ValidatePubkeyRequest) (*v1.ValidatePubkeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatePubkey", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatePubkeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatePubkey indicates an expected call of ValidatePubkey
+func (mr *MockValidatorServiceServerMockRecorder) ValidatePubkey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePubkey", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatePubkey), arg0, arg1)
+}
+
+// ValidatorAttestations mocks base method
+func (m *MockValidatorServiceServer) ValidatorAttestations(arg0 context.Context, arg1 *v1.ValidatorAttestationsRequest) (*v1.ValidatorAttestationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorAttestations", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorAttestationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorAttestations indicates an expected call of ValidatorAttestations
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorAttestations(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorAttestations", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorAttestations), arg0, arg1)
+}
+
+// ValidatorAttested mocks base method
+func (m *MockValidatorServiceServer) ValidatorAttested(arg0 context.Context, arg1 *v1.ValidatorAttestedRequest) (*v1.ValidatorAttestedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorAttested", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorAttestedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorAttested indicates an expected call of ValidatorAttested
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorAttested(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorAttested", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorAttested), arg0, arg1)
+}
+
+// ValidatorBalanceDelta mocks base method
+func (m *MockValidatorServiceServer) ValidatorBalanceDelta(arg0 context.Context, arg1 *v1.ValidatorBalanceDeltaRequest) (*v1.ValidatorBalanceDeltaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorBalanceDelta", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorBalanceDeltaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorBalanceDelta indicates an expected call of ValidatorBalanceDelta
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorBalanceDelta(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorBalanceDelta", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorBalanceDelta), arg0, arg1)
+}
+
+// ValidatorBalances mocks base method
+func (m *MockValidatorServiceServer) ValidatorBalances(arg0 context.Context, arg1 *v1.ValidatorBalancesRequest) (*v1.ValidatorBalancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorBalances", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorBalancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorBalances indicates an expected call of ValidatorBalances
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorBalances(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorBalances", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorBalances), arg0, arg1)
+}
+
+// ValidatorDuties mocks base method
+func (m *MockValidatorServiceServer) ValidatorDuties(arg0 context.Context, arg1 *v1.ValidatorDutiesRequest) (*v1.ValidatorDutiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorDuties", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorDutiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorDuties indicates an expected call of ValidatorDuties
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorDuties(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorDuties", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorDuties), arg0, arg1)
+}
+
 // ValidatorIndex mocks base method
 func (m *MockValidatorServiceServer) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +201,21 @@ func (mr *MockValidatorServiceServerMockRecorder) ValidatorPerformance(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformance", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorPerformance), arg0, arg1)
 }
 
+// ValidatorPerformanceSummary mocks base method
+func (m *MockValidatorServiceServer) ValidatorPerformanceSummary(arg0 context.Context, arg1 *v1.ValidatorPerformanceSummaryRequest) (*v1.ValidatorPerformanceSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidatorPerformanceSummary", arg0, arg1)
+	ret0, _ := ret[0].(*v1.ValidatorPerformanceSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorPerformanceSummary indicates an expected call of ValidatorPerformanceSummary
+func (mr *MockValidatorServiceServerMockRecorder) ValidatorPerformanceSummary(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformanceSummary", reflect.TypeOf((*MockValidatorServiceServer)(nil).ValidatorPerformanceSummary), arg0, arg1)
+}
+
 // ValidatorStatus mocks base method
 func (m *MockValidatorServiceServer) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest) (*v1.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +245,36 @@ func (mr *MockValidatorServiceServerMockRecorder) WaitForActivation(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForActivation", reflect.TypeOf((*MockValidatorServiceServer)(nil).WaitForActivation), arg0, arg1)
 }
 
+// WithdrawableValidators mocks base method
+func (m *MockValidatorServiceServer) WithdrawableValidators(arg0 context.Context, arg1 *v1.WithdrawableValidatorsRequest) (*v1.WithdrawableValidatorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawableValidators", arg0, arg1)
+	ret0, _ := ret[0].(*v1.WithdrawableValidatorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawableValidators indicates an expected call of WithdrawableValidators
+func (mr *MockValidatorServiceServerMockRecorder) WithdrawableValidators(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawableValidators", reflect.TypeOf((*MockValidatorServiceServer)(nil).WithdrawableValidators), arg0, arg1)
+}
+
+// WithdrawalCredentials mocks base method
+func (m *MockValidatorServiceServer) WithdrawalCredentials(arg0 context.Context, arg1 *v1.WithdrawalCredentialsRequest) (*v1.WithdrawalCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithdrawalCredentials", arg0, arg1)
+	ret0, _ := ret[0].(*v1.WithdrawalCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawalCredentials indicates an expected call of WithdrawalCredentials
+func (mr *MockValidatorServiceServerMockRecorder) WithdrawalCredentials(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawalCredentials", reflect.TypeOf((*MockValidatorServiceServer)(nil).WithdrawalCredentials), arg0, arg1)
+}
+
 // MockValidatorService_WaitForActivationServer is a mock of ValidatorService_WaitForActivationServer interface
 type MockValidatorService_WaitForActivationServer struct {
 	ctrl     *gomock.Controller
@@ -16,7 +16,6 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
-	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 )
 
@@ -72,7 +71,6 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 	if int64(index) <= w.lastReceivedMerkleIndex {
 		return
 	}
-	w.lastReceivedMerkleIndex = int64(index)
 
 	// We then decode the deposit input in order to create a deposit object
 	// we can store in our persistent DB.
@@ -98,11 +96,20 @@ func (w *Web3Service) ProcessDepositLog(depositLog gethTypes.Log) {
 		}
 	}
 
+	// We update the in-memory deposit trie incrementally with every received deposit,
+	// which only rehashes the path from the new leaf to the root. A deposit the trie
+	// rejects is still stored, but its index is not marked as received.
+	if err := w.depositTrie.InsertIntoTrie(depositData, int(index)); err != nil {
+		log.Errorf("Could not insert deposit into deposit trie: %v", err)
+	} else {
+		w.lastReceivedMerkleIndex = int64(index)
+	}
+
 	// We always store all historical deposits in the DB.
 	w.beaconDB.InsertDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
 
 	if !w.chainStarted {
-		w.chainStartDeposits = append(w.chainStartDeposits, depositData)
+		w.chainStartDeposits = append(w.chainStartDeposits, deposit)
 	} else {
 		w.beaconDB.InsertPendingDeposit(w.ctx, deposit, big.NewInt(int64(depositLog.BlockNumber)))
 	}
@@ -139,18 +146,6 @@ func (w *Web3Service) ProcessChainStartLog(depositLog gethTypes.Log) {
 	w.depositRoot = chainStartDepositRoot[:]
 	chainStartTime := time.Unix(int64(timestamp), 0)
 
-	// We then update the in-memory deposit trie from the chain start
-	// deposits at this point, as this trie will be later needed for
-	// incoming, post-chain start deposits.
-	sparseMerkleTrie, err := trieutil.GenerateTrieFromItems(
-		w.chainStartDeposits,
-		int(params.BeaconConfig().DepositContractTreeDepth),
-	)
-	if err != nil {
-		log.Fatalf("Unable to generate deposit trie from ChainStart deposits: %v", err)
-	}
-	w.depositTrie = sparseMerkleTrie
-
 	log.WithFields(logrus.Fields{
 		"ChainStartTime": chainStartTime,
 	}).Info("Minimum number of validators reached for beacon-chain to start")
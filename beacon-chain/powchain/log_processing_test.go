@@ -16,7 +16,9 @@ import (
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -163,6 +165,87 @@ func TestProcessDepositLog_InsertsPendingDeposit(t *testing.T) {
 	hook.Reset()
 }
 
+func TestProcessDepositLog_NonContiguousIndex(t *testing.T) {
+	hook := logTest.NewGlobal()
+	testAcc, err := setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.contractAddr,
+		Reader:          &goodReader{},
+		Logger:          &goodLogger{},
+		HTTPLogger:      &goodLogger{},
+		ContractBackend: testAcc.backend,
+		BeaconDB:        &db.BeaconDB{},
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	testAcc.backend.Commit()
+
+	var stub [48]byte
+	copy(stub[:], []byte("testing"))
+
+	data := &pb.DepositInput{
+		Pubkey:                      stub[:],
+		ProofOfPossession:           stub[:],
+		WithdrawalCredentialsHash32: []byte("withdraw"),
+	}
+
+	serializedData := new(bytes.Buffer)
+	if err := ssz.Encode(serializedData, data); err != nil {
+		t.Fatalf("Could not serialize data %v", err)
+	}
+
+	testAcc.txOpts.Value = amount32Eth
+	for i := 0; i < 3; i++ {
+		if _, err := testAcc.contract.Deposit(testAcc.txOpts, serializedData.Bytes()); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+	}
+
+	testAcc.backend.Commit()
+
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{
+			web3Service.depositContractAddress,
+		},
+	}
+
+	logs, err := testAcc.backend.FilterLogs(web3Service.ctx, query)
+	if err != nil {
+		t.Fatalf("Unable to retrieve logs %v", err)
+	}
+
+	web3Service.chainStarted = true
+
+	// The deposit at merkle index 2 leaves a gap in the trie, so it cannot be inserted
+	// but must still be stored.
+	web3Service.ProcessDepositLog(logs[2])
+	testutil.AssertLogsContain(t, hook, "Could not insert deposit into deposit trie")
+	if web3Service.lastReceivedMerkleIndex != -1 {
+		t.Errorf("Expected last received merkle index to remain -1, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+	pendingDeposits := web3Service.beaconDB.PendingDeposits(context.Background(), nil /*blockNum*/)
+	if len(pendingDeposits) != 1 {
+		t.Errorf("Unexpected number of deposits. Wanted 1 deposit, got %+v", pendingDeposits)
+	}
+
+	// Later deposits which fit the trie are still processed.
+	web3Service.ProcessDepositLog(logs[0])
+	if web3Service.lastReceivedMerkleIndex != 0 {
+		t.Errorf("Expected last received merkle index 0, received %d", web3Service.lastReceivedMerkleIndex)
+	}
+	pendingDeposits = web3Service.beaconDB.PendingDeposits(context.Background(), nil /*blockNum*/)
+	if len(pendingDeposits) != 2 {
+		t.Errorf("Unexpected number of deposits. Wanted 2 deposits, got %+v", pendingDeposits)
+	}
+	hook.Reset()
+}
+
 func TestUnpackDepositLogData_OK(t *testing.T) {
 	testAcc, err := setup()
 	if err != nil {
@@ -406,6 +489,15 @@ func TestProcessChainStartLog_8UniquePubkeys(t *testing.T) {
 		)
 	}
 
+	// The incrementally updated deposit trie must match a trie rebuilt from all deposits.
+	rebuiltTrie, err := trieutil.GenerateTrieFromItems(cachedDeposits, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatalf("Could not generate deposit trie: %v", err)
+	}
+	if web3Service.DepositRoot() != rebuiltTrie.Root() {
+		t.Errorf("Expected deposit root %#x, received %#x", rebuiltTrie.Root(), web3Service.DepositRoot())
+	}
+
 	<-genesisTimeChan
 	testutil.AssertLogsDoNotContain(t, hook, "Unable to unpack ChainStart log data")
 	testutil.AssertLogsDoNotContain(t, hook, "Receipt root from log doesn't match the root saved in memory")
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"runtime/debug"
+	"sort"
 	"strings"
 	"time"
 
@@ -88,7 +89,7 @@ type Web3Service struct {
 	depositContractCaller   *contracts.DepositContractCaller
 	depositRoot             []byte
 	depositTrie             *trieutil.MerkleTrie
-	chainStartDeposits      [][]byte
+	chainStartDeposits      []*pb.Deposit
 	chainStarted            bool
 	chainStartETH1Data      *pb.Eth1Data
 	beaconDB                *db.BeaconDB
@@ -149,7 +150,7 @@ func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Servic
 		httpLogger:              config.HTTPLogger,
 		blockFetcher:            config.BlockFetcher,
 		depositContractCaller:   depositContractCaller,
-		chainStartDeposits:      [][]byte{},
+		chainStartDeposits:      []*pb.Deposit{},
 		beaconDB:                config.BeaconDB,
 		lastReceivedMerkleIndex: -1,
 		lastRequestedBlock:      big.NewInt(0),
@@ -184,9 +185,23 @@ func (w *Web3Service) ChainStartFeed() *event.Feed {
 }
 
 // ChainStartDeposits returns a slice of validator deposit data processed
-// by the deposit contract and cached in the powchain service.
+// by the deposit contract and cached in the powchain service. The deposit data
+// is ordered by merkle tree index with duplicate indices removed, so every node
+// builds its genesis state from the same deposits regardless of log order.
 func (w *Web3Service) ChainStartDeposits() [][]byte {
-	return w.chainStartDeposits
+	deposits := make([]*pb.Deposit, len(w.chainStartDeposits))
+	copy(deposits, w.chainStartDeposits)
+	sort.SliceStable(deposits, func(i, j int) bool {
+		return deposits[i].MerkleTreeIndex < deposits[j].MerkleTreeIndex
+	})
+	depositData := make([][]byte, 0, len(deposits))
+	for i, dep := range deposits {
+		if i > 0 && dep.MerkleTreeIndex == deposits[i-1].MerkleTreeIndex {
+			continue
+		}
+		depositData = append(depositData, dep.DepositData)
+	}
+	return depositData
 }
 
 // ChainStartETH1Data returns the eth1 data at chainstart.
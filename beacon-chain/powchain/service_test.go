@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -21,6 +22,7 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -367,3 +369,22 @@ func TestHandlePanic_OK(t *testing.T) {
 	web3Service.processSubscribedHeaders(nil)
 	testutil.AssertLogsContain(t, hook, "Panicked when handling data from ETH 1.0 Chain!")
 }
+
+func TestChainStartDeposits_SortedAndDeduplicated(t *testing.T) {
+	web3Service := &Web3Service{
+		chainStartDeposits: []*pb.Deposit{
+			{MerkleTreeIndex: 2, DepositData: []byte("C")},
+			{MerkleTreeIndex: 0, DepositData: []byte("A")},
+			{MerkleTreeIndex: 3, DepositData: []byte("D")},
+			{MerkleTreeIndex: 1, DepositData: []byte("B")},
+			{MerkleTreeIndex: 2, DepositData: []byte("C")},
+		},
+	}
+	want := [][]byte{[]byte("A"), []byte("B"), []byte("C"), []byte("D")}
+	if got := web3Service.ChainStartDeposits(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Wanted chain start deposits %s, received %s", want, got)
+	}
+	if web3Service.chainStartDeposits[0].MerkleTreeIndex != 2 {
+		t.Error("Expected the cached chain start deposits to be left unmodified")
+	}
+}
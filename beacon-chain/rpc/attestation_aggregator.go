@@ -0,0 +1,91 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// attestationAggregator merges the attestations it receives into one aggregate per attestation
+// data. An attestation is only merged when none of its participants are already part of the
+// aggregate, as a signature cannot be counted twice. Aggregates are dropped once they are an
+// epoch older than the latest attestation seen, since they can no longer be included in a block.
+type attestationAggregator struct {
+	aggregates  map[[32]byte]*pbp2p.Attestation
+	highestSlot uint64
+}
+
+func newAttestationAggregator() *attestationAggregator {
+	return &attestationAggregator{
+		aggregates: make(map[[32]byte]*pbp2p.Attestation),
+	}
+}
+
+// add merges the attestation into the aggregate for its data and returns the resulting
+// aggregate. A nil aggregate is returned if the attestation did not add any participant.
+func (a *attestationAggregator) add(att *pbp2p.Attestation) (*pbp2p.Attestation, error) {
+	if att.Data == nil {
+		return nil, errors.New("attestation has no data")
+	}
+	root, err := hashutil.HashProto(att.Data)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	if att.Data.Slot > a.highestSlot {
+		a.highestSlot = att.Data.Slot
+		a.prune()
+	}
+	if att.Data.Slot+params.BeaconConfig().SlotsPerEpoch < a.highestSlot {
+		return nil, nil
+	}
+
+	aggregate, ok := a.aggregates[root]
+	if !ok {
+		a.aggregates[root] = proto.Clone(att).(*pbp2p.Attestation)
+		return proto.Clone(att).(*pbp2p.Attestation), nil
+	}
+	if len(aggregate.AggregationBitfield) != len(att.AggregationBitfield) ||
+		len(aggregate.CustodyBitfield) != len(att.CustodyBitfield) {
+		return nil, fmt.Errorf(
+			"attestation bitfield length %d does not match aggregate bitfield length %d",
+			len(att.AggregationBitfield),
+			len(aggregate.AggregationBitfield),
+		)
+	}
+	for i := range att.AggregationBitfield {
+		if aggregate.AggregationBitfield[i]&att.AggregationBitfield[i] != 0 {
+			return nil, nil
+		}
+	}
+
+	aggregateSig, err := bls.SignatureFromBytes(aggregate.AggregateSignature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize aggregate signature: %v", err)
+	}
+	sig, err := bls.SignatureFromBytes(att.AggregateSignature)
+	if err != nil {
+		return nil, fmt.Errorf("could not deserialize attestation signature: %v", err)
+	}
+	for i := range att.AggregationBitfield {
+		aggregate.AggregationBitfield[i] |= att.AggregationBitfield[i]
+	}
+	for i := range att.CustodyBitfield {
+		aggregate.CustodyBitfield[i] |= att.CustodyBitfield[i]
+	}
+	aggregate.AggregateSignature = bls.AggregateSignatures([]*bls.Signature{aggregateSig, sig}).Marshal()
+	return proto.Clone(aggregate).(*pbp2p.Attestation), nil
+}
+
+// prune drops the aggregates more than an epoch older than the highest slot seen.
+func (a *attestationAggregator) prune() {
+	for root, aggregate := range a.aggregates {
+		if aggregate.Data.Slot+params.BeaconConfig().SlotsPerEpoch < a.highestSlot {
+			delete(a.aggregates, root)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package rpc
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func signedAttestation(t *testing.T, data *pbp2p.AttestationData, bitfield []byte) (*pbp2p.Attestation, *bls.Signature) {
+	priv, err := bls.RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := priv.Sign([]byte("attestation"), params.BeaconConfig().DomainAttestation)
+	return &pbp2p.Attestation{
+		Data:                data,
+		AggregationBitfield: bitfield,
+		CustodyBitfield:     make([]byte, len(bitfield)),
+		AggregateSignature:  sig.Marshal(),
+	}, sig
+}
+
+func TestAttestationAggregator_MergesDisjointAttestations(t *testing.T) {
+	aggregator := newAttestationAggregator()
+	data := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1, Shard: 2}
+	att1, sig1 := signedAttestation(t, data, []byte{0x80})
+	att2, sig2 := signedAttestation(t, data, []byte{0x40})
+
+	if _, err := aggregator.add(att1); err != nil {
+		t.Fatal(err)
+	}
+	aggregate, err := aggregator.add(att2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(aggregate.AggregationBitfield, []byte{0xC0}) {
+		t.Errorf("Wanted aggregation bitfield %#x, received %#x", []byte{0xC0}, aggregate.AggregationBitfield)
+	}
+	wantSig := bls.AggregateSignatures([]*bls.Signature{sig1, sig2}).Marshal()
+	if !bytes.Equal(aggregate.AggregateSignature, wantSig) {
+		t.Error("Expected the aggregate signature to combine both attestation signatures")
+	}
+	if !bytes.Equal(att1.AggregationBitfield, []byte{0x80}) {
+		t.Error("Expected the first attestation to be left unmodified")
+	}
+}
+
+func TestAttestationAggregator_SkipsOverlappingAttestation(t *testing.T) {
+	aggregator := newAttestationAggregator()
+	data := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	att1, _ := signedAttestation(t, data, []byte{0xC0})
+	att2, _ := signedAttestation(t, data, []byte{0x40})
+
+	if _, err := aggregator.add(att1); err != nil {
+		t.Fatal(err)
+	}
+	aggregate, err := aggregator.add(att2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aggregate != nil {
+		t.Errorf("Expected no aggregate for an overlapping attestation, received %v", aggregate)
+	}
+}
+
+func TestAttestationAggregator_SeparatesAttestationData(t *testing.T) {
+	aggregator := newAttestationAggregator()
+	att1, _ := signedAttestation(t, &pbp2p.AttestationData{Shard: 1}, []byte{0x80})
+	att2, _ := signedAttestation(t, &pbp2p.AttestationData{Shard: 2}, []byte{0x40})
+
+	if _, err := aggregator.add(att1); err != nil {
+		t.Fatal(err)
+	}
+	aggregate, err := aggregator.add(att2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(aggregate.AggregationBitfield, []byte{0x40}) {
+		t.Errorf("Expected attestations with different data to be aggregated apart, received bitfield %#x", aggregate.AggregationBitfield)
+	}
+}
+
+func TestAttestationAggregator_PrunesOldAggregates(t *testing.T) {
+	aggregator := newAttestationAggregator()
+	oldData := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot}
+	att, _ := signedAttestation(t, oldData, []byte{0x80})
+	if _, err := aggregator.add(att); err != nil {
+		t.Fatal(err)
+	}
+	newSlot := params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch + 1
+	att, _ = signedAttestation(t, &pbp2p.AttestationData{Slot: newSlot}, []byte{0x80})
+	if _, err := aggregator.add(att); err != nil {
+		t.Fatal(err)
+	}
+	if len(aggregator.aggregates) != 1 {
+		t.Errorf("Wanted 1 aggregate after pruning, received %d", len(aggregator.aggregates))
+	}
+
+	att, _ = signedAttestation(t, oldData, []byte{0x40})
+	aggregate, err := aggregator.add(att)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aggregate != nil {
+		t.Errorf("Expected no aggregate for an attestation older than an epoch, received %v", aggregate)
+	}
+}
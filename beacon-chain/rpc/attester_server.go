@@ -16,6 +16,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // AttesterServer defines a server implementation of the gRPC Attester service,
@@ -170,3 +172,52 @@ func (as *AttesterServer) AttestationDataAtSlot(ctx context.Context, req *pb.Att
 	}
 	return res, nil
 }
+
+// ValidateAttestation checks the attestation against the head state using the same verification as
+// the state transition, including its committee participants and aggregate signature. Attestations
+// which would be rejected are reported as invalid along with the reason, rather than as an error.
+func (as *AttesterServer) ValidateAttestation(ctx context.Context, req *pb.ValidateAttestationRequest) (*pb.ValidateAttestationResponse, error) {
+	if req.Attestation == nil || req.Attestation.Data == nil {
+		return nil, status.Error(codes.InvalidArgument, "attestation and its data are required")
+	}
+	headState, err := as.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no beacon state available")
+	}
+	if err := blocks.VerifyAttestation(headState, req.Attestation, true /* verifySignatures */); err != nil {
+		return &pb.ValidateAttestationResponse{
+			Valid:  false,
+			Reason: err.Error(),
+		}, nil
+	}
+	return &pb.ValidateAttestationResponse{
+		Valid: true,
+	}, nil
+}
+
+// HashAttestationData returns the root the node keys the attestation data by, along with the
+// root of the data and a zero custody bit which the state transition verifies attester signatures
+// against. Both are computed with the same proto hashing the node uses internally.
+func (as *AttesterServer) HashAttestationData(ctx context.Context, data *pbp2p.AttestationData) (*pb.AttestationDataRootResponse, error) {
+	if data == nil {
+		return nil, status.Error(codes.InvalidArgument, "attestation data is required")
+	}
+	dataRoot, err := hashutil.HashProto(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data: %v", err)
+	}
+	signingRoot, err := hashutil.HashProto(&pbp2p.AttestationDataAndCustodyBit{
+		Data:       data,
+		CustodyBit: false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not hash attestation data and custody bit: %v", err)
+	}
+	return &pb.AttestationDataRootResponse{
+		DataRoot:    dataRoot[:],
+		SigningRoot: signingRoot[:],
+	}, nil
+}
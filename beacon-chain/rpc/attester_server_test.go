@@ -1,7 +1,11 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
 	"sync"
 	"testing"
 
@@ -11,8 +15,13 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockBroadcaster struct{}
@@ -284,3 +293,173 @@ func TestAttestationDataAtSlot_handlesInProgressRequest(t *testing.T) {
 
 	wg.Wait()
 }
+
+// attestationAtGenesis returns an attestation for the first committee at the genesis slot in
+// which only the first committee member participated, along with that member's index. The
+// head state slot is advanced so the attestation can be included.
+func attestationAtGenesis(t *testing.T, beaconState *pbp2p.BeaconState) (*pbp2p.Attestation, uint64) {
+	beaconState.Slot = params.BeaconConfig().GenesisSlot + params.BeaconConfig().MinAttestationInclusionDelay
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, params.BeaconConfig().GenesisSlot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0].Committee
+	aggregationBitfield, err := bitutil.SetBitfield(0, len(committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &pbp2p.Attestation{
+		Data: &pbp2p.AttestationData{
+			Slot:                     params.BeaconConfig().GenesisSlot,
+			Shard:                    committees[0].Shard,
+			JustifiedEpoch:           beaconState.JustifiedEpoch,
+			JustifiedBlockRootHash32: beaconState.JustifiedRoot,
+			CrosslinkDataRootHash32:  params.BeaconConfig().ZeroHash[:],
+			LatestCrosslink:          beaconState.LatestCrosslinks[committees[0].Shard],
+		},
+		AggregationBitfield: aggregationBitfield,
+		CustodyBitfield:     make([]byte, len(aggregationBitfield)),
+	}, committee[0]
+}
+
+func signAttestation(t *testing.T, beaconState *pbp2p.BeaconState, att *pbp2p.Attestation, privKey *bls.SecretKey) []byte {
+	messageRoot, err := hashutil.HashProto(&pbp2p.AttestationDataAndCustodyBit{
+		Data:       att.Data,
+		CustodyBit: false,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	domain := forkutil.DomainVersion(beaconState.Fork, helpers.SlotToEpoch(att.Data.Slot), params.BeaconConfig().DomainAttestation)
+	return privKey.Sign(messageRoot[:], domain).Marshal()
+}
+
+func TestValidateAttestation_ValidAndInvalidSignature(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	privKeys := make([]*bls.SecretKey, len(beaconState.ValidatorRegistry))
+	for i := range privKeys {
+		privKeys[i], err = bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		beaconState.ValidatorRegistry[i].Pubkey = privKeys[i].PublicKey().Marshal()
+	}
+	att, attester := attestationAtGenesis(t, beaconState)
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	attesterServer := &AttesterServer{
+		beaconDB: db,
+	}
+
+	att.AggregateSignature = signAttestation(t, beaconState, att, privKeys[attester])
+	res, err := attesterServer.ValidateAttestation(ctx, &pb.ValidateAttestationRequest{Attestation: att})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if !res.Valid {
+		t.Errorf("Expected attestation to be valid, received reason: %s", res.Reason)
+	}
+
+	att.AggregateSignature = signAttestation(t, beaconState, att, privKeys[(attester+1)%uint64(len(privKeys))])
+	res, err = attesterServer.ValidateAttestation(ctx, &pb.ValidateAttestationRequest{Attestation: att})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	want := "aggregate signature did not verify"
+	if res.Valid || !strings.Contains(res.Reason, want) {
+		t.Errorf("Expected invalid attestation with reason containing %q, received %v", want, res)
+	}
+}
+
+func TestValidateAttestation_ShardOutOfRange(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	att, _ := attestationAtGenesis(t, beaconState)
+	att.Data.Shard = uint64(len(beaconState.LatestCrosslinks))
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	attesterServer := &AttesterServer{
+		beaconDB: db,
+	}
+
+	res, err := attesterServer.ValidateAttestation(ctx, &pb.ValidateAttestationRequest{Attestation: att})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	want := "out of range"
+	if res.Valid || !strings.Contains(res.Reason, want) {
+		t.Errorf("Expected invalid attestation with reason containing %q, received %v", want, res)
+	}
+}
+
+func TestValidateAttestation_NoBeaconState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	attesterServer := &AttesterServer{
+		beaconDB: db,
+	}
+	req := &pb.ValidateAttestationRequest{
+		Attestation: &pbp2p.Attestation{Data: &pbp2p.AttestationData{}},
+	}
+	if _, err := attesterServer.ValidateAttestation(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition error, received %v", err)
+	}
+}
+
+func TestHashAttestationData_KnownVector(t *testing.T) {
+	attesterServer := &AttesterServer{}
+	data := &pbp2p.AttestationData{
+		Slot:                     9223372036854775809,
+		Shard:                    3,
+		BeaconBlockRootHash32:    []byte("beacon block root"),
+		EpochBoundaryRootHash32:  []byte("epoch boundary root"),
+		CrosslinkDataRootHash32:  []byte("crosslink data root"),
+		LatestCrosslink:          &pbp2p.Crosslink{Epoch: 2, CrosslinkDataRootHash32: []byte("latest crosslink")},
+		JustifiedEpoch:           144115188075855872,
+		JustifiedBlockRootHash32: []byte("justified block root"),
+	}
+	resp, err := attesterServer.HashAttestationData(context.Background(), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedDataRoot := "f0833de370456283798eb469937f3e0b7bd4731f69dab45b5acc5cc420e07bcf"
+	if root := fmt.Sprintf("%x", resp.DataRoot); root != wantedDataRoot {
+		t.Errorf("Expected data root %s, received %s", wantedDataRoot, root)
+	}
+	wantedSigningRoot := "424d4a9c7a929d6c73f5df675c268e6464912ca95534b407c919128f0df96757"
+	if root := fmt.Sprintf("%x", resp.SigningRoot); root != wantedSigningRoot {
+		t.Errorf("Expected signing root %s, received %s", wantedSigningRoot, root)
+	}
+
+	// The signing root must be the message the state transition verifies signatures against.
+	messageRoot, err := hashutil.HashProto(&pbp2p.AttestationDataAndCustodyBit{Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(resp.SigningRoot, messageRoot[:]) {
+		t.Errorf("Expected signing root %#x, received %#x", messageRoot, resp.SigningRoot)
+	}
+}
+
+func TestHashAttestationData_NilData(t *testing.T) {
+	attesterServer := &AttesterServer{}
+	if _, err := attesterServer.HashAttestationData(context.Background(), nil); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error, received %v", err)
+	}
+}
@@ -1,25 +1,55 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/forkutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// maxSkippedSlotsSpan bounds the number of slots scanned by a single SkippedSlots request.
+const maxSkippedSlotsSpan = 1024
+
+// maxJustifiedHistorySpan bounds the number of epochs read by a single JustifiedCheckpointHistory request.
+const maxJustifiedHistorySpan = 256
+
+// defaultBlockTreeBreadthWarning is the number of blocks at a single slot of the block tree above
+// which the tree is reported as unusually wide if no threshold is configured.
+const defaultBlockTreeBreadthWarning = 16
+
+var wideBlockTrees = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "rpc_block_tree_wide_total",
+	Help: "Count of BlockTree responses with more blocks at a single slot than the breadth warning threshold.",
+})
+
 // BeaconServer defines a server implementation of the gRPC Beacon service,
 // providing RPC endpoints for obtaining the canonical beacon chain head,
 // fetching latest observed attestations, and more.
@@ -33,6 +63,11 @@ type BeaconServer struct {
 	incomingAttestation chan *pbp2p.Attestation
 	canonicalStateChan  chan *pbp2p.BeaconState
 	chainStartChan      chan time.Time
+	syncService         syncService
+	eth1DataCache       eth1DataCache
+	// treeBreadthWarning is the number of blocks at a single slot of the block tree above
+	// which BlockTree warns of an unusually wide tree, defaulting to defaultBlockTreeBreadthWarning.
+	treeBreadthWarning uint64
 }
 
 // WaitForChainStart queries the logs of the Deposit Contract in order to verify the beacon chain
@@ -66,6 +101,7 @@ func (bs *BeaconServer) WaitForChainStart(req *ptypes.Empty, stream pb.BeaconSer
 		case <-sub.Err():
 			return errors.New("subscriber closed, exiting goroutine")
 		case <-bs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
 			return errors.New("rpc context closed, exiting goroutine")
 		}
 	}
@@ -81,20 +117,133 @@ func (bs *BeaconServer) CanonicalHead(ctx context.Context, req *ptypes.Empty) (*
 	return block, nil
 }
 
-// LatestAttestation streams the latest processed attestations to the rpc clients.
+// LatestAttestation streams the latest processed attestations to the rpc clients. Clients
+// which fall too far behind miss the oldest pending attestations rather than stalling the feed.
 func (bs *BeaconServer) LatestAttestation(req *ptypes.Empty, stream pb.BeaconService_LatestAttestationServer) error {
-	sub := bs.operationService.IncomingAttFeed().Subscribe(bs.incomingAttestation)
-	defer sub.Unsubscribe()
+	buffer := newStreamBuffer(
+		bs.operationService.IncomingAttFeed(),
+		bs.incomingAttestation,
+		params.BeaconConfig().DefaultBufferSize,
+		"LatestAttestation",
+	)
+	defer buffer.Unsubscribe()
 	for {
 		select {
-		case attestation := <-bs.incomingAttestation:
+		case item := <-buffer.Items():
 			log.Info("Sending attestation to RPC clients")
-			if err := stream.Send(attestation); err != nil {
+			if err := stream.Send(item.(*pbp2p.Attestation)); err != nil {
 				return err
 			}
-		case <-sub.Err():
+		case <-buffer.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return nil
+		case <-bs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
+			return nil
+		}
+	}
+}
+
+// BlockStream streams every block processed by the chain service to the rpc clients,
+// skipping blocks with a slot lower than the requested start slot. Clients which fall too far
+// behind miss the oldest pending blocks rather than stalling the chain service.
+func (bs *BeaconServer) BlockStream(req *pb.BlockStreamRequest, stream pb.BeaconService_BlockStreamServer) error {
+	buffer := newStreamBuffer(
+		bs.chainService.ProcessedBlockFeed(),
+		make(chan *pbp2p.BeaconBlock, 1),
+		params.BeaconConfig().DefaultBufferSize,
+		"BlockStream",
+	)
+	defer buffer.Unsubscribe()
+	for {
+		select {
+		case item := <-buffer.Items():
+			block := item.(*pbp2p.BeaconBlock)
+			if block.Slot < req.StartSlot {
+				continue
+			}
+			if err := stream.Send(block); err != nil {
+				return err
+			}
+		case <-buffer.Err():
+			log.Debug("Subscriber closed, exiting goroutine")
+			return nil
+		case <-stream.Context().Done():
+			log.Debug("Stream context closed, exiting goroutine")
+			return nil
+		case <-bs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
+			return nil
+		}
+	}
+}
+
+// AggregateAttestationStream sends the aggregate attestation for an attestation data every time
+// an incoming attestation is merged into it, as long as it has enough participants. Attestations
+// are aggregated per stream from a buffered subscription so a slow client never blocks
+// attestation processing.
+func (bs *BeaconServer) AggregateAttestationStream(req *pb.AggregateStreamRequest, stream pb.BeaconService_AggregateAttestationStreamServer) error {
+	buffer := newStreamBuffer(
+		bs.operationService.IncomingAttFeed(),
+		make(chan *pbp2p.Attestation, 1),
+		params.BeaconConfig().DefaultBufferSize,
+		"AggregateAttestationStream",
+	)
+	defer buffer.Unsubscribe()
+	aggregator := newAttestationAggregator()
+	for {
+		select {
+		case item := <-buffer.Items():
+			aggregate, err := aggregator.add(item.(*pbp2p.Attestation))
+			if err != nil {
+				log.WithError(err).Debug("Could not aggregate attestation")
+				continue
+			}
+			if aggregate == nil || uint64(bitutil.BitSetCount(aggregate.AggregationBitfield)) < req.MinParticipants {
+				continue
+			}
+			if err := stream.Send(aggregate); err != nil {
+				return err
+			}
+		case <-buffer.Err():
 			log.Debug("Subscriber closed, exiting goroutine")
 			return nil
+		case <-stream.Context().Done():
+			log.Debug("Stream context closed, exiting goroutine")
+			return nil
+		case <-bs.ctx.Done():
+			log.Debug("RPC context closed, exiting goroutine")
+			return nil
+		}
+	}
+}
+
+// SlotTickStream sends the slot and epoch to the rpc clients at the start of every slot. Ticks
+// are computed from the genesis time of the head state so they stay aligned to slot boundaries.
+func (bs *BeaconServer) SlotTickStream(_ *ptypes.Empty, stream pb.BeaconService_SlotTickStreamServer) error {
+	beaconState, err := bs.beaconDB.HeadState(stream.Context())
+	if err != nil {
+		return fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if beaconState == nil {
+		return status.Error(codes.FailedPrecondition, "no beacon state available")
+	}
+	genesisTime := time.Unix(int64(beaconState.GenesisTime), 0)
+	ticker := slotutil.GetSlotTicker(genesisTime, params.BeaconConfig().SecondsPerSlot)
+	defer ticker.Done()
+	for {
+		select {
+		case slot := <-ticker.C():
+			tick := &pb.SlotTick{
+				Slot:  slot,
+				Epoch: helpers.SlotToEpoch(slot),
+			}
+			if err := stream.Send(tick); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			log.Debug("Stream context closed, exiting goroutine")
+			return nil
 		case <-bs.ctx.Done():
 			log.Debug("RPC context closed, exiting goroutine")
 			return nil
@@ -102,6 +251,29 @@ func (bs *BeaconServer) LatestAttestation(req *ptypes.Empty, stream pb.BeaconSer
 	}
 }
 
+// SyncStatus reports whether the node is still syncing, the slot of its chain head and the
+// highest chain head slot known among its peers. If no peer has reported its chain head yet,
+// the node's own head slot is returned as the highest slot.
+func (bs *BeaconServer) SyncStatus(ctx context.Context, _ *ptypes.Empty) (*pb.SyncStatusResponse, error) {
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get canonical head block: %v", err)
+	}
+	if head == nil {
+		return nil, errors.New("no chain head exists in db")
+	}
+	highestSlot, known := bs.syncService.HighestObservedSlot()
+	if !known || highestSlot < head.Slot {
+		highestSlot = head.Slot
+	}
+	return &pb.SyncStatusResponse{
+		Syncing:          bs.syncService.Status() != nil,
+		HeadSlot:         head.Slot,
+		HighestSlot:      highestSlot,
+		HighestSlotKnown: known,
+	}, nil
+}
+
 // ForkData fetches the current fork information from the beacon state.
 func (bs *BeaconServer) ForkData(ctx context.Context, _ *ptypes.Empty) (*pbp2p.Fork, error) {
 	state, err := bs.beaconDB.HeadState(ctx)
@@ -123,6 +295,50 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 	}
 	// Fetch the current canonical chain height from the eth1.0 chain.
 	currentHeight := bs.powChainService.LatestBlockHeight()
+
+	// The selected eth1 data only depends on the state's eth1 data and votes, so they are hashed
+	// on their own rather than hashing the whole state.
+	votesRoot, err := hashutil.HashProto(&pbp2p.BeaconState{
+		LatestEth1Data: beaconState.LatestEth1Data,
+		Eth1DataVotes:  beaconState.Eth1DataVotes,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not hash eth1 data votes: %v", err)
+	}
+	bs.invalidateEth1DataOnReorg(ctx)
+	if res, ok := bs.eth1DataCache.get(votesRoot, currentHeight); ok {
+		return res, nil
+	}
+	res, err := bs.selectEth1Data(ctx, beaconState, currentHeight)
+	if err != nil {
+		return nil, err
+	}
+	// The result is only cached when the block hash at the latest height is known, as it is what
+	// allows detecting a later reorg of the eth1 chain.
+	if blockHash, err := bs.powChainService.BlockHashByHeight(ctx, currentHeight); err == nil {
+		bs.eth1DataCache.put(votesRoot, currentHeight, blockHash, res)
+	}
+	return res, nil
+}
+
+// invalidateEth1DataOnReorg drops the cached eth1 data if the block hash at the eth1 height it was
+// computed at has changed since, as the eth1 chain has then reorganized and the heights of the
+// blocks voted for may no longer be the same.
+func (bs *BeaconServer) invalidateEth1DataOnReorg(ctx context.Context) {
+	height, cachedHash, ok := bs.eth1DataCache.cachedHeight()
+	if !ok {
+		return
+	}
+	blockHash, err := bs.powChainService.BlockHashByHeight(ctx, height)
+	if err == nil && blockHash == cachedHash {
+		return
+	}
+	log.WithField("height", height).Debug("Eth1 block hash changed at cached height, invalidating cached eth1 data")
+	bs.eth1DataCache.invalidate()
+}
+
+// selectEth1Data selects the eth1 data to vote on from the eth1 data votes of the given state.
+func (bs *BeaconServer) selectEth1Data(ctx context.Context, beaconState *pbp2p.BeaconState, currentHeight *big.Int) (*pb.Eth1DataResponse, error) {
 	eth1FollowDistance := int64(params.BeaconConfig().Eth1FollowDistance)
 
 	stateLatestEth1Hash := bytesutil.ToBytes32(beaconState.LatestEth1Data.BlockHash32)
@@ -210,8 +426,8 @@ func (bs *BeaconServer) Eth1Data(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1
 }
 
 // PendingDeposits returns a list of pending deposits that are ready for
-// inclusion in the next beacon block.
-func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*pb.PendingDepositsResponse, error) {
+// inclusion in the next beacon block, capped by the requested max deposits.
+func (bs *BeaconServer) PendingDeposits(ctx context.Context, req *pb.PendingDepositsRequest) (*pb.PendingDepositsResponse, error) {
 	bNum := bs.powChainService.LatestBlockHeight()
 	if bNum == nil {
 		return nil, errors.New("latest PoW block number is unknown")
@@ -229,6 +445,9 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 	if err != nil {
 		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
 	}
+	if beaconState == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no beacon state available")
+	}
 	h := bytesutil.ToBytes32(beaconState.LatestEth1Data.BlockHash32)
 	_, latestEth1DataHeight, err := bs.powChainService.BlockExists(ctx, h)
 	if err != nil {
@@ -263,9 +482,14 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 		}
 	}
 
+	// The requested max deposits can only lower the number of deposits allowed in a block.
+	maxDeposits := params.BeaconConfig().MaxDeposits
+	if req.MaxDeposits > 0 && req.MaxDeposits < maxDeposits {
+		maxDeposits = req.MaxDeposits
+	}
 	for i := range pendingDeps {
 		// Don't construct merkle proof if the number of deposits is more than max allowed in block.
-		if uint64(i) == params.BeaconConfig().MaxDeposits {
+		if uint64(i) == maxDeposits {
 			break
 		}
 		pendingDeps[i], err = constructMerkleProof(depositTrie, pendingDeps[i])
@@ -275,14 +499,228 @@ func (bs *BeaconServer) PendingDeposits(ctx context.Context, _ *ptypes.Empty) (*
 	}
 	// Limit the return of pending deposits to not be more than max deposits allowed in block.
 	var pendingDeposits []*pbp2p.Deposit
-	for i := 0; i < len(pendingDeps) && i < int(params.BeaconConfig().MaxDeposits); i++ {
+	for i := 0; i < len(pendingDeps) && i < int(maxDeposits); i++ {
 		pendingDeposits = append(pendingDeposits, pendingDeps[i])
 	}
-	return &pb.PendingDepositsResponse{PendingDeposits: pendingDeposits}, nil
+	readiness, err := bs.depositsReadiness(ctx, pendingDeposits)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PendingDepositsResponse{PendingDeposits: pendingDeposits, Readiness: readiness}, nil
+}
+
+// depositsReadiness computes, for each deposit, the eth1 block at which it passed the
+// eth1 follow distance window along with that block's timestamp. This mirrors the
+// readiness check in PendingDeposits, where a deposit included in block N is only
+// returned once the latest eth1 block is at least N + ETH1_FOLLOW_DISTANCE.
+func (bs *BeaconServer) depositsReadiness(ctx context.Context, deposits []*pbp2p.Deposit) ([]*pb.DepositReadiness, error) {
+	followDistance := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
+	readiness := make([]*pb.DepositReadiness, 0, len(deposits))
+	for _, dep := range deposits {
+		blockNum := bs.beaconDB.PendingDepositBlockNumber(ctx, dep.MerkleTreeIndex)
+		if blockNum == nil {
+			return nil, fmt.Errorf("could not find eth1 block of pending deposit with merkle index %d", dep.MerkleTreeIndex)
+		}
+		readyBlock := new(big.Int).Add(blockNum, followDistance)
+		readyTime, err := bs.powChainService.BlockTimeByHeight(ctx, readyBlock)
+		if err != nil {
+			return nil, fmt.Errorf("could not fetch eth1 block time at height %d: %v", readyBlock, err)
+		}
+		readiness = append(readiness, &pb.DepositReadiness{
+			MerkleTreeIndex: dep.MerkleTreeIndex,
+			Eth1BlockNumber: readyBlock.Uint64(),
+			Eth1BlockTime:   readyTime,
+		})
+	}
+	return readiness, nil
+}
+
+// GenesisDepositRoot returns the deposit root of the eth1 data in the genesis state, which light
+// clients can use as an anchor to verify the deposit contract at genesis.
+func (bs *BeaconServer) GenesisDepositRoot(ctx context.Context, _ *ptypes.Empty) (*pb.GenesisDepositRootResponse, error) {
+	genesisState, err := bs.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve genesis state: %v", err)
+	}
+	if genesisState == nil || genesisState.LatestEth1Data == nil {
+		return nil, status.Error(codes.FailedPrecondition, "genesis state not yet available")
+	}
+	return &pb.GenesisDepositRootResponse{
+		DepositRoot: genesisState.LatestEth1Data.DepositRootHash32,
+	}, nil
+}
+
+// GenesisValidators returns a page of the validator registry of the genesis state the node was
+// initialized with, so clients can verify the initial validator set.
+func (bs *BeaconServer) GenesisValidators(ctx context.Context, req *pb.GenesisValidatorsRequest) (*pb.GenesisValidatorsResponse, error) {
+	genesisState, err := bs.beaconDB.GenesisState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve genesis state: %v", err)
+	}
+	if genesisState == nil {
+		return nil, status.Error(codes.FailedPrecondition, "genesis state not yet available")
+	}
+	validators := genesisState.ValidatorRegistry
+	start, end, nextPageToken, err := paginate(req.PageSize, req.PageToken, len(validators))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GenesisValidatorsResponse{
+		Validators:    validators[start:end],
+		NextPageToken: nextPageToken,
+		TotalSize:     uint64(len(validators)),
+	}, nil
+}
+
+// PendingDepositCount returns the number of deposits in the pending deposits of the node with a
+// Merkle tree index at or above the deposit index of the head state, which are the deposits that
+// have not yet been processed.
+func (bs *BeaconServer) PendingDepositCount(ctx context.Context, _ *ptypes.Empty) (*pb.PendingDepositCountResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	var count uint64
+	for _, dep := range bs.beaconDB.PendingDeposits(ctx, nil /* beforeBlk */) {
+		if dep.MerkleTreeIndex >= beaconState.DepositIndex {
+			count++
+		}
+	}
+	return &pb.PendingDepositCountResponse{Count: count}, nil
+}
+
+// UpcomingActivations returns the validators which are activated when the validator registry is
+// updated at the end of the current epoch of the head state, applying the balance churn limit to
+// the validators waiting for activation in the order of their index. No validators are returned
+// if the head state is not eligible for a registry update.
+func (bs *BeaconServer) UpcomingActivations(ctx context.Context, _ *ptypes.Empty) (*pb.UpcomingActivationsResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	activationEpoch := helpers.EntryExitEffectEpoch(helpers.CurrentEpoch(beaconState))
+	if !epoch.CanProcessValidatorRegistry(beaconState) {
+		return &pb.UpcomingActivationsResponse{
+			ValidatorIndices: []uint64{},
+			ActivationEpoch:  activationEpoch,
+		}, nil
+	}
+	return &pb.UpcomingActivationsResponse{
+		ValidatorIndices: validators.ActivationQueue(beaconState),
+		ActivationEpoch:  activationEpoch,
+	}, nil
+}
+
+// LastFinalizedSlot returns the slot of the block at the last finalized checkpoint. Unlike fetching
+// the finalized block itself, only its slot is returned which keeps finality lag monitoring cheap.
+func (bs *BeaconServer) LastFinalizedSlot(ctx context.Context, _ *ptypes.Empty) (*pb.LastFinalizedSlotResponse, error) {
+	finalizedBlock, err := bs.beaconDB.FinalizedBlock()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "no block has been finalized yet: %v", err)
+	}
+	return &pb.LastFinalizedSlotResponse{Slot: finalizedBlock.Slot}, nil
+}
+
+// FinalityDistance returns the number of slots and epochs elapsed between the finalized epoch of the
+// head state and its slot. The distance only grows past a couple of epochs when finality stalls, so it
+// is flagged separately when no epoch has been finalized since genesis.
+func (bs *BeaconServer) FinalityDistance(ctx context.Context, _ *ptypes.Empty) (*pb.FinalityDistanceResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	finalizedEpoch := beaconState.FinalizedEpoch
+	return &pb.FinalityDistanceResponse{
+		Slots:          beaconState.Slot - helpers.StartSlot(finalizedEpoch),
+		Epochs:         helpers.CurrentEpoch(beaconState) - finalizedEpoch,
+		FinalizedEpoch: finalizedEpoch,
+		NeverFinalized: finalizedEpoch <= params.BeaconConfig().GenesisEpoch,
+	}, nil
+}
+
+// StateSchemaInfo returns a compact structural summary of the head state, made of its fork version
+// and slot along with the length of each of its lists, without transferring the state itself.
+func (bs *BeaconServer) StateSchemaInfo(ctx context.Context, _ *ptypes.Empty) (*pb.StateSchemaInfoResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	var forkVersion uint64
+	if beaconState.Fork != nil {
+		forkVersion = forkutil.ForkVersion(beaconState.Fork, helpers.CurrentEpoch(beaconState))
+	}
+	return &pb.StateSchemaInfoResponse{
+		ForkVersion:                forkVersion,
+		Slot:                       beaconState.Slot,
+		ValidatorRegistryCount:     uint64(len(beaconState.ValidatorRegistry)),
+		ValidatorBalancesCount:     uint64(len(beaconState.ValidatorBalances)),
+		LatestRandaoMixesCount:     uint64(len(beaconState.LatestRandaoMixes)),
+		LatestCrosslinksCount:      uint64(len(beaconState.LatestCrosslinks)),
+		LatestBlockRootsCount:      uint64(len(beaconState.LatestBlockRootHash32S)),
+		HistoricalRootsCount:       uint64(len(beaconState.BatchedBlockRootHash32S)),
+		LatestSlashedBalancesCount: uint64(len(beaconState.LatestSlashedBalances)),
+		LatestAttestationsCount:    uint64(len(beaconState.LatestAttestations)),
+		LatestIndexRootsCount:      uint64(len(beaconState.LatestIndexRootHash32S)),
+		Eth1DataVotesCount:         uint64(len(beaconState.Eth1DataVotes)),
+	}, nil
+}
+
+// DepositStatus reports whether the deposit with the requested Merkle tree index has been processed
+// into the head state, which is the case for every index below the state's deposit index, or is
+// still waiting in the pending deposits of the node.
+func (bs *BeaconServer) DepositStatus(ctx context.Context, req *pb.DepositStatusRequest) (*pb.DepositStatusResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	if req.MerkleTreeIndex < beaconState.DepositIndex {
+		return &pb.DepositStatusResponse{Status: pb.DepositStatusResponse_PROCESSED}, nil
+	}
+	if bs.beaconDB.PendingDepositBlockNumber(ctx, req.MerkleTreeIndex) != nil {
+		return &pb.DepositStatusResponse{Status: pb.DepositStatusResponse_PENDING}, nil
+	}
+	return &pb.DepositStatusResponse{Status: pb.DepositStatusResponse_UNKNOWN}, nil
+}
+
+// Eth1FollowStatus returns the latest eth1 block number known to the node along with the eth1 follow
+// distance, and the eth1 block number up to which deposits are considered safe for inclusion.
+func (bs *BeaconServer) Eth1FollowStatus(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1FollowStatusResponse, error) {
+	latest := bs.powChainService.LatestBlockHeight()
+	if latest == nil {
+		return nil, status.Error(codes.FailedPrecondition, "latest PoW block number is unknown")
+	}
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	safe := new(big.Int).Sub(latest, new(big.Int).SetUint64(followDistance))
+	// No deposit is safe until the eth1 chain has advanced past the follow distance.
+	if safe.Sign() < 0 {
+		safe.SetUint64(0)
+	}
+	return &pb.Eth1FollowStatusResponse{
+		LatestBlockNumber: latest.Uint64(),
+		FollowDistance:    followDistance,
+		SafeBlockNumber:   safe.Uint64(),
+	}, nil
 }
 
 // BlockTree returns the current tree of saved blocks and their votes starting from the justified state.
-func (bs *BeaconServer) BlockTree(ctx context.Context, _ *ptypes.Empty) (*pb.BlockTreeResponse, error) {
+// If requested, every node is also tagged with whether it is finalized, justified or neither according
+// to the finalized and justified roots of the head state and their ancestors. A validator range can be
+// given to only attribute the votes of a subset of the validators to the nodes. A tree with more blocks
+// at a single slot than the breadth warning threshold is still returned, but logs a warning as it may
+// be caused by block spam or a fork choice bug.
+func (bs *BeaconServer) BlockTree(ctx context.Context, req *pb.BlockTreeRequest) (*pb.BlockTreeResponse, error) {
+	if r := req.ValidatorRange; r != nil && r.StartIndex >= r.EndIndex {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"validator range end index %d must be greater than its start index %d",
+			r.EndIndex,
+			r.StartIndex,
+		)
+	}
+	// The targets fetcher may not be set until the chain service is ready.
+	if bs.targetsFetcher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "attestation targets not yet available")
+	}
 	justifiedState, err := bs.beaconDB.JustifiedState()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve justified state: %v", err)
@@ -291,12 +729,37 @@ func (bs *BeaconServer) BlockTree(ctx context.Context, _ *ptypes.Empty) (*pb.Blo
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve attestation target: %v", err)
 	}
+	if r := req.ValidatorRange; r != nil {
+		rangeTargets := make(map[uint64]*pbp2p.AttestationTarget)
+		for validatorIndex, target := range attestationTargets {
+			if validatorIndex >= r.StartIndex && validatorIndex < r.EndIndex {
+				rangeTargets[validatorIndex] = target
+			}
+		}
+		attestationTargets = rangeTargets
+	}
 	justifiedBlock, err := bs.beaconDB.JustifiedBlock()
 	if err != nil {
 		return nil, err
 	}
+	var finalizedRoots, justifiedRoots map[[32]byte]bool
+	if req.AnnotateFinalization {
+		headState, err := bs.beaconDB.HeadState(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve head state: %v", err)
+		}
+		finalizedRoots, err = bs.ancestorRoots(bytesutil.ToBytes32(headState.FinalizedRoot), justifiedBlock.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve finalized ancestors: %v", err)
+		}
+		justifiedRoots, err = bs.ancestorRoots(bytesutil.ToBytes32(headState.JustifiedRoot), justifiedBlock.Slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve justified ancestors: %v", err)
+		}
+	}
 	highestSlot := bs.beaconDB.HighestBlockSlot()
 	fullBlockTree := []*pbp2p.BeaconBlock{}
+	var breadth, widestSlot uint64
 	for i := justifiedBlock.Slot + 1; i < highestSlot; i++ {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
@@ -305,8 +768,24 @@ func (bs *BeaconServer) BlockTree(ctx context.Context, _ *ptypes.Empty) (*pb.Blo
 		if err != nil {
 			return nil, err
 		}
+		if uint64(len(nextLayer)) > breadth {
+			breadth = uint64(len(nextLayer))
+			widestSlot = i
+		}
 		fullBlockTree = append(fullBlockTree, nextLayer...)
 	}
+	breadthWarning := bs.treeBreadthWarning
+	if breadthWarning == 0 {
+		breadthWarning = defaultBlockTreeBreadthWarning
+	}
+	if breadth > breadthWarning {
+		wideBlockTrees.Inc()
+		log.WithFields(logrus.Fields{
+			"breadth":   breadth,
+			"slot":      widestSlot - params.BeaconConfig().GenesisSlot,
+			"threshold": breadthWarning,
+		}).Warn("Block tree is unusually wide, possible block spam or fork choice bug")
+	}
 	tree := []*pb.BlockTreeResponse_TreeNode{}
 	for _, kid := range fullBlockTree {
 		if ctx.Err() != nil {
@@ -326,20 +805,52 @@ func (bs *BeaconServer) BlockTree(ctx context.Context, _ *ptypes.Empty) (*pb.Blo
 		}
 		activeValidatorIndices := helpers.ActiveValidatorIndices(hState.ValidatorRegistry, helpers.CurrentEpoch(hState))
 		totalVotes := epoch.TotalBalance(hState, activeValidatorIndices)
-		tree = append(tree, &pb.BlockTreeResponse_TreeNode{
+		node := &pb.BlockTreeResponse_TreeNode{
 			BlockRoot:         blockRoot[:],
 			Block:             kid,
 			ParticipatedVotes: uint64(participatedVotes),
 			TotalVotes:        uint64(totalVotes),
-		})
+		}
+		if req.AnnotateFinalization {
+			switch {
+			case finalizedRoots[blockRoot]:
+				node.FinalizationStatus = pb.BlockTreeResponse_FINALIZED
+			case justifiedRoots[blockRoot]:
+				node.FinalizationStatus = pb.BlockTreeResponse_JUSTIFIED
+			default:
+				node.FinalizationStatus = pb.BlockTreeResponse_NOT_JUSTIFIED
+			}
+		}
+		tree = append(tree, node)
 	}
 	return &pb.BlockTreeResponse{
 		Tree: tree,
 	}, nil
 }
 
+// ancestorRoots returns the given block root along with the roots of its ancestors, stopping
+// at the first block with a slot lower than or equal to the lowest slot.
+func (bs *BeaconServer) ancestorRoots(root [32]byte, lowestSlot uint64) (map[[32]byte]bool, error) {
+	roots := make(map[[32]byte]bool)
+	for {
+		block, err := bs.beaconDB.Block(root)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve block %#x: %v", root, err)
+		}
+		if block == nil || block.Slot <= lowestSlot {
+			return roots, nil
+		}
+		roots[root] = true
+		root = bytesutil.ToBytes32(block.ParentRootHash32)
+	}
+}
+
 // BlockTreeBySlots returns the current tree of saved blocks and their votes starting from the justified state.
 func (bs *BeaconServer) BlockTreeBySlots(ctx context.Context, req *pb.TreeBlockSlotRequest) (*pb.BlockTreeResponse, error) {
+	// The targets fetcher may not be set until the chain service is ready.
+	if bs.targetsFetcher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "attestation targets not yet available")
+	}
 	justifiedState, err := bs.beaconDB.JustifiedState()
 	if err != nil {
 		return nil, fmt.Errorf("could not retrieve justified state: %v", err)
@@ -410,6 +921,1252 @@ func (bs *BeaconServer) BlockTreeBySlots(ctx context.Context, req *pb.TreeBlockS
 	}, nil
 }
 
+// ForkChoiceStore returns the node's current fork choice store: the justified and finalized
+// checkpoints saved by fork choice, and the justified block along with every saved block after
+// it weighted by the latest attestation targets of the validators in the justified state.
+func (bs *BeaconServer) ForkChoiceStore(ctx context.Context, _ *ptypes.Empty) (*pb.ForkChoiceStoreResponse, error) {
+	// The targets fetcher may not be set until the chain service is ready.
+	if bs.targetsFetcher == nil {
+		return nil, status.Error(codes.FailedPrecondition, "attestation targets not yet available")
+	}
+	justifiedState, err := bs.beaconDB.JustifiedState()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "fork choice store not yet initialized: %v", err)
+	}
+	justifiedBlock, err := bs.beaconDB.JustifiedBlock()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "fork choice store not yet initialized: %v", err)
+	}
+	finalizedBlock, err := bs.beaconDB.FinalizedBlock()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "fork choice store not yet initialized: %v", err)
+	}
+	attestationTargets, err := bs.targetsFetcher.AttestationTargets(justifiedState)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attestation targets: %v", err)
+	}
+	justifiedCheckpoint, err := forkChoiceCheckpoint(justifiedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute justified checkpoint: %v", err)
+	}
+	finalizedCheckpoint, err := forkChoiceCheckpoint(finalizedBlock)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute finalized checkpoint: %v", err)
+	}
+
+	trackedBlocks := []*pbp2p.BeaconBlock{justifiedBlock}
+	highestSlot := bs.beaconDB.HighestBlockSlot()
+	for i := justifiedBlock.Slot + 1; i <= highestSlot; i++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		layer, err := bs.beaconDB.BlocksBySlot(ctx, i)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve blocks at slot %d: %v", i-params.BeaconConfig().GenesisSlot, err)
+		}
+		trackedBlocks = append(trackedBlocks, layer...)
+	}
+	tracked := make([]*pb.ForkChoiceStoreResponse_TrackedBlock, 0, len(trackedBlocks))
+	for _, blk := range trackedBlocks {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		weight, err := blockchain.VoteCount(blk, justifiedState, attestationTargets, bs.beaconDB)
+		if err != nil {
+			return nil, fmt.Errorf("could not count votes: %v", err)
+		}
+		blockRoot, err := hashutil.HashBeaconBlock(blk)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash block: %v", err)
+		}
+		tracked = append(tracked, &pb.ForkChoiceStoreResponse_TrackedBlock{
+			BlockRoot:  blockRoot[:],
+			ParentRoot: blk.ParentRootHash32,
+			Slot:       blk.Slot,
+			Weight:     uint64(weight),
+		})
+	}
+	return &pb.ForkChoiceStoreResponse{
+		JustifiedCheckpoint: justifiedCheckpoint,
+		FinalizedCheckpoint: finalizedCheckpoint,
+		Blocks:              tracked,
+	}, nil
+}
+
+// AttestationTargetCache returns the latest attestation target of every validator which is active in
+// the justified state, the same targets BlockTree and fork choice weigh blocks with. An empty set of
+// targets is returned while the targets fetcher or the justified state are not yet available.
+func (bs *BeaconServer) AttestationTargetCache(ctx context.Context, _ *ptypes.Empty) (*pb.AttestationTargetCacheResponse, error) {
+	empty := &pb.AttestationTargetCacheResponse{
+		Targets: make(map[uint64]*pbp2p.AttestationTarget),
+	}
+	if bs.targetsFetcher == nil {
+		return empty, nil
+	}
+	// The justified state is only missing before the chain has started.
+	justifiedState, err := bs.beaconDB.JustifiedState()
+	if err != nil {
+		return empty, nil
+	}
+	attestationTargets, err := bs.targetsFetcher.AttestationTargets(justifiedState)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve attestation targets: %v", err)
+	}
+	return &pb.AttestationTargetCacheResponse{
+		Targets: attestationTargets,
+	}, nil
+}
+
+// forkChoiceCheckpoint describes a justified or finalized block saved by fork choice as a
+// checkpoint. The block may precede the start slot of the checkpoint epoch if that slot was
+// skipped, so the epoch is derived from the block slot.
+func forkChoiceCheckpoint(block *pbp2p.BeaconBlock) (*pb.ForkChoiceStoreResponse_Checkpoint, error) {
+	root, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ForkChoiceStoreResponse_Checkpoint{
+		Epoch:     helpers.SlotToEpoch(block.Slot),
+		Slot:      block.Slot,
+		BlockRoot: root[:],
+	}, nil
+}
+
+// DetectedSlashings returns the slashable offenses observed by the node which have not yet
+// been included on chain. Double proposals are detected from conflicting blocks saved for the
+// slots the head state can compute proposers for, while double and surround votes are detected
+// from conflicting attestations in the node's attestation pool.
+func (bs *BeaconServer) DetectedSlashings(ctx context.Context, _ *ptypes.Empty) (*pb.DetectedSlashingsResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	proposerSlashings, err := bs.detectDoubleProposals(ctx, beaconState)
+	if err != nil {
+		return nil, fmt.Errorf("could not detect double proposals: %v", err)
+	}
+	attestations, err := bs.beaconDB.Attestations()
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve pending attestations: %v", err)
+	}
+	attesterSlashings := detectConflictingAttestations(beaconState, attestations)
+
+	slashings := make([]*pb.DetectedSlashingsResponse_DetectedSlashing, 0, len(proposerSlashings)+len(attesterSlashings))
+	slashings = append(slashings, proposerSlashings...)
+	slashings = append(slashings, attesterSlashings...)
+	return &pb.DetectedSlashingsResponse{Slashings: slashings}, nil
+}
+
+// detectDoubleProposals looks for slots with more than one saved block from the start of the
+// previous epoch up to the end of the next epoch, as those are the only slots the proposer
+// can be determined for from the given state.
+func (bs *BeaconServer) detectDoubleProposals(
+	ctx context.Context,
+	beaconState *pbp2p.BeaconState,
+) ([]*pb.DetectedSlashingsResponse_DetectedSlashing, error) {
+	var slashings []*pb.DetectedSlashingsResponse_DetectedSlashing
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	endSlot := helpers.StartSlot(helpers.NextEpoch(beaconState)+1) - 1
+	if highestSlot := bs.beaconDB.HighestBlockSlot(); highestSlot < endSlot {
+		endSlot = highestSlot
+	}
+	for slot := helpers.StartSlot(helpers.PrevEpoch(beaconState)); slot <= endSlot; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blks, err := bs.beaconDB.BlocksBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve blocks at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if len(blks) < 2 {
+			continue
+		}
+		proposerIdx, err := helpers.BeaconProposerIndex(beaconState, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get proposer index at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if beaconState.ValidatorRegistry[proposerIdx].SlashedEpoch <= currentEpoch {
+			continue
+		}
+		slashings = append(slashings, &pb.DetectedSlashingsResponse_DetectedSlashing{
+			ValidatorIndex: proposerIdx,
+			Offense:        pb.SlashingOffense_DOUBLE_PROPOSAL,
+			Slot:           slot,
+		})
+	}
+	return slashings, nil
+}
+
+// detectConflictingAttestations compares every pair of attestations and reports the validators
+// which participated in both of two attestations forming a double vote or a surround vote. An
+// attestation whose committee cannot be computed from the given state is skipped.
+func detectConflictingAttestations(
+	beaconState *pbp2p.BeaconState,
+	attestations []*pbp2p.Attestation,
+) []*pb.DetectedSlashingsResponse_DetectedSlashing {
+	var atts []*pbp2p.Attestation
+	var participants [][]uint64
+	for _, att := range attestations {
+		indices, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+		if err != nil {
+			log.Debugf("Skipping attestation in slashing detection: %v", err)
+			continue
+		}
+		atts = append(atts, att)
+		participants = append(participants, indices)
+	}
+
+	type offender struct {
+		validatorIndex uint64
+		offense        pb.SlashingOffense
+	}
+	reported := make(map[offender]bool)
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	var slashings []*pb.DetectedSlashingsResponse_DetectedSlashing
+	for i := 0; i < len(atts); i++ {
+		for j := i + 1; j < len(atts); j++ {
+			data1 := atts[i].Data
+			data2 := atts[j].Data
+			if proto.Equal(data1, data2) {
+				continue
+			}
+			var offense pb.SlashingOffense
+			switch {
+			case helpers.SlotToEpoch(data1.Slot) == helpers.SlotToEpoch(data2.Slot):
+				offense = pb.SlashingOffense_DOUBLE_VOTE
+			case blocks.IsSurroundVote(data1, data2) || blocks.IsSurroundVote(data2, data1):
+				offense = pb.SlashingOffense_SURROUND_VOTE
+			default:
+				continue
+			}
+			for _, idx := range sliceutil.IntersectionUint64(participants[i], participants[j]) {
+				o := offender{validatorIndex: idx, offense: offense}
+				if reported[o] || beaconState.ValidatorRegistry[idx].SlashedEpoch <= currentEpoch {
+					continue
+				}
+				reported[o] = true
+				slashings = append(slashings, &pb.DetectedSlashingsResponse_DetectedSlashing{
+					ValidatorIndex: idx,
+					Offense:        offense,
+					Slot:           data2.Slot,
+				})
+			}
+		}
+	}
+	return slashings
+}
+
+// EpochAttestationStats computes aggregation statistics of the attestations included in
+// every block saved for the slots of the requested epoch. An error is returned if no
+// blocks have been saved for the epoch yet.
+func (bs *BeaconServer) EpochAttestationStats(
+	ctx context.Context,
+	req *pb.EpochAttestationStatsRequest,
+) (*pb.EpochAttestationStatsResponse, error) {
+	startSlot := helpers.StartSlot(req.Epoch)
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch
+	if startSlot > bs.beaconDB.HighestBlockSlot() {
+		return nil, fmt.Errorf(
+			"blocks for epoch %d are not available",
+			req.Epoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+
+	var blockCount, attestationCount, aggregationBits uint64
+	dataRoots := make(map[[32]byte]bool)
+	for slot := startSlot; slot < endSlot; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blks, err := bs.beaconDB.BlocksBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve blocks at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		for _, blk := range blks {
+			blockCount++
+			for _, att := range blk.Body.Attestations {
+				attestationCount++
+				aggregationBits += uint64(bitutil.BitSetCount(att.AggregationBitfield))
+				root, err := hashutil.HashProto(att.Data)
+				if err != nil {
+					return nil, fmt.Errorf("could not hash attestation data: %v", err)
+				}
+				dataRoots[root] = true
+			}
+		}
+	}
+	if blockCount == 0 {
+		return nil, fmt.Errorf(
+			"blocks for epoch %d are not available",
+			req.Epoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+
+	var averageBits float32
+	if attestationCount > 0 {
+		averageBits = float32(aggregationBits) / float32(attestationCount)
+	}
+	return &pb.EpochAttestationStatsResponse{
+		BlockCount:             blockCount,
+		AttestationCount:       attestationCount,
+		AverageAggregationBits: averageBits,
+		DistinctDataRoots:      uint64(len(dataRoots)),
+	}, nil
+}
+
+// Eth1DataVotes returns the eth1 data votes tallied in the head state, ordered by
+// descending vote count.
+func (bs *BeaconServer) Eth1DataVotes(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1DataVotesResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	votes := make([]*pbp2p.Eth1DataVote, len(beaconState.Eth1DataVotes))
+	copy(votes, beaconState.Eth1DataVotes)
+	sort.SliceStable(votes, func(i, j int) bool {
+		return votes[i].VoteCount > votes[j].VoteCount
+	})
+	return &pb.Eth1DataVotesResponse{
+		Eth1DataVotes: votes,
+	}, nil
+}
+
+// NextEth1VotingPeriod returns the slot and estimated time at which the eth1 voting period of the
+// head state ends. Votes are tallied and reset by the epoch processing of the last slot of the
+// period, so a head state which is exactly at that slot has already started the next period.
+func (bs *BeaconServer) NextEth1VotingPeriod(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1VotingPeriodResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	period, endSlot := eth1VotingPeriod(beaconState)
+	endTime := beaconState.GenesisTime + (endSlot-params.BeaconConfig().GenesisSlot)*params.BeaconConfig().SecondsPerSlot
+	return &pb.Eth1VotingPeriodResponse{
+		CurrentPeriod: period,
+		PeriodEndSlot: endSlot,
+		PeriodEndTime: endTime,
+	}, nil
+}
+
+// eth1VotingPeriod returns the eth1 voting period the votes of the state are counted in, and the
+// slot whose epoch processing ends it.
+func eth1VotingPeriod(beaconState *pbp2p.BeaconState) (uint64, uint64) {
+	epochsPerPeriod := params.BeaconConfig().EpochsPerEth1VotingPeriod
+	period := (helpers.CurrentEpoch(beaconState) - params.BeaconConfig().GenesisEpoch) / epochsPerPeriod
+	periodEndSlot := func(period uint64) uint64 {
+		return helpers.StartSlot(params.BeaconConfig().GenesisEpoch+(period+1)*epochsPerPeriod) - 1
+	}
+	if beaconState.Slot >= periodEndSlot(period) {
+		period++
+	}
+	return period, periodEndSlot(period)
+}
+
+// Eth1VoteCandidates returns the eth1 blocks which are valid to vote for in the voting period of the
+// head state, following the same rules as the eth1 data selected for block proposals. These are the
+// blocks already voted for in the period which are in the canonical eth1 chain, newer than the latest
+// eth1 data of the state and at least the follow distance behind the eth1 head, along with the
+// follow distance ancestor of the head which is voted for when none of the votes are valid.
+func (bs *BeaconServer) Eth1VoteCandidates(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1VoteCandidatesResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	currentHeight := bs.powChainService.LatestBlockHeight()
+	if currentHeight == nil {
+		return nil, status.Error(codes.FailedPrecondition, "latest PoW block number is unknown")
+	}
+	period, _ := eth1VotingPeriod(beaconState)
+	res := &pb.Eth1VoteCandidatesResponse{
+		Candidates:   make([]*pb.Eth1VoteCandidatesResponse_Candidate, 0),
+		VotingPeriod: period,
+	}
+	maxHeight := new(big.Int).Sub(currentHeight, new(big.Int).SetUint64(params.BeaconConfig().Eth1FollowDistance))
+	// No block can be voted for until the eth1 chain has advanced past the follow distance.
+	if maxHeight.Sign() < 0 {
+		return res, nil
+	}
+	res.MaxHeight = maxHeight.Uint64()
+	ancestorHash, err := bs.powChainService.BlockHashByHeight(ctx, maxHeight)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch ETH1_FOLLOW_DISTANCE ancestor: %v", err)
+	}
+	ancestor := &pb.Eth1VoteCandidatesResponse_Candidate{
+		BlockHash:              ancestorHash[:],
+		Height:                 res.MaxHeight,
+		FollowDistanceAncestor: true,
+	}
+
+	// Votes are only considered once the state has eth1 data to build upon.
+	stateLatestEth1Hash := bytesutil.ToBytes32(beaconState.LatestEth1Data.BlockHash32)
+	if stateLatestEth1Hash == [32]byte{} {
+		res.Candidates = append(res.Candidates, ancestor)
+		return res, nil
+	}
+	_, stateLatestEth1Height, err := bs.powChainService.BlockExists(ctx, stateLatestEth1Hash)
+	if err != nil {
+		return nil, fmt.Errorf("could not verify block with hash exists in Eth1 chain: %#x: %v", stateLatestEth1Hash, err)
+	}
+	res.MinHeight = stateLatestEth1Height.Uint64()
+	for _, vote := range beaconState.Eth1DataVotes {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		eth1Hash := bytesutil.ToBytes32(vote.Eth1Data.BlockHash32)
+		if eth1Hash == ancestorHash {
+			ancestor.VoteCount = vote.VoteCount
+			continue
+		}
+		blockExists, blockHeight, err := bs.powChainService.BlockExists(ctx, eth1Hash)
+		if err != nil || !blockExists {
+			continue
+		}
+		if blockHeight.Cmp(maxHeight) > 0 || blockHeight.Cmp(stateLatestEth1Height) <= 0 {
+			continue
+		}
+		res.Candidates = append(res.Candidates, &pb.Eth1VoteCandidatesResponse_Candidate{
+			BlockHash: eth1Hash[:],
+			Height:    blockHeight.Uint64(),
+			VoteCount: vote.VoteCount,
+		})
+	}
+	res.Candidates = append(res.Candidates, ancestor)
+	sort.SliceStable(res.Candidates, func(i, j int) bool {
+		return res.Candidates[i].Height < res.Candidates[j].Height
+	})
+	return res, nil
+}
+
+// BlocksBySlot returns every block saved at the requested slot, including blocks from
+// competing forks, and marks the block on the canonical chain if there is one.
+func (bs *BeaconServer) BlocksBySlot(ctx context.Context, req *pb.BlocksBySlotRequest) (*pb.BlocksBySlotResponse, error) {
+	blks, err := bs.beaconDB.BlocksBySlot(ctx, req.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve blocks at slot %d: %v", req.Slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	canonicalBlock, err := bs.beaconDB.CanonicalBlockBySlot(ctx, req.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", req.Slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	var canonicalRoot [32]byte
+	if canonicalBlock != nil {
+		canonicalRoot, err = hashutil.HashBeaconBlock(canonicalBlock)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash canonical block: %v", err)
+		}
+	}
+
+	slotBlocks := make([]*pb.BlocksBySlotResponse_SlotBlock, 0, len(blks))
+	for _, blk := range blks {
+		root, err := hashutil.HashBeaconBlock(blk)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash block: %v", err)
+		}
+		slotBlocks = append(slotBlocks, &pb.BlocksBySlotResponse_SlotBlock{
+			Block:     blk,
+			BlockRoot: root[:],
+			Canonical: canonicalBlock != nil && root == canonicalRoot,
+		})
+	}
+	return &pb.BlocksBySlotResponse{
+		Blocks: slotBlocks,
+	}, nil
+}
+
+// BlockOperationCounts returns the number of deposits, attestations, slashings and
+// voluntary exits included in the body of the block with the requested root.
+func (bs *BeaconServer) BlockOperationCounts(ctx context.Context, req *pb.BlockByRootRequest) (*pb.BlockOperationCountsResponse, error) {
+	blk, err := bs.beaconDB.Block(bytesutil.ToBytes32(req.BlockRoot))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve block: %v", err)
+	}
+	if blk == nil {
+		return nil, status.Errorf(codes.NotFound, "no block found with root %#x", req.BlockRoot)
+	}
+	body := blk.Body
+	if body == nil {
+		return &pb.BlockOperationCountsResponse{}, nil
+	}
+	return &pb.BlockOperationCountsResponse{
+		Deposits:          uint64(len(body.Deposits)),
+		Attestations:      uint64(len(body.Attestations)),
+		ProposerSlashings: uint64(len(body.ProposerSlashings)),
+		AttesterSlashings: uint64(len(body.AttesterSlashings)),
+		VoluntaryExits:    uint64(len(body.VoluntaryExits)),
+	}, nil
+}
+
+// BlockAttestations returns the attestations included in the body of the block with the requested
+// root, along with the number of validators which participated in each of them.
+func (bs *BeaconServer) BlockAttestations(ctx context.Context, req *pb.BlockByRootRequest) (*pb.BlockAttestationsResponse, error) {
+	blk, err := bs.beaconDB.Block(bytesutil.ToBytes32(req.BlockRoot))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve block: %v", err)
+	}
+	if blk == nil {
+		return nil, status.Errorf(codes.NotFound, "no block found with root %#x", req.BlockRoot)
+	}
+	attestations := make([]*pb.BlockAttestationsResponse_IncludedAttestation, 0)
+	if blk.Body != nil {
+		for _, att := range blk.Body.Attestations {
+			attestations = append(attestations, &pb.BlockAttestationsResponse_IncludedAttestation{
+				Attestation:         att,
+				AggregationBitCount: uint64(bitutil.BitSetCount(att.AggregationBitfield)),
+			})
+		}
+	}
+	return &pb.BlockAttestationsResponse{
+		Attestations: attestations,
+	}, nil
+}
+
+// ProposerReward computes the rewards earned by the proposer of the block with the requested root
+// from the state before the block. Every validator participating in an included attestation earns
+// the proposer an attestation inclusion reward, and every validator slashed by an included slashing
+// earns the proposer the whistleblower reward.
+func (bs *BeaconServer) ProposerReward(ctx context.Context, req *pb.BlockByRootRequest) (*pb.ProposerRewardResponse, error) {
+	blk, err := bs.beaconDB.Block(bytesutil.ToBytes32(req.BlockRoot))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve block: %v", err)
+	}
+	if blk == nil {
+		return nil, status.Errorf(codes.NotFound, "no block found with root %#x", req.BlockRoot)
+	}
+	preState, err := bs.blockPreState(ctx, blk)
+	if err != nil {
+		return nil, err
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(preState, blk.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposer index: %v", err)
+	}
+	if blk.Body == nil {
+		return &pb.ProposerRewardResponse{ProposerIndex: proposerIdx}, nil
+	}
+
+	activeIndices := helpers.ActiveValidatorIndices(preState.ValidatorRegistry, helpers.CurrentEpoch(preState))
+	baseRewardQuotient := helpers.BaseRewardQuotient(epoch.TotalBalance(preState, activeIndices))
+	inclusionReward := helpers.BaseReward(preState, proposerIdx, baseRewardQuotient) /
+		params.BeaconConfig().AttestationInclusionRewardQuotient
+	included := make(map[uint64]bool)
+	for _, att := range blk.Body.Attestations {
+		participants, err := helpers.AttestationParticipants(preState, att.Data, att.AggregationBitfield)
+		if err != nil {
+			return nil, fmt.Errorf("could not get attestation participants: %v", err)
+		}
+		for _, idx := range participants {
+			included[idx] = true
+		}
+	}
+	attestationReward := uint64(len(included)) * inclusionReward
+
+	// The whistleblower rewards are taken from the proposer balance change when processing the
+	// slashings of the block, so they follow the exact rules of the state transition.
+	slashedState := proto.Clone(preState).(*pbp2p.BeaconState)
+	slashedState, err = blocks.ProcessProposerSlashings(slashedState, blk, false /* verifySignatures */)
+	if err != nil {
+		return nil, fmt.Errorf("could not process proposer slashings: %v", err)
+	}
+	slashedState, err = blocks.ProcessAttesterSlashings(slashedState, blk, false /* verifySignatures */)
+	if err != nil {
+		return nil, fmt.Errorf("could not process attester slashings: %v", err)
+	}
+	var slashingReward uint64
+	if slashedState.ValidatorBalances[proposerIdx] > preState.ValidatorBalances[proposerIdx] {
+		slashingReward = slashedState.ValidatorBalances[proposerIdx] - preState.ValidatorBalances[proposerIdx]
+	}
+
+	return &pb.ProposerRewardResponse{
+		ProposerIndex:              proposerIdx,
+		AttestationInclusionReward: attestationReward,
+		SlashingReward:             slashingReward,
+		TotalReward:                attestationReward + slashingReward,
+	}, nil
+}
+
+// ProposedBlock returns the canonical block at the requested slot along with its root if it was
+// proposed by the requested validator, letting a validator confirm its proposal made it on chain.
+// The proposer of the block is determined from the state the block was applied to.
+func (bs *BeaconServer) ProposedBlock(ctx context.Context, req *pb.ProposedBlockRequest) (*pb.ProposedBlockResponse, error) {
+	blk, err := bs.beaconDB.CanonicalBlockBySlot(ctx, req.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", req.Slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	if blk == nil {
+		return &pb.ProposedBlockResponse{Found: false}, nil
+	}
+	preState, err := bs.blockPreState(ctx, blk)
+	if err != nil {
+		return nil, err
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(preState, blk.Slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get proposer index: %v", err)
+	}
+	if proposerIdx != req.ValidatorIndex {
+		return &pb.ProposedBlockResponse{Found: false}, nil
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(blk)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block: %v", err)
+	}
+	return &pb.ProposedBlockResponse{
+		Block:     blk,
+		BlockRoot: blockRoot[:],
+		Found:     true,
+	}, nil
+}
+
+// Crosslinks returns the latest crosslink of every shard recorded in the head state, ordered by
+// shard. Shards without a crosslink in the head state are returned with an empty crosslink.
+func (bs *BeaconServer) Crosslinks(ctx context.Context, _ *ptypes.Empty) (*pb.CrosslinksResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	shardCount := params.BeaconConfig().ShardCount
+	crosslinks := make([]*pb.CrosslinksResponse_ShardCrosslink, shardCount)
+	for shard := uint64(0); shard < shardCount; shard++ {
+		crosslink := &pbp2p.Crosslink{}
+		if shard < uint64(len(beaconState.LatestCrosslinks)) && beaconState.LatestCrosslinks[shard] != nil {
+			crosslink = beaconState.LatestCrosslinks[shard]
+		}
+		crosslinks[shard] = &pb.CrosslinksResponse_ShardCrosslink{
+			Shard:           shard,
+			LatestCrosslink: crosslink,
+		}
+	}
+	return &pb.CrosslinksResponse{Crosslinks: crosslinks}, nil
+}
+
+// ChurnLimit returns the maximum balance which can be activated, and separately exited, when the
+// validator registry of the head state is updated. It is computed from the total effective balance
+// of the active validators, the same way the registry update bounds its churn.
+func (bs *BeaconServer) ChurnLimit(ctx context.Context, _ *ptypes.Empty) (*pb.ChurnLimitResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	activeValidatorIndices := helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, helpers.CurrentEpoch(beaconState))
+	totalBalance := helpers.TotalBalance(beaconState, activeValidatorIndices)
+	return &pb.ChurnLimitResponse{
+		BalanceChurnLimit:    validators.MaxBalanceChurn(totalBalance),
+		ActiveValidatorCount: uint64(len(activeValidatorIndices)),
+		TotalActiveBalance:   totalBalance,
+	}, nil
+}
+
+// AttestingBalances returns the attesting balances of the current and previous epochs of the
+// head state, along with the total active balance of each epoch, as they are computed when the
+// epoch is processed to decide justification. The current epoch only counts the attesters of its
+// epoch boundary block, while the previous epoch counts every attester. Both attesting balances
+// are zero until attestations are included, and the genesis epoch has no previous epoch.
+func (bs *BeaconServer) AttestingBalances(ctx context.Context, _ *ptypes.Empty) (*pb.AttestingBalancesResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(beaconState)
+	res := &pb.AttestingBalancesResponse{
+		CurrentTotalBalance: epoch.TotalBalance(
+			beaconState,
+			helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, currentEpoch),
+		),
+	}
+	hasPrevEpoch := currentEpoch > params.BeaconConfig().GenesisEpoch
+	if hasPrevEpoch {
+		res.PreviousTotalBalance = epoch.TotalBalance(
+			beaconState,
+			helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, currentEpoch-1),
+		)
+	}
+	if len(beaconState.LatestAttestations) == 0 {
+		return res, nil
+	}
+
+	var currentAttesters, prevAttesters []uint64
+	var boundaryRoot []byte
+	for _, att := range beaconState.LatestAttestations {
+		attEpoch := helpers.SlotToEpoch(att.Data.Slot)
+		if attEpoch != currentEpoch && (!hasPrevEpoch || attEpoch != currentEpoch-1) {
+			continue
+		}
+		participants, err := helpers.AttestationParticipants(beaconState, att.Data, att.AggregationBitfield)
+		if err != nil {
+			return nil, fmt.Errorf("could not get attestation participants: %v", err)
+		}
+		if attEpoch != currentEpoch {
+			prevAttesters = sliceutil.UnionUint64(prevAttesters, participants)
+			continue
+		}
+		if boundaryRoot == nil {
+			boundaryRoot, err = blocks.BlockRoot(beaconState, helpers.StartSlot(currentEpoch))
+			if err != nil {
+				return nil, fmt.Errorf("could not get epoch boundary block root: %v", err)
+			}
+		}
+		if bytes.Equal(att.Data.EpochBoundaryRootHash32, boundaryRoot) {
+			currentAttesters = sliceutil.UnionUint64(currentAttesters, participants)
+		}
+	}
+	res.CurrentAttestingBalance = epoch.TotalBalance(beaconState, currentAttesters)
+	res.PreviousAttestingBalance = epoch.TotalBalance(beaconState, prevAttesters)
+	return res, nil
+}
+
+// TotalDeposited returns the sum of the amounts of every deposit the node observed in the deposit
+// contract, whether or not it was included in a beacon block yet, along with the sum of the amounts
+// of the deposits still pending inclusion.
+func (bs *BeaconServer) TotalDeposited(ctx context.Context, _ *ptypes.Empty) (*pb.TotalDepositedResponse, error) {
+	deposits := bs.beaconDB.AllDeposits(ctx, nil /* beforeBlk */)
+	totalAmount, err := sumDepositAmounts(deposits)
+	if err != nil {
+		return nil, err
+	}
+	pendingAmount, err := sumDepositAmounts(bs.beaconDB.PendingDeposits(ctx, nil /* beforeBlk */))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TotalDepositedResponse{
+		TotalAmount:   totalAmount,
+		PendingAmount: pendingAmount,
+		DepositCount:  uint64(len(deposits)),
+	}, nil
+}
+
+// sumDepositAmounts returns the sum of the amounts encoded in the deposit data of the deposits.
+func sumDepositAmounts(deposits []*pbp2p.Deposit) (uint64, error) {
+	var total uint64
+	for _, dep := range deposits {
+		amount, _, err := helpers.DecodeDepositAmountAndTimeStamp(dep.DepositData)
+		if err != nil {
+			return 0, fmt.Errorf("could not decode amount of deposit %d: %v", dep.MerkleTreeIndex, err)
+		}
+		total += amount
+	}
+	return total, nil
+}
+
+// blockPreState returns the state a block was applied to, by advancing the historical state saved
+// for its parent block through the skipped slots up to the slot of the block.
+func (bs *BeaconServer) blockPreState(ctx context.Context, blk *pbp2p.BeaconBlock) (*pbp2p.BeaconState, error) {
+	parentRoot := bytesutil.ToBytes32(blk.ParentRootHash32)
+	parent, err := bs.beaconDB.Block(parentRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve parent block: %v", err)
+	}
+	if parent == nil {
+		return nil, status.Errorf(codes.NotFound, "no parent block found with root %#x", parentRoot)
+	}
+	beaconState, err := bs.beaconDB.HistoricalStateFromSlot(ctx, parent.Slot, parentRoot)
+	if err != nil || beaconState.Slot != parent.Slot {
+		return nil, status.Errorf(
+			codes.NotFound,
+			"no state saved for parent block at slot %d",
+			parent.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	for beaconState.Slot < blk.Slot-1 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		beaconState, err = state.ExecuteStateTransition(
+			ctx, beaconState, nil /* block */, parentRoot, state.DefaultConfig(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not execute state transition: %v", err)
+		}
+	}
+	beaconState.Slot = blk.Slot
+	return beaconState, nil
+}
+
+// ActiveBalance returns the total effective balance of the validators active in the requested
+// epoch. The head state is used for the current epoch, while past epochs are computed from the
+// historical state saved at the start slot of the epoch.
+func (bs *BeaconServer) ActiveBalance(ctx context.Context, req *pb.ActiveBalanceRequest) (*pb.ActiveBalanceResponse, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch %d is after the current epoch %d",
+			req.Epoch-params.BeaconConfig().GenesisEpoch,
+			currentEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	beaconState := headState
+	if req.Epoch < currentEpoch {
+		beaconState, err = canonicalHistoricalState(ctx, bs.beaconDB, helpers.StartSlot(req.Epoch))
+		if err != nil {
+			return nil, status.Errorf(
+				codes.NotFound,
+				"no state available for epoch %d: %v",
+				req.Epoch-params.BeaconConfig().GenesisEpoch,
+				err,
+			)
+		}
+	}
+	activeIndices := helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, req.Epoch)
+	return &pb.ActiveBalanceResponse{
+		TotalActiveBalance:   helpers.TotalBalance(beaconState, activeIndices),
+		ActiveValidatorCount: uint64(len(activeIndices)),
+	}, nil
+}
+
+// ActiveValidators returns a page of the indices of the validators active in the requested epoch.
+// The current epoch is served from the head state and earlier epochs from the historical state
+// saved at the start of the epoch, so only epochs which still have a saved state can be requested.
+func (bs *BeaconServer) ActiveValidators(ctx context.Context, req *pb.ActiveValidatorsRequest) (*pb.ActiveValidatorsResponse, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	if req.Epoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch %d is after the current epoch %d",
+			req.Epoch-params.BeaconConfig().GenesisEpoch,
+			currentEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	beaconState := headState
+	if req.Epoch < currentEpoch {
+		beaconState, err = canonicalHistoricalState(ctx, bs.beaconDB, helpers.StartSlot(req.Epoch))
+		if err != nil {
+			return nil, status.Errorf(
+				codes.NotFound,
+				"no state available for epoch %d: %v",
+				req.Epoch-params.BeaconConfig().GenesisEpoch,
+				err,
+			)
+		}
+	}
+	activeIndices := helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, req.Epoch)
+	start, end, nextPageToken, err := paginate(req.PageSize, req.PageToken, len(activeIndices))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ActiveValidatorsResponse{
+		ValidatorIndices: activeIndices[start:end],
+		NextPageToken:    nextPageToken,
+		TotalSize:        uint64(len(activeIndices)),
+	}, nil
+}
+
+// JustifiedCheckpointHistory returns the justified checkpoint recorded in the historical state saved
+// for the canonical block at the start slot of every epoch in the requested range, inclusive on both
+// ends. Epochs without a canonical block or a saved state at their start slot are skipped.
+func (bs *BeaconServer) JustifiedCheckpointHistory(ctx context.Context, req *pb.JustifiedHistoryRequest) (*pb.JustifiedHistoryResponse, error) {
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"end epoch %d cannot be lower than the start epoch %d",
+			req.EndEpoch-params.BeaconConfig().GenesisEpoch,
+			req.StartEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	if req.EndEpoch-req.StartEpoch >= maxJustifiedHistorySpan {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch range of %d epochs exceeds the maximum of %d",
+			req.EndEpoch-req.StartEpoch+1,
+			maxJustifiedHistorySpan,
+		)
+	}
+	checkpoints := make([]*pb.JustifiedHistoryResponse_EpochCheckpoint, 0)
+	for epoch := req.StartEpoch; epoch <= req.EndEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		slot := helpers.StartSlot(epoch)
+		block, err := bs.beaconDB.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if block == nil {
+			continue
+		}
+		blockRoot, err := hashutil.HashBeaconBlock(block)
+		if err != nil {
+			return nil, fmt.Errorf("could not hash canonical block: %v", err)
+		}
+		// The lookup errors when no historical state is saved at all, which is skipped
+		// in the same way as a slot whose state was not saved or has been pruned.
+		hState, err := bs.beaconDB.HistoricalStateFromSlot(ctx, slot, blockRoot)
+		if err != nil || hState.Slot != slot {
+			continue
+		}
+		checkpoints = append(checkpoints, &pb.JustifiedHistoryResponse_EpochCheckpoint{
+			Epoch:          epoch,
+			JustifiedEpoch: hState.JustifiedEpoch,
+			JustifiedRoot:  hState.JustifiedRoot,
+		})
+	}
+	return &pb.JustifiedHistoryResponse{
+		Checkpoints: checkpoints,
+	}, nil
+}
+
+// SkippedSlots returns the slots in the requested range, inclusive on both ends, which have no
+// block on the canonical chain. Slots after the current chain head are not reported as skipped.
+func (bs *BeaconServer) SkippedSlots(ctx context.Context, req *pb.SkippedSlotsRequest) (*pb.SkippedSlotsResponse, error) {
+	if req.SlotFrom > req.SlotTo {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"upper limit (%d) of slot range cannot be lower than the lower limit (%d)",
+			req.SlotTo-params.BeaconConfig().GenesisSlot,
+			req.SlotFrom-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	if req.SlotTo-req.SlotFrom >= maxSkippedSlotsSpan {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"slot range of %d slots exceeds the maximum of %d",
+			req.SlotTo-req.SlotFrom+1,
+			maxSkippedSlotsSpan,
+		)
+	}
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, fmt.Errorf("could not get canonical head block: %v", err)
+	}
+	if head == nil {
+		return nil, errors.New("no chain head exists in db")
+	}
+	slotTo := req.SlotTo
+	if slotTo > head.Slot {
+		slotTo = head.Slot
+	}
+	skipped := make([]uint64, 0)
+	for slot := req.SlotFrom; slot <= slotTo; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blk, err := bs.beaconDB.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if blk == nil {
+			skipped = append(skipped, slot)
+		}
+	}
+	return &pb.SkippedSlotsResponse{
+		Slots: skipped,
+	}, nil
+}
+
+// HistoricalBlockRoots returns the block roots the head state recorded for the slots of the
+// requested range, in slot order, so the ancestry of the head can be verified without fetching
+// blocks. The head state only retains the roots of the LatestBlockRootsLength slots preceding its
+// slot, and a range reaching outside of them is rejected.
+func (bs *BeaconServer) HistoricalBlockRoots(
+	ctx context.Context,
+	req *pb.HistoricalBlockRootsRequest,
+) (*pb.HistoricalBlockRootsResponse, error) {
+	if req.SlotFrom > req.SlotTo {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"upper limit (%d) of slot range cannot be lower than the lower limit (%d)",
+			req.SlotTo-params.BeaconConfig().GenesisSlot,
+			req.SlotFrom-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	if beaconState == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no beacon state available")
+	}
+	earliestSlot := params.BeaconConfig().GenesisSlot
+	if beaconState.Slot > earliestSlot+params.BeaconConfig().LatestBlockRootsLength {
+		earliestSlot = beaconState.Slot - params.BeaconConfig().LatestBlockRootsLength
+	}
+	if req.SlotFrom < earliestSlot || req.SlotTo >= beaconState.Slot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"slot range %d to %d is not within the retained block roots of slots %d to %d",
+			req.SlotFrom-params.BeaconConfig().GenesisSlot,
+			req.SlotTo-params.BeaconConfig().GenesisSlot,
+			earliestSlot-params.BeaconConfig().GenesisSlot,
+			beaconState.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	roots := make([]*pb.HistoricalBlockRootsResponse_SlotBlockRoot, 0, req.SlotTo-req.SlotFrom+1)
+	for slot := req.SlotFrom; slot <= req.SlotTo; slot++ {
+		root, err := blocks.BlockRoot(beaconState, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get block root at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		roots = append(roots, &pb.HistoricalBlockRootsResponse_SlotBlockRoot{
+			Slot:      slot,
+			BlockRoot: root,
+		})
+	}
+	return &pb.HistoricalBlockRootsResponse{BlockRoots: roots}, nil
+}
+
+// SlotAttestationCoverage returns, for every committee at the requested slot, the fraction of its
+// members with an attestation for the slot included in a canonical block. Committees are computed
+// from the shuffling of the head state and attestations are collected from the canonical blocks
+// within the inclusion window of the slot.
+func (bs *BeaconServer) SlotAttestationCoverage(ctx context.Context, req *pb.SlotCoverageRequest) (*pb.SlotCoverageResponse, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	if req.Slot > headState.Slot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"slot %d is beyond the head slot %d",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			headState.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	committees, err := helpers.CrosslinkCommitteesAtSlot(headState, req.Slot, false /* registryChange */)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"could not get crosslink committees at slot %d: %v",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			err,
+		)
+	}
+	included := make(map[uint64][]bool, len(committees))
+	for _, committee := range committees {
+		included[committee.Shard] = make([]bool, len(committee.Committee))
+	}
+
+	endSlot := req.Slot + params.BeaconConfig().SlotsPerEpoch
+	if endSlot > headState.Slot {
+		endSlot = headState.Slot
+	}
+	for slot := req.Slot + 1; slot <= endSlot; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blk, err := bs.beaconDB.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if blk == nil || blk.Body == nil {
+			continue
+		}
+		for _, att := range blk.Body.Attestations {
+			if att.Data.Slot != req.Slot {
+				continue
+			}
+			members, ok := included[att.Data.Shard]
+			if !ok {
+				continue
+			}
+			for i := range members {
+				bitSet, err := bitutil.CheckBit(att.AggregationBitfield, i)
+				if err != nil {
+					return nil, fmt.Errorf("could not check aggregation bitfield: %v", err)
+				}
+				members[i] = members[i] || bitSet
+			}
+		}
+	}
+
+	coverage := make([]*pb.SlotCoverageResponse_CommitteeCoverage, 0, len(committees))
+	for _, committee := range committees {
+		var includedMembers uint64
+		for _, ok := range included[committee.Shard] {
+			if ok {
+				includedMembers++
+			}
+		}
+		var fraction float32
+		if len(committee.Committee) > 0 {
+			fraction = float32(includedMembers) / float32(len(committee.Committee))
+		}
+		coverage = append(coverage, &pb.SlotCoverageResponse_CommitteeCoverage{
+			Shard:           committee.Shard,
+			CommitteeSize:   uint64(len(committee.Committee)),
+			IncludedMembers: includedMembers,
+			Coverage:        fraction,
+		})
+	}
+	return &pb.SlotCoverageResponse{
+		Committees: coverage,
+	}, nil
+}
+
+// BeaconCommittee computes the committee at the requested slot and committee index from the
+// shuffling of the head state. Only slots from the previous epoch up to the next epoch can be
+// computed, and the committee index must be within the committee count at that slot.
+func (bs *BeaconServer) BeaconCommittee(ctx context.Context, req *pb.BeaconCommitteeRequest) (*pb.BeaconCommitteeResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	wantedEpoch := helpers.SlotToEpoch(req.Slot)
+	prevEpoch := helpers.PrevEpoch(beaconState)
+	nextEpoch := helpers.NextEpoch(beaconState)
+	if wantedEpoch < prevEpoch || wantedEpoch > nextEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"slot %d is outside of the committee lookahead: %d <= slot < %d",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			helpers.StartSlot(prevEpoch)-params.BeaconConfig().GenesisSlot,
+			helpers.StartSlot(nextEpoch+1)-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, req.Slot, false /* registryChange */)
+	if err != nil {
+		return nil, fmt.Errorf("could not get crosslink committees at slot %d: %v", req.Slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	if req.CommitteeIndex >= uint64(len(committees)) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"committee index %d out of range, slot %d has %d committees",
+			req.CommitteeIndex,
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			len(committees),
+		)
+	}
+	committee := committees[req.CommitteeIndex]
+	return &pb.BeaconCommitteeResponse{
+		Committee: committee.Committee,
+		Shard:     committee.Shard,
+	}, nil
+}
+
+// EpochShuffling returns a page of the shuffled validator indices of an epoch, computed from the
+// shuffling of the head state. The indices are ordered as they are assigned to the crosslink
+// committees of each slot of the epoch. Only epochs from the previous epoch up to the seed
+// lookahead of the current epoch can be computed.
+func (bs *BeaconServer) EpochShuffling(ctx context.Context, req *pb.EpochShufflingRequest) (*pb.EpochShufflingResponse, error) {
+	beaconState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	prevEpoch := helpers.PrevEpoch(beaconState)
+	lookaheadEpoch := helpers.CurrentEpoch(beaconState) + params.BeaconConfig().MinSeedLookahead
+	if req.Epoch < prevEpoch || req.Epoch > lookaheadEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch %d is outside of the seed lookahead: %d <= epoch <= %d",
+			req.Epoch-params.BeaconConfig().GenesisEpoch,
+			prevEpoch-params.BeaconConfig().GenesisEpoch,
+			lookaheadEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	var shuffledIndices []uint64
+	startSlot := helpers.StartSlot(req.Epoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+		if err != nil {
+			return nil, fmt.Errorf("could not get crosslink committees at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		for _, committee := range committees {
+			shuffledIndices = append(shuffledIndices, committee.Committee...)
+		}
+	}
+	start, end, nextPageToken, err := paginate(req.PageSize, req.PageToken, len(shuffledIndices))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EpochShufflingResponse{
+		ValidatorIndices: shuffledIndices[start:end],
+		NextPageToken:    nextPageToken,
+		TotalSize:        uint64(len(shuffledIndices)),
+	}, nil
+}
+
+// MissedAttesters returns a page of the validators assigned to a crosslink committee in the previous
+// epoch of the head state whose bit is not set in any attestation for their committee included in a
+// canonical block so far. As attestations can be included up to an epoch after their slot, the list
+// may still shrink until the end of the current epoch.
+func (bs *BeaconServer) MissedAttesters(ctx context.Context, req *pb.MissedAttestersRequest) (*pb.MissedAttestersResponse, error) {
+	headState, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	if helpers.CurrentEpoch(headState) == params.BeaconConfig().GenesisEpoch {
+		return nil, status.Error(codes.FailedPrecondition, "no epoch has ended yet")
+	}
+	prevEpoch := helpers.PrevEpoch(headState)
+	startSlot := helpers.StartSlot(prevEpoch)
+	endSlot := startSlot + params.BeaconConfig().SlotsPerEpoch
+
+	type slotShard struct {
+		slot  uint64
+		shard uint64
+	}
+	committees := make(map[slotShard][]uint64)
+	attested := make(map[slotShard][]bool)
+	for slot := startSlot; slot < endSlot; slot++ {
+		slotCommittees, err := helpers.CrosslinkCommitteesAtSlot(headState, slot, false /* registryChange */)
+		if err != nil {
+			return nil, fmt.Errorf("could not get crosslink committees at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		for _, committee := range slotCommittees {
+			key := slotShard{slot: slot, shard: committee.Shard}
+			committees[key] = committee.Committee
+			attested[key] = make([]bool, len(committee.Committee))
+		}
+	}
+
+	for slot := startSlot + 1; slot <= headState.Slot; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blk, err := bs.beaconDB.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if blk == nil || blk.Body == nil {
+			continue
+		}
+		for _, att := range blk.Body.Attestations {
+			members, ok := attested[slotShard{slot: att.Data.Slot, shard: att.Data.Shard}]
+			if !ok {
+				continue
+			}
+			for i := range members {
+				bitSet, err := bitutil.CheckBit(att.AggregationBitfield, i)
+				if err != nil {
+					return nil, fmt.Errorf("could not check aggregation bitfield: %v", err)
+				}
+				members[i] = members[i] || bitSet
+			}
+		}
+	}
+
+	missed := make([]uint64, 0)
+	for key, committee := range committees {
+		for i, idx := range committee {
+			if !attested[key][i] {
+				missed = append(missed, idx)
+			}
+		}
+	}
+	sort.Slice(missed, func(i, j int) bool {
+		return missed[i] < missed[j]
+	})
+	start, end, nextPageToken, err := paginate(req.PageSize, req.PageToken, len(missed))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MissedAttestersResponse{
+		ValidatorIndices: missed[start:end],
+		NextPageToken:    nextPageToken,
+		TotalSize:        uint64(len(missed)),
+		Epoch:            prevEpoch,
+	}, nil
+}
+
 func (bs *BeaconServer) defaultDataResponse(ctx context.Context, currentHeight *big.Int, eth1FollowDistance int64) (*pb.Eth1DataResponse, error) {
 	ancestorHeight := big.NewInt(0).Sub(currentHeight, big.NewInt(eth1FollowDistance))
 	blockHash, err := bs.powChainService.BlockHashByHeight(ctx, ancestorHeight)
@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -15,9 +17,13 @@ import (
 	"github.com/gogo/protobuf/proto"
 	ptypes "github.com/gogo/protobuf/types"
 	"github.com/golang/mock/gomock"
+	"github.com/prysmaticlabs/prysm/beacon-chain/chaintest/backend"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -25,6 +31,8 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var closedContext = "context closed"
@@ -77,6 +85,9 @@ type mockPOWChainService struct {
 	latestBlockNumber *big.Int
 	hashesByHeight    map[int][]byte
 	blockTimeByHeight map[int]uint64
+	// strictBlockTimes makes BlockTimeByHeight fail for heights missing from blockTimeByHeight
+	// instead of returning a zero time, to catch tests which did not set up every block time.
+	strictBlockTimes bool
 }
 
 func (m *mockPOWChainService) HasChainStartLogOccurred() (bool, uint64, error) {
@@ -118,7 +129,11 @@ func (m *mockPOWChainService) BlockHashByHeight(_ context.Context, height *big.I
 
 func (m *mockPOWChainService) BlockTimeByHeight(_ context.Context, height *big.Int) (uint64, error) {
 	h := int(height.Int64())
-	return m.blockTimeByHeight[h], nil
+	blockTime, ok := m.blockTimeByHeight[h]
+	if !ok && m.strictBlockTimes {
+		return 0, fmt.Errorf("could not fetch block time for height: %v", height)
+	}
+	return blockTime, nil
 }
 
 func (m *mockPOWChainService) DepositRoot() [32]byte {
@@ -206,6 +221,67 @@ func TestWaitForChainStart_NotStartedThenLogFired(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Sending ChainStart log and genesis time to connected validator clients")
 }
 
+func TestWaitForChainStart_NotStartedThenStateInitialized(t *testing.T) {
+	hook := logTest.NewGlobal()
+	chainService := newMockChainService()
+	beaconServer := &BeaconServer{
+		ctx:            context.Background(),
+		chainStartChan: make(chan time.Time, 1),
+		powChainService: &faultyPOWChainService{
+			chainStartFeed: new(event.Feed),
+		},
+		chainService: chainService,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockBeaconService_WaitForChainStartServer(ctrl)
+	mockStream.EXPECT().Send(
+		&pb.ChainStartResponse{
+			Started:     true,
+			GenesisTime: uint64(time.Unix(0, 0).Unix()),
+		},
+	).Return(nil)
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		if err := beaconServer.WaitForChainStart(&ptypes.Empty{}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+	// Keep sending until the server has subscribed to the feed and received the event.
+	for chainService.StateInitializedFeed().Send(time.Unix(0, 0)) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	<-exitRoutine
+	testutil.AssertLogsContain(t, hook, "Sending ChainStart log and genesis time to connected validator clients")
+}
+
+func TestWaitForChainStart_ContextClosedWhileSubscribed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx, cancel := context.WithCancel(context.Background())
+	beaconServer := &BeaconServer{
+		ctx:            ctx,
+		chainStartChan: make(chan time.Time, 1),
+		powChainService: &faultyPOWChainService{
+			chainStartFeed: new(event.Feed),
+		},
+		chainService: newMockChainService(),
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockStream := internal.NewMockBeaconService_WaitForChainStartServer(ctrl)
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		if err := beaconServer.WaitForChainStart(&ptypes.Empty{}, mockStream); err == nil || !strings.Contains(err.Error(), closedContext) {
+			tt.Errorf("Expected closed context error, received %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+	cancel()
+	<-exitRoutine
+	testutil.AssertLogsContain(t, hook, "RPC context closed, exiting goroutine")
+}
+
 func TestLatestAttestation_ContextClosed(t *testing.T) {
 	hook := logTest.NewGlobal()
 	mockOperationService := &mockOperationService{}
@@ -291,18 +367,349 @@ func TestLatestAttestation_SendsCorrectly(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Sending attestation to RPC clients")
 }
 
+func TestBlockStream_ContextClosed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx, cancel := context.WithCancel(context.Background())
+	beaconServer := &BeaconServer{
+		ctx:          context.Background(),
+		chainService: newMockChainService(),
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exitRoutine := make(chan bool)
+	mockStream := internal.NewMockBeaconService_BlockStreamServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	go func(tt *testing.T) {
+		if err := beaconServer.BlockStream(&pb.BlockStreamRequest{}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		<-exitRoutine
+	}(t)
+	cancel()
+	exitRoutine <- true
+	testutil.AssertLogsContain(t, hook, "Stream context closed, exiting goroutine")
+}
+
+func TestBlockStream_SendsBlocksFromStartSlot(t *testing.T) {
+	chainService := newMockChainService()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	beaconServer := &BeaconServer{
+		ctx:          ctx,
+		chainService: chainService,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	startSlot := params.BeaconConfig().GenesisSlot + 5
+	skippedBlock := &pbp2p.BeaconBlock{Slot: startSlot - 1}
+	streamedBlock := &pbp2p.BeaconBlock{Slot: startSlot}
+	sent := make(chan bool)
+	mockStream := internal.NewMockBeaconService_BlockStreamServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Send(streamedBlock).Do(func(arg0 interface{}) {
+		sent <- true
+	}).Return(nil)
+
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		if err := beaconServer.BlockStream(&pb.BlockStreamRequest{StartSlot: startSlot}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+
+	// Wait for the stream to subscribe to the processed block feed.
+	for chainService.ProcessedBlockFeed().Send(skippedBlock) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	chainService.ProcessedBlockFeed().Send(streamedBlock)
+	<-sent
+	cancel()
+	<-exitRoutine
+}
+
+func TestAggregateAttestationStream_ContextClosed(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx, cancel := context.WithCancel(context.Background())
+	beaconServer := &BeaconServer{
+		ctx:              context.Background(),
+		operationService: &mockOperationService{},
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exitRoutine := make(chan bool)
+	mockStream := internal.NewMockBeaconService_AggregateAttestationStreamServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	go func(tt *testing.T) {
+		if err := beaconServer.AggregateAttestationStream(&pb.AggregateStreamRequest{}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		<-exitRoutine
+	}(t)
+	cancel()
+	exitRoutine <- true
+	testutil.AssertLogsContain(t, hook, "Stream context closed, exiting goroutine")
+}
+
+func TestAggregateAttestationStream_SendsMergedAggregates(t *testing.T) {
+	operationService := &mockOperationService{incomingAttFeed: new(event.Feed)}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	beaconServer := &BeaconServer{
+		ctx:              ctx,
+		operationService: operationService,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	data := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1}
+	att1, _ := signedAttestation(t, data, []byte{0x80})
+	att2, _ := signedAttestation(t, data, []byte{0x40})
+	sent := make(chan *pbp2p.Attestation)
+	mockStream := internal.NewMockBeaconService_AggregateAttestationStreamServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Send(gomock.Any()).Do(func(arg0 interface{}) {
+		sent <- arg0.(*pbp2p.Attestation)
+	}).Return(nil)
+
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		req := &pb.AggregateStreamRequest{MinParticipants: 2}
+		if err := beaconServer.AggregateAttestationStream(req, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+
+	// Wait for the stream to subscribe to the incoming attestation feed.
+	for operationService.IncomingAttFeed().Send(att1) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	operationService.IncomingAttFeed().Send(att2)
+	aggregate := <-sent
+	if !bytes.Equal(aggregate.AggregationBitfield, []byte{0xC0}) {
+		t.Errorf("Wanted aggregation bitfield %#x, received %#x", []byte{0xC0}, aggregate.AggregationBitfield)
+	}
+	cancel()
+	<-exitRoutine
+}
+
+func TestSlotTickStream_SendsSlotAtNextSlotStart(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := params.BeaconConfig()
+	secondsPerSlot := cfg.SecondsPerSlot
+	cfg.SecondsPerSlot = 1
+	params.OverrideBeaconConfig(cfg)
+	defer func() {
+		cfg.SecondsPerSlot = secondsPerSlot
+		params.OverrideBeaconConfig(cfg)
+	}()
+
+	genesisTime := time.Now()
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{GenesisTime: uint64(genesisTime.Unix())}); err != nil {
+		t.Fatal(err)
+	}
+	beaconServer := &BeaconServer{
+		ctx:      ctx,
+		beaconDB: db,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	wantSlot := params.BeaconConfig().GenesisSlot + 1
+	want := &pb.SlotTick{
+		Slot:  wantSlot,
+		Epoch: helpers.SlotToEpoch(wantSlot),
+	}
+	sent := make(chan time.Time)
+	mockStream := internal.NewMockBeaconService_SlotTickStreamServer(ctrl)
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Send(want).Do(func(arg0 interface{}) {
+		sent <- time.Now()
+	}).Return(nil)
+
+	exitRoutine := make(chan bool)
+	go func(tt *testing.T) {
+		if err := beaconServer.SlotTickStream(&ptypes.Empty{}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		exitRoutine <- true
+	}(t)
+
+	sentTime := <-sent
+	slotStart := time.Unix(genesisTime.Unix()+1, 0)
+	if sentTime.Before(slotStart) {
+		t.Errorf("Slot tick sent at %v before the slot started at %v", sentTime, slotStart)
+	}
+	cancel()
+	<-exitRoutine
+}
+
+func TestSlotTickStream_ContextClosed(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	hook := logTest.NewGlobal()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	if err := db.SaveState(context.Background(), &pbp2p.BeaconState{GenesisTime: uint64(time.Now().Unix())}); err != nil {
+		t.Fatal(err)
+	}
+	beaconServer := &BeaconServer{
+		ctx:      ctx,
+		beaconDB: db,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	exitRoutine := make(chan bool)
+	mockStream := internal.NewMockBeaconService_SlotTickStreamServer(ctrl)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	go func(tt *testing.T) {
+		if err := beaconServer.SlotTickStream(&ptypes.Empty{}, mockStream); err != nil {
+			tt.Errorf("Could not call RPC method: %v", err)
+		}
+		<-exitRoutine
+	}(t)
+	cancel()
+	exitRoutine <- true
+	testutil.AssertLogsContain(t, hook, "RPC context closed, exiting goroutine")
+}
+
+func TestSlotTickStream_NoBeaconState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	beaconServer := &BeaconServer{
+		ctx:      context.Background(),
+		beaconDB: db,
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStream := internal.NewMockBeaconService_SlotTickStreamServer(ctrl)
+	mockStream.EXPECT().Context().Return(context.Background()).AnyTimes()
+	err := beaconServer.SlotTickStream(&ptypes.Empty{}, mockStream)
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition error, received %v", err)
+	}
+	if !strings.Contains(err.Error(), "no beacon state available") {
+		t.Errorf("Unexpected error message, received %v", err)
+	}
+}
+
+func TestSyncStatus_PeersAhead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	headSlot := params.BeaconConfig().GenesisSlot + 5
+	head := &pbp2p.BeaconBlock{Slot: headSlot}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, &pbp2p.BeaconState{Slot: headSlot}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{
+		beaconDB: db,
+		syncService: &mockSyncService{
+			status:              errors.New("not initially synced"),
+			highestObservedSlot: headSlot + 10,
+			chainHeadReceived:   true,
+		},
+	}
+	resp, err := bs.SyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &pb.SyncStatusResponse{
+		Syncing:          true,
+		HeadSlot:         headSlot,
+		HighestSlot:      headSlot + 10,
+		HighestSlotKnown: true,
+	}
+	if !proto.Equal(resp, wanted) {
+		t.Errorf("Wanted sync status %v, received %v", wanted, resp)
+	}
+}
+
+func TestSyncStatus_HighestSlotUnknown(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	headSlot := params.BeaconConfig().GenesisSlot + 5
+	head := &pbp2p.BeaconBlock{Slot: headSlot}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, &pbp2p.BeaconState{Slot: headSlot}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{
+		beaconDB:    db,
+		syncService: &mockSyncService{},
+	}
+	resp, err := bs.SyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &pb.SyncStatusResponse{
+		Syncing:          false,
+		HeadSlot:         headSlot,
+		HighestSlot:      headSlot,
+		HighestSlotKnown: false,
+	}
+	if !proto.Equal(resp, wanted) {
+		t.Errorf("Wanted sync status %v, received %v", wanted, resp)
+	}
+}
+
 func TestPendingDeposits_UnknownBlockNum(t *testing.T) {
 	p := &mockPOWChainService{
 		latestBlockNumber: nil,
 	}
 	bs := BeaconServer{powChainService: p}
 
-	_, err := bs.PendingDeposits(context.Background(), nil)
+	_, err := bs.PendingDeposits(context.Background(), &pb.PendingDepositsRequest{})
 	if err.Error() != "latest PoW block number is unknown" {
 		t.Errorf("Received unexpected error: %v", err)
 	}
 }
 
+func TestPendingDeposits_NoBeaconState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
+	p := &mockPOWChainService{
+		latestBlockNumber: big.NewInt(0).Add(height, big.NewInt(1)),
+	}
+	db.InsertDeposit(ctx, &pbp2p.Deposit{DepositData: []byte("a")}, big.NewInt(0))
+
+	bs := &BeaconServer{
+		beaconDB:        db,
+		powChainService: p,
+	}
+	_, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition error, received %v", err)
+	}
+	if !strings.Contains(err.Error(), "no beacon state available") {
+		t.Errorf("Unexpected error message, received %v", err)
+	}
+}
+
 func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 	ctx := context.Background()
 
@@ -360,7 +767,7 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 		chainService:    newMockChainService(),
 	}
 
-	result, err := bs.PendingDeposits(ctx, nil)
+	result, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -370,7 +777,7 @@ func TestPendingDeposits_OutsideEth1FollowWindow(t *testing.T) {
 
 	// It should also return the recent deposits after their follow window.
 	p.latestBlockNumber = big.NewInt(0).Add(p.latestBlockNumber, big.NewInt(10000))
-	allResp, err := bs.PendingDeposits(ctx, nil)
+	allResp, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -439,7 +846,7 @@ func TestPendingDeposits_CantReturnBelowStateDepositIndex(t *testing.T) {
 
 	// It should also return the recent deposits after their follow window.
 	p.latestBlockNumber = big.NewInt(0).Add(p.latestBlockNumber, big.NewInt(10000))
-	allResp, err := bs.PendingDeposits(ctx, nil)
+	allResp, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -517,7 +924,7 @@ func TestPendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 
 	// It should also return the recent deposits after their follow window.
 	p.latestBlockNumber = big.NewInt(0).Add(p.latestBlockNumber, big.NewInt(10000))
-	allResp, err := bs.PendingDeposits(ctx, nil)
+	allResp, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -530,28 +937,244 @@ func TestPendingDeposits_CantReturnMoreThanMax(t *testing.T) {
 	}
 }
 
-func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
-	db := internal.SetupDB(t)
-	defer internal.TeardownDB(t, db)
+func TestPendingDeposits_RequestedMaxDepositsCapsCount(t *testing.T) {
 	ctx := context.Background()
 
-	beaconServer := &BeaconServer{
-		beaconDB: db,
-		powChainService: &faultyPOWChainService{
-			hashesByHeight: make(map[int][]byte),
+	height := big.NewInt(int64(params.BeaconConfig().Eth1FollowDistance))
+	p := &mockPOWChainService{
+		latestBlockNumber: height,
+		hashesByHeight: map[int][]byte{
+			int(height.Int64()): []byte("0x0"),
 		},
 	}
+	d := internal.SetupDB(t)
+	defer internal.TeardownDB(t, d)
+
 	beaconState := &pbp2p.BeaconState{
 		LatestEth1Data: &pbp2p.Eth1Data{
-			BlockHash32: []byte{'a'},
+			BlockHash32: []byte("0x0"),
 		},
-		Eth1DataVotes: []*pbp2p.Eth1DataVote{},
+		DepositIndex: 2,
 	}
-	if err := beaconServer.beaconDB.SaveState(ctx, beaconState); err != nil {
+	if err := d.SaveState(ctx, beaconState); err != nil {
 		t.Fatal(err)
 	}
-	want := "could not fetch ETH1_FOLLOW_DISTANCE ancestor"
-	if _, err := beaconServer.Eth1Data(context.Background(), nil); !strings.Contains(err.Error(), want) {
+
+	readyDeposits := []*pbp2p.Deposit{
+		{
+			MerkleTreeIndex: 0,
+			DepositData:     []byte("a"),
+		},
+		{
+			MerkleTreeIndex: 1,
+			DepositData:     []byte("b"),
+		},
+	}
+
+	var recentDeposits []*pbp2p.Deposit
+	for i := 2; i < 22; i++ {
+		recentDeposits = append(recentDeposits, &pbp2p.Deposit{
+			MerkleTreeIndex: uint64(i),
+			DepositData:     []byte{byte(i)},
+		})
+	}
+
+	for _, dp := range append(readyDeposits, recentDeposits...) {
+		d.InsertDeposit(ctx, dp, big.NewInt(int64(dp.MerkleTreeIndex)))
+	}
+	for _, dp := range recentDeposits {
+		d.InsertPendingDeposit(ctx, dp, big.NewInt(int64(dp.MerkleTreeIndex)))
+	}
+
+	bs := &BeaconServer{
+		beaconDB:        d,
+		powChainService: p,
+		chainService:    newMockChainService(),
+	}
+
+	tests := []struct {
+		maxDeposits uint64
+		wanted      int
+	}{
+		{maxDeposits: 3, wanted: 3},
+		{maxDeposits: 0, wanted: int(params.BeaconConfig().MaxDeposits)},
+		{maxDeposits: params.BeaconConfig().MaxDeposits + 5, wanted: int(params.BeaconConfig().MaxDeposits)},
+	}
+	for _, tt := range tests {
+		// Place the recent deposits after their follow window.
+		p.latestBlockNumber = big.NewInt(0).Add(height, big.NewInt(10000))
+		resp, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{MaxDeposits: tt.maxDeposits})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.PendingDeposits) != tt.wanted {
+			t.Errorf(
+				"Received unexpected number of pending deposits for max deposits %d: %d, wanted: %d",
+				tt.maxDeposits,
+				len(resp.PendingDeposits),
+				tt.wanted,
+			)
+		}
+		if len(resp.Readiness) != tt.wanted {
+			t.Errorf("Expected readiness for each of the %d deposits, received %d", tt.wanted, len(resp.Readiness))
+		}
+	}
+}
+
+func TestPendingDeposits_ReadinessMatchesFollowDistance(t *testing.T) {
+	ctx := context.Background()
+
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	height := big.NewInt(int64(followDistance))
+	p := &mockPOWChainService{
+		latestBlockNumber: height,
+		hashesByHeight: map[int][]byte{
+			int(height.Int64()): []byte("0x0"),
+		},
+		blockTimeByHeight: make(map[int]uint64),
+		strictBlockTimes:  true,
+	}
+	d := internal.SetupDB(t)
+	defer internal.TeardownDB(t, d)
+
+	beaconState := &pbp2p.BeaconState{
+		LatestEth1Data: &pbp2p.Eth1Data{
+			BlockHash32: []byte("0x0"),
+		},
+		DepositIndex: 2,
+	}
+	if err := d.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	readyDeposits := []*pbp2p.Deposit{
+		{
+			MerkleTreeIndex: 0,
+			DepositData:     []byte("a"),
+		},
+		{
+			MerkleTreeIndex: 1,
+			DepositData:     []byte("b"),
+		},
+	}
+
+	var recentDeposits []*pbp2p.Deposit
+	for i := 2; i < 6; i++ {
+		recentDeposits = append(recentDeposits, &pbp2p.Deposit{
+			MerkleTreeIndex: uint64(i),
+			DepositData:     []byte{byte(i)},
+		})
+	}
+
+	// Using the merkleTreeIndex as the block number for this test...
+	for _, dp := range append(readyDeposits, recentDeposits...) {
+		d.InsertDeposit(ctx, dp, big.NewInt(int64(dp.MerkleTreeIndex)))
+	}
+	for _, dp := range recentDeposits {
+		d.InsertPendingDeposit(ctx, dp, big.NewInt(int64(dp.MerkleTreeIndex)))
+		readyHeight := int(dp.MerkleTreeIndex + followDistance)
+		p.blockTimeByHeight[readyHeight] = uint64(1000 + readyHeight)
+	}
+
+	bs := &BeaconServer{
+		beaconDB:        d,
+		powChainService: p,
+		chainService:    newMockChainService(),
+	}
+
+	p.latestBlockNumber = big.NewInt(0).Add(p.latestBlockNumber, big.NewInt(10000))
+	latestHeight := p.latestBlockNumber.Uint64()
+	resp, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Readiness) != len(resp.PendingDeposits) {
+		t.Fatalf(
+			"Received unexpected number of readiness entries: %d, wanted: %d",
+			len(resp.Readiness),
+			len(resp.PendingDeposits),
+		)
+	}
+	for i, dep := range resp.PendingDeposits {
+		r := resp.Readiness[i]
+		if r.MerkleTreeIndex != dep.MerkleTreeIndex {
+			t.Errorf("Readiness entry %d has merkle index %d, wanted: %d", i, r.MerkleTreeIndex, dep.MerkleTreeIndex)
+		}
+		wantedBlock := dep.MerkleTreeIndex + followDistance
+		if r.Eth1BlockNumber != wantedBlock {
+			t.Errorf("Received unexpected readiness block: %d, wanted: %d", r.Eth1BlockNumber, wantedBlock)
+		}
+		if r.Eth1BlockNumber > latestHeight {
+			t.Errorf("Returned deposit is ready at block %d which is past the latest block %d", r.Eth1BlockNumber, latestHeight)
+		}
+		if r.Eth1BlockTime != uint64(1000+wantedBlock) {
+			t.Errorf("Received unexpected readiness time: %d, wanted: %d", r.Eth1BlockTime, 1000+wantedBlock)
+		}
+	}
+}
+
+func TestPendingDeposits_MissingBlockTimeFailsInStrictMode(t *testing.T) {
+	ctx := context.Background()
+
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	p := &mockPOWChainService{
+		latestBlockNumber: big.NewInt(int64(followDistance) + 10000),
+		hashesByHeight: map[int][]byte{
+			int(followDistance): []byte("0x0"),
+		},
+		blockTimeByHeight: make(map[int]uint64),
+		strictBlockTimes:  true,
+	}
+	d := internal.SetupDB(t)
+	defer internal.TeardownDB(t, d)
+
+	if err := d.SaveState(ctx, &pbp2p.BeaconState{
+		LatestEth1Data: &pbp2p.Eth1Data{
+			BlockHash32: []byte("0x0"),
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	dp := &pbp2p.Deposit{
+		MerkleTreeIndex: 0,
+		DepositData:     []byte("a"),
+	}
+	d.InsertDeposit(ctx, dp, big.NewInt(0))
+	d.InsertPendingDeposit(ctx, dp, big.NewInt(0))
+
+	bs := &BeaconServer{
+		beaconDB:        d,
+		powChainService: p,
+		chainService:    newMockChainService(),
+	}
+	want := fmt.Sprintf("could not fetch eth1 block time at height %d", followDistance)
+	if _, err := bs.PendingDeposits(ctx, &pb.PendingDepositsRequest{}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestEth1Data_EmptyVotesFetchBlockHashFailure(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconServer := &BeaconServer{
+		beaconDB: db,
+		powChainService: &faultyPOWChainService{
+			hashesByHeight: make(map[int][]byte),
+		},
+	}
+	beaconState := &pbp2p.BeaconState{
+		LatestEth1Data: &pbp2p.Eth1Data{
+			BlockHash32: []byte{'a'},
+		},
+		Eth1DataVotes: []*pbp2p.Eth1DataVote{},
+	}
+	if err := beaconServer.beaconDB.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	want := "could not fetch ETH1_FOLLOW_DISTANCE ancestor"
+	if _, err := beaconServer.Eth1Data(context.Background(), nil); !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected error %v, received %v", want, err)
 	}
 }
@@ -700,6 +1323,81 @@ func TestEth1Data_NonEmptyVotesSelectsBestVote(t *testing.T) {
 	}
 }
 
+func TestEth1Data_RecomputedAfterEth1Reorg(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState := &pbp2p.BeaconState{
+		Eth1DataVotes: []*pbp2p.Eth1DataVote{
+			{
+				VoteCount: 1,
+				Eth1Data: &pbp2p.Eth1Data{
+					BlockHash32:       []byte("block0"),
+					DepositRootHash32: []byte("deposit0"),
+				},
+			},
+			{
+				VoteCount: 1,
+				Eth1Data: &pbp2p.Eth1Data{
+					BlockHash32:       []byte("block1"),
+					DepositRootHash32: []byte("deposit1"),
+				},
+			},
+		},
+		LatestEth1Data: &pbp2p.Eth1Data{
+			BlockHash32: []byte("stub"),
+		},
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	currentHeight := int(params.BeaconConfig().Eth1FollowDistance) + 5
+	powChainService := &mockPOWChainService{
+		latestBlockNumber: big.NewInt(int64(currentHeight)),
+		hashesByHeight: map[int][]byte{
+			0:             beaconState.LatestEth1Data.BlockHash32,
+			1:             beaconState.Eth1DataVotes[0].Eth1Data.BlockHash32,
+			2:             beaconState.Eth1DataVotes[1].Eth1Data.BlockHash32,
+			currentHeight: []byte("head"),
+		},
+	}
+	beaconServer := &BeaconServer{
+		beaconDB:        db,
+		powChainService: powChainService,
+	}
+	result, err := beaconServer.Eth1Data(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Votes are tied so the vote for the block with the highest height is selected.
+	if !bytes.Equal(result.Eth1Data.BlockHash32, []byte("block1")) {
+		t.Fatalf("Expected block1 to be selected, received %s", result.Eth1Data.BlockHash32)
+	}
+
+	// Swapping the heights of the voted blocks without changing the block hash at the
+	// latest height is not seen as a reorg, so the cached eth1 data is returned.
+	powChainService.hashesByHeight[1] = beaconState.Eth1DataVotes[1].Eth1Data.BlockHash32
+	powChainService.hashesByHeight[2] = beaconState.Eth1DataVotes[0].Eth1Data.BlockHash32
+	result, err = beaconServer.Eth1Data(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(result.Eth1Data.BlockHash32, []byte("block1")) {
+		t.Fatalf("Expected cached block1 to be returned, received %s", result.Eth1Data.BlockHash32)
+	}
+
+	// Simulate a reorg of the eth1 chain, replacing the block at the latest height.
+	powChainService.hashesByHeight[currentHeight] = []byte("reorged head")
+	result, err = beaconServer.Eth1Data(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(result.Eth1Data.BlockHash32, []byte("block0")) {
+		t.Errorf("Expected block0 to be selected after the reorg, received %s", result.Eth1Data.BlockHash32)
+	}
+}
+
 func TestBlockTree_OK(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -903,7 +1601,7 @@ func TestBlockTree_OK(t *testing.T) {
 		beaconDB:       db,
 		targetsFetcher: &mockChainService{targets: attestationTargets},
 	}
-	resp, err := bs.BlockTree(ctx, &ptypes.Empty{})
+	resp, err := bs.BlockTree(ctx, &pb.BlockTreeRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -919,21 +1617,19 @@ func TestBlockTree_OK(t *testing.T) {
 		}
 	}
 }
-func TestBlockTreeBySlots_ArgsValildation(t *testing.T) {
+
+func TestBlockTree_AnnotatesFinalizationStatus(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	ctx := context.Background()
-	// We want to ensure that if our block tree looks as follows, the RPC response
-	// returns the correct information.
-	//                   /->[A, Slot 3, 3 Votes]->[B, Slot 4, 3 Votes]
-	// [Justified Block]->[C, Slot 3, 2 Votes]
-	//                   \->[D, Slot 3, 2 Votes]->[SKIP SLOT]->[E, Slot 5, 1 Vote]
+	// We annotate the following block tree using a head state which has finalized
+	// block A and justified block B.
+	// [Justified Block]->[A, Slot 1]->[B, Slot 2]->[C, Slot 3]
+	//                               \->[D, Slot 2]
 	justifiedState := &pbp2p.BeaconState{
 		Slot:              params.BeaconConfig().GenesisSlot,
-		ValidatorBalances: make([]uint64, 11),
-	}
-	for i := 0; i < len(justifiedState.ValidatorBalances); i++ {
-		justifiedState.ValidatorBalances[i] = params.BeaconConfig().MaxDepositAmount
+		ValidatorRegistry: []*pbp2p.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}},
+		ValidatorBalances: []uint64{params.BeaconConfig().MaxDepositAmount},
 	}
 	if err := db.SaveJustifiedState(justifiedState); err != nil {
 		t.Fatal(err)
@@ -944,209 +1640,402 @@ func TestBlockTreeBySlots_ArgsValildation(t *testing.T) {
 	if err := db.SaveJustifiedBlock(justifiedBlock); err != nil {
 		t.Fatal(err)
 	}
+	if err := db.SaveBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
+	}
 	justifiedRoot, _ := hashutil.HashBeaconBlock(justifiedBlock)
-	validators := []*pbp2p.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}}
-	balances := []uint64{params.BeaconConfig().MaxDepositAmount}
-	b1 := &pbp2p.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 3,
+	a := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
 		ParentRootHash32: justifiedRoot[:],
 		RandaoReveal:     []byte("A"),
 	}
-	b1Root, _ := hashutil.HashBeaconBlock(b1)
-	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
-		Slot:              params.BeaconConfig().GenesisSlot + 3,
-		ValidatorRegistry: validators,
-		ValidatorBalances: balances,
-	}, b1Root); err != nil {
-		t.Fatal(err)
+	aRoot, _ := hashutil.HashBeaconBlock(a)
+	b := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: aRoot[:],
+		RandaoReveal:     []byte("B"),
 	}
-	b2 := &pbp2p.BeaconBlock{
+	bRoot, _ := hashutil.HashBeaconBlock(b)
+	c := &pbp2p.BeaconBlock{
 		Slot:             params.BeaconConfig().GenesisSlot + 3,
-		ParentRootHash32: justifiedRoot[:],
+		ParentRootHash32: bRoot[:],
 		RandaoReveal:     []byte("C"),
 	}
-	b2Root, _ := hashutil.HashBeaconBlock(b2)
-	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
-		Slot:              params.BeaconConfig().GenesisSlot + 3,
-		ValidatorRegistry: validators,
-		ValidatorBalances: balances,
-	}, b2Root); err != nil {
-		t.Fatal(err)
-	}
-	b3 := &pbp2p.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 3,
-		ParentRootHash32: justifiedRoot[:],
+	d := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: aRoot[:],
 		RandaoReveal:     []byte("D"),
 	}
-	b3Root, _ := hashutil.HashBeaconBlock(b3)
-	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
-		Slot:              params.BeaconConfig().GenesisSlot + 3,
-		ValidatorRegistry: validators,
-		ValidatorBalances: balances,
-	}, b3Root); err != nil {
-		t.Fatal(err)
+	for _, blk := range []*pbp2p.BeaconBlock{a, b, c, d} {
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		root, _ := hashutil.HashBeaconBlock(blk)
+		if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+			Slot:              blk.Slot,
+			ValidatorRegistry: justifiedState.ValidatorRegistry,
+			ValidatorBalances: justifiedState.ValidatorBalances,
+		}, root); err != nil {
+			t.Fatal(err)
+		}
 	}
-	b4 := &pbp2p.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 4,
-		ParentRootHash32: b1Root[:],
-		RandaoReveal:     []byte("B"),
+	headState := &pbp2p.BeaconState{
+		Slot:          c.Slot,
+		FinalizedRoot: aRoot[:],
+		JustifiedRoot: bRoot[:],
 	}
-	b4Root, _ := hashutil.HashBeaconBlock(b4)
-	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
-		Slot:              params.BeaconConfig().GenesisSlot + 4,
-		ValidatorRegistry: validators,
-		ValidatorBalances: balances,
-	}, b4Root); err != nil {
+	if err := db.UpdateChainHead(ctx, c, headState); err != nil {
 		t.Fatal(err)
 	}
-	b5 := &pbp2p.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 5,
-		ParentRootHash32: b3Root[:],
-		RandaoReveal:     []byte("E"),
+
+	bs := &BeaconServer{
+		beaconDB:       db,
+		targetsFetcher: &mockChainService{targets: make(map[uint64]*pbp2p.AttestationTarget)},
 	}
-	b5Root, _ := hashutil.HashBeaconBlock(b5)
-	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
-		Slot:              params.BeaconConfig().GenesisSlot + 5,
+	resp, err := bs.BlockTree(ctx, &pb.BlockTreeRequest{AnnotateFinalization: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]pb.BlockTreeResponse_FinalizationStatus{
+		"A": pb.BlockTreeResponse_FINALIZED,
+		"B": pb.BlockTreeResponse_JUSTIFIED,
+		"D": pb.BlockTreeResponse_NOT_JUSTIFIED,
+	}
+	if len(resp.Tree) != len(want) {
+		t.Fatalf("Expected %d tree nodes, received %d", len(want), len(resp.Tree))
+	}
+	for _, node := range resp.Tree {
+		name := string(node.Block.RandaoReveal)
+		if node.FinalizationStatus != want[name] {
+			t.Errorf("Expected block %s to be tagged %v, received %v", name, want[name], node.FinalizationStatus)
+		}
+	}
+
+	resp, err = bs.BlockTree(ctx, &pb.BlockTreeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, node := range resp.Tree {
+		if node.FinalizationStatus != pb.BlockTreeResponse_UNANNOTATED {
+			t.Errorf("Expected block %s to not be annotated, received %v", node.Block.RandaoReveal, node.FinalizationStatus)
+		}
+	}
+}
+
+func TestBlockTree_ValidatorRange(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	// Validators 0-2 vote for block A and validators 3-9 vote for its child B.
+	// [Justified Block]->[A, Slot 1]->[B, Slot 2]
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	validators := make([]*pbp2p.Validator, 10)
+	balances := make([]uint64, len(validators))
+	for i := range validators {
+		validators[i] = &pbp2p.Validator{ExitEpoch: params.BeaconConfig().FarFutureEpoch}
+		balances[i] = maxDeposit
+	}
+	justifiedState := &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
 		ValidatorRegistry: validators,
 		ValidatorBalances: balances,
-	}, b5Root); err != nil {
+	}
+	if err := db.SaveJustifiedState(justifiedState); err != nil {
 		t.Fatal(err)
 	}
-	attestationTargets := make(map[uint64]*pbp2p.AttestationTarget)
-	// We give block A 3 votes.
-	attestationTargets[0] = &pbp2p.AttestationTarget{
-		Slot:       b1.Slot,
-		ParentRoot: b1.ParentRootHash32,
-		BlockRoot:  b1Root[:],
+	justifiedBlock := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot,
 	}
-	attestationTargets[1] = &pbp2p.AttestationTarget{
-		Slot:       b1.Slot,
-		ParentRoot: b1.ParentRootHash32,
-		BlockRoot:  b1Root[:],
+	if err := db.SaveJustifiedBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
 	}
-	attestationTargets[2] = &pbp2p.AttestationTarget{
-		Slot:       b1.Slot,
-		ParentRoot: b1.ParentRootHash32,
-		BlockRoot:  b1Root[:],
+	justifiedRoot, _ := hashutil.HashBeaconBlock(justifiedBlock)
+	a := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		ParentRootHash32: justifiedRoot[:],
+		RandaoReveal:     []byte("A"),
+	}
+	aRoot, _ := hashutil.HashBeaconBlock(a)
+	b := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: aRoot[:],
+		RandaoReveal:     []byte("B"),
+	}
+	bRoot, _ := hashutil.HashBeaconBlock(b)
+	for _, blk := range []*pbp2p.BeaconBlock{a, b} {
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		root, _ := hashutil.HashBeaconBlock(blk)
+		if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+			Slot:              blk.Slot,
+			ValidatorRegistry: validators,
+			ValidatorBalances: balances,
+		}, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The tree only covers blocks below the highest slot, so the head is one slot above B.
+	head := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 3,
+		ParentRootHash32: bRoot[:],
+	}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, &pbp2p.BeaconState{Slot: head.Slot}); err != nil {
+		t.Fatal(err)
+	}
+	attestationTargets := make(map[uint64]*pbp2p.AttestationTarget)
+	for i := uint64(0); i < uint64(len(validators)); i++ {
+		target := &pbp2p.AttestationTarget{Slot: a.Slot, ParentRoot: a.ParentRootHash32, BlockRoot: aRoot[:]}
+		if i >= 3 {
+			target = &pbp2p.AttestationTarget{Slot: b.Slot, ParentRoot: b.ParentRootHash32, BlockRoot: bRoot[:]}
+		}
+		attestationTargets[i] = target
 	}
 
-	// We give block C 2 votes.
-	attestationTargets[3] = &pbp2p.AttestationTarget{
-		Slot:       b2.Slot,
-		ParentRoot: b2.ParentRootHash32,
-		BlockRoot:  b2Root[:],
+	bs := &BeaconServer{
+		beaconDB:       db,
+		targetsFetcher: &mockChainService{targets: attestationTargets},
 	}
-	attestationTargets[4] = &pbp2p.AttestationTarget{
-		Slot:       b2.Slot,
-		ParentRoot: b2.ParentRootHash32,
-		BlockRoot:  b2Root[:],
+	resp, err := bs.BlockTree(ctx, &pb.BlockTreeRequest{
+		ValidatorRange: &pb.ValidatorIndexRange{StartIndex: 0, EndIndex: 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantVotes := map[string]uint64{
+		"A": 5 * maxDeposit,
+		"B": 2 * maxDeposit,
+	}
+	if len(resp.Tree) != len(wantVotes) {
+		t.Fatalf("Expected %d tree nodes, received %d", len(wantVotes), len(resp.Tree))
+	}
+	for _, node := range resp.Tree {
+		name := string(node.Block.RandaoReveal)
+		if node.ParticipatedVotes != wantVotes[name] {
+			t.Errorf("Expected block %s to have %d participated votes, received %d", name, wantVotes[name], node.ParticipatedVotes)
+		}
+		if node.TotalVotes != 10*maxDeposit {
+			t.Errorf("Expected block %s to have %d total votes, received %d", name, 10*maxDeposit, node.TotalVotes)
+		}
 	}
 
-	// We give block D 2 votes.
-	attestationTargets[5] = &pbp2p.AttestationTarget{
-		Slot:       b3.Slot,
-		ParentRoot: b3.ParentRootHash32,
-		BlockRoot:  b3Root[:],
+	_, err = bs.BlockTree(ctx, &pb.BlockTreeRequest{
+		ValidatorRange: &pb.ValidatorIndexRange{StartIndex: 5, EndIndex: 5},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error for an empty validator range, received %v", err)
 	}
-	attestationTargets[6] = &pbp2p.AttestationTarget{
-		Slot:       b3.Slot,
-		ParentRoot: b3.ParentRootHash32,
-		BlockRoot:  b3Root[:],
+}
+
+func TestBlockTree_NilTargetsFetcher(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	_, err := bs.BlockTree(ctx, &pb.BlockTreeRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition error, received %v", err)
+	}
+	if !strings.Contains(err.Error(), "attestation targets not yet available") {
+		t.Errorf("Unexpected error message, received %v", err)
 	}
+}
 
-	// We give block B 3 votes.
-	attestationTargets[7] = &pbp2p.AttestationTarget{
-		Slot:       b4.Slot,
-		ParentRoot: b4.ParentRootHash32,
-		BlockRoot:  b4Root[:],
+func TestGenesisDepositRoot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	if _, err := bs.GenesisDepositRoot(ctx, &ptypes.Empty{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition error before genesis, received %v", err)
 	}
-	attestationTargets[8] = &pbp2p.AttestationTarget{
-		Slot:       b4.Slot,
-		ParentRoot: b4.ParentRootHash32,
-		BlockRoot:  b4Root[:],
+
+	depositRoot := []byte("genesis deposit root")
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), nil /* deposits */, &pbp2p.Eth1Data{
+		DepositRootHash32: depositRoot,
+		BlockHash32:       []byte("genesis block hash"),
+	}); err != nil {
+		t.Fatalf("Could not initialize beacon state: %v", err)
+	}
+	resp, err := bs.GenesisDepositRoot(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
 	}
-	attestationTargets[9] = &pbp2p.AttestationTarget{
-		Slot:       b4.Slot,
-		ParentRoot: b4.ParentRootHash32,
-		BlockRoot:  b4Root[:],
+	if !bytes.Equal(resp.DepositRoot, depositRoot) {
+		t.Errorf("Expected genesis deposit root %#x, received %#x", depositRoot, resp.DepositRoot)
+	}
+}
+
+func TestDepositStatus(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{DepositIndex: 3}); err != nil {
+		t.Fatal(err)
 	}
+	db.InsertPendingDeposit(ctx, &pbp2p.Deposit{MerkleTreeIndex: 3}, big.NewInt(10))
 
-	// We give block E 1 vote.
-	attestationTargets[10] = &pbp2p.AttestationTarget{
-		Slot:       b5.Slot,
-		ParentRoot: b5.ParentRootHash32,
-		BlockRoot:  b5Root[:],
+	bs := &BeaconServer{beaconDB: db}
+	tests := []struct {
+		merkleTreeIndex uint64
+		wanted          pb.DepositStatusResponse_Status
+	}{
+		{merkleTreeIndex: 0, wanted: pb.DepositStatusResponse_PROCESSED},
+		{merkleTreeIndex: 2, wanted: pb.DepositStatusResponse_PROCESSED},
+		{merkleTreeIndex: 3, wanted: pb.DepositStatusResponse_PENDING},
+		{merkleTreeIndex: 4, wanted: pb.DepositStatusResponse_UNKNOWN},
+	}
+	for _, tt := range tests {
+		resp, err := bs.DepositStatus(ctx, &pb.DepositStatusRequest{MerkleTreeIndex: tt.merkleTreeIndex})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Status != tt.wanted {
+			t.Errorf("Expected deposit %d to be %v, received %v", tt.merkleTreeIndex, tt.wanted, resp.Status)
+		}
 	}
+}
 
-	tree := []*pb.BlockTreeResponse_TreeNode{
-		{
-			Block:             b1,
-			ParticipatedVotes: 3 * params.BeaconConfig().MaxDepositAmount,
-			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
-		},
-		{
-			Block:             b2,
-			ParticipatedVotes: 2 * params.BeaconConfig().MaxDepositAmount,
-			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
-		},
-		{
-			Block:             b3,
-			ParticipatedVotes: 2 * params.BeaconConfig().MaxDepositAmount,
-			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
-		},
-		{
-			Block:             b4,
-			ParticipatedVotes: 3 * params.BeaconConfig().MaxDepositAmount,
-			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
-		},
-		{
-			Block:             b5,
-			ParticipatedVotes: 1 * params.BeaconConfig().MaxDepositAmount,
-			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
-		},
+func TestGenesisValidators_Paginated(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	if _, err := bs.GenesisValidators(ctx, &pb.GenesisValidatorsRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition error before genesis, received %v", err)
 	}
-	for _, node := range tree {
-		if err := db.SaveBlock(node.Block); err != nil {
+
+	deposits := make([]*pbp2p.Deposit, 5)
+	for i := range deposits {
+		depositData, err := helpers.EncodeDepositData(
+			&pbp2p.DepositInput{Pubkey: []byte(strconv.Itoa(i))},
+			params.BeaconConfig().MaxDepositAmount,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			t.Fatalf("Could not encode deposit input: %v", err)
+		}
+		deposits[i] = &pbp2p.Deposit{DepositData: depositData}
+	}
+	if err := db.InitializeState(ctx, uint64(time.Now().Unix()), deposits, &pbp2p.Eth1Data{}); err != nil {
+		t.Fatalf("Could not initialize beacon state: %v", err)
+	}
+
+	var pubkeys []string
+	req := &pb.GenesisValidatorsRequest{PageSize: 2}
+	for {
+		res, err := bs.GenesisValidators(ctx, req)
+		if err != nil {
 			t.Fatal(err)
 		}
+		if res.TotalSize != uint64(len(deposits)) {
+			t.Errorf("Wanted total size %d, received %d", len(deposits), res.TotalSize)
+		}
+		for _, v := range res.Validators {
+			pubkeys = append(pubkeys, string(v.Pubkey))
+		}
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
 	}
-	headState := &pbp2p.BeaconState{
-		Slot: b4.Slot,
+	want := []string{"0", "1", "2", "3", "4"}
+	if !reflect.DeepEqual(pubkeys, want) {
+		t.Errorf("Wanted genesis validators with public keys %v, received %v", want, pubkeys)
 	}
-	if err := db.UpdateChainHead(ctx, b4, headState); err != nil {
+}
+
+func TestPendingDepositCount(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{DepositIndex: 3}); err != nil {
 		t.Fatal(err)
 	}
-	bs := &BeaconServer{
-		beaconDB:       db,
-		targetsFetcher: &mockChainService{targets: attestationTargets},
-	}
-	if _, err := bs.BlockTreeBySlots(ctx, nil); err == nil {
-		// There should be a "argument 'TreeBlockSlotRequest' cannot be nil" error
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.PendingDepositCount(ctx, &ptypes.Empty{})
+	if err != nil {
 		t.Fatal(err)
 	}
-	slotRange := &pb.TreeBlockSlotRequest{
-		SlotFrom: params.BeaconConfig().GenesisSlot + 4,
-		SlotTo:   params.BeaconConfig().GenesisSlot + 3,
+	if resp.Count != 0 {
+		t.Errorf("Expected no pending deposits, received %d", resp.Count)
 	}
-	if _, err := bs.BlockTreeBySlots(ctx, slotRange); err == nil {
-		// There should be a 'Upper limit of slot range cannot be lower than the lower limit' error.
+
+	for i := uint64(1); i <= 5; i++ {
+		db.InsertPendingDeposit(ctx, &pbp2p.Deposit{MerkleTreeIndex: i}, big.NewInt(int64(10+i)))
+	}
+	resp, err = bs.PendingDepositCount(ctx, &ptypes.Empty{})
+	if err != nil {
 		t.Fatal(err)
 	}
+	// Deposits 1 and 2 were already processed into the state.
+	if resp.Count != 3 {
+		t.Errorf("Expected 3 pending deposits, received %d", resp.Count)
+	}
 }
-func TestBlockTreeBySlots_OK(t *testing.T) {
+
+func TestEth1FollowStatus(t *testing.T) {
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	tests := []struct {
+		latest     uint64
+		wantedSafe uint64
+	}{
+		{latest: followDistance + 5, wantedSafe: 5},
+		{latest: followDistance, wantedSafe: 0},
+		{latest: followDistance - 1, wantedSafe: 0},
+	}
+	for _, tt := range tests {
+		latest := new(big.Int).SetUint64(tt.latest)
+		bs := &BeaconServer{
+			powChainService: &mockPOWChainService{latestBlockNumber: latest},
+		}
+		resp, err := bs.Eth1FollowStatus(context.Background(), &ptypes.Empty{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.LatestBlockNumber != tt.latest {
+			t.Errorf("Expected latest block number %d, received %d", tt.latest, resp.LatestBlockNumber)
+		}
+		if resp.FollowDistance != followDistance {
+			t.Errorf("Expected follow distance %d, received %d", followDistance, resp.FollowDistance)
+		}
+		if resp.SafeBlockNumber != tt.wantedSafe {
+			t.Errorf("Expected safe block number %d, received %d", tt.wantedSafe, resp.SafeBlockNumber)
+		}
+		if latest.Uint64() != tt.latest {
+			t.Errorf("Expected latest block height to be left unchanged, received %d", latest.Uint64())
+		}
+	}
+}
+
+func TestEth1FollowStatus_UnknownLatestBlock(t *testing.T) {
+	bs := &BeaconServer{
+		powChainService: &mockPOWChainService{},
+	}
+	if _, err := bs.Eth1FollowStatus(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition error, received %v", err)
+	}
+}
+
+func TestForkChoiceStore_ReturnsCheckpointsAndWeights(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	ctx := context.Background()
-	// We want to ensure that if our block tree looks as follows, the RPC response
-	// returns the correct information.
-	//                   /->[A, Slot 3, 3 Votes]->[B, Slot 4, 3 Votes]
-	// [Justified Block]->[C, Slot 3, 2 Votes]
-	//                   \->[D, Slot 3, 2 Votes]->[SKIP SLOT]->[E, Slot 5, 1 Vote]
+	// [Justified Block]->[A, Slot 1, 2 Votes]->[B, Slot 2, 1 Vote]
+	//                  \->[C, Slot 2, 1 Vote]
 	justifiedState := &pbp2p.BeaconState{
 		Slot:              params.BeaconConfig().GenesisSlot,
-		ValidatorBalances: make([]uint64, 11),
+		ValidatorBalances: make([]uint64, 3),
 	}
-	for i := 0; i < len(justifiedState.ValidatorBalances); i++ {
+	for i := range justifiedState.ValidatorBalances {
 		justifiedState.ValidatorBalances[i] = params.BeaconConfig().MaxDepositAmount
 	}
 	if err := db.SaveJustifiedState(justifiedState); err != nil {
@@ -1158,24 +2047,380 @@ func TestBlockTreeBySlots_OK(t *testing.T) {
 	if err := db.SaveJustifiedBlock(justifiedBlock); err != nil {
 		t.Fatal(err)
 	}
+	if err := db.SaveFinalizedBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
+	}
 	justifiedRoot, _ := hashutil.HashBeaconBlock(justifiedBlock)
-	validators := []*pbp2p.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}}
-	balances := []uint64{params.BeaconConfig().MaxDepositAmount}
-	b1 := &pbp2p.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 3,
+	a := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
 		ParentRootHash32: justifiedRoot[:],
 		RandaoReveal:     []byte("A"),
 	}
-	b1Root, _ := hashutil.HashBeaconBlock(b1)
-	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
-		Slot:              params.BeaconConfig().GenesisSlot + 3,
-		ValidatorRegistry: validators,
-		ValidatorBalances: balances,
-	}, b1Root); err != nil {
-		t.Fatal(err)
+	aRoot, _ := hashutil.HashBeaconBlock(a)
+	b := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: aRoot[:],
+		RandaoReveal:     []byte("B"),
 	}
-	b2 := &pbp2p.BeaconBlock{
-		Slot:             params.BeaconConfig().GenesisSlot + 3,
+	bRoot, _ := hashutil.HashBeaconBlock(b)
+	c := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: aRoot[:],
+		RandaoReveal:     []byte("C"),
+	}
+	cRoot, _ := hashutil.HashBeaconBlock(c)
+	for _, blk := range []*pbp2p.BeaconBlock{a, b, c} {
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateChainHead(ctx, b, &pbp2p.BeaconState{Slot: b.Slot}); err != nil {
+		t.Fatal(err)
+	}
+	attestationTargets := map[uint64]*pbp2p.AttestationTarget{
+		0: {Slot: b.Slot, ParentRoot: b.ParentRootHash32, BlockRoot: bRoot[:]},
+		1: {Slot: c.Slot, ParentRoot: c.ParentRootHash32, BlockRoot: cRoot[:]},
+	}
+
+	bs := &BeaconServer{
+		beaconDB:       db,
+		targetsFetcher: &mockChainService{targets: attestationTargets},
+	}
+	resp, err := bs.ForkChoiceStore(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantedCheckpoint := &pb.ForkChoiceStoreResponse_Checkpoint{
+		Epoch:     params.BeaconConfig().GenesisEpoch,
+		Slot:      justifiedBlock.Slot,
+		BlockRoot: justifiedRoot[:],
+	}
+	if !proto.Equal(resp.JustifiedCheckpoint, wantedCheckpoint) {
+		t.Errorf("Expected justified checkpoint %v, received %v", wantedCheckpoint, resp.JustifiedCheckpoint)
+	}
+	if !proto.Equal(resp.FinalizedCheckpoint, wantedCheckpoint) {
+		t.Errorf("Expected finalized checkpoint %v, received %v", wantedCheckpoint, resp.FinalizedCheckpoint)
+	}
+	wantedWeights := map[[32]byte]uint64{
+		aRoot: 2 * params.BeaconConfig().MaxDepositAmount,
+		bRoot: params.BeaconConfig().MaxDepositAmount,
+		cRoot: params.BeaconConfig().MaxDepositAmount,
+	}
+	if len(resp.Blocks) != len(wantedWeights)+1 {
+		t.Fatalf("Expected %d tracked blocks, received %d", len(wantedWeights)+1, len(resp.Blocks))
+	}
+	for _, blk := range resp.Blocks {
+		root := bytesutil.ToBytes32(blk.BlockRoot)
+		if root == justifiedRoot {
+			continue
+		}
+		if blk.Weight != wantedWeights[root] {
+			t.Errorf("Expected block at slot %d to weigh %d, received %d", blk.Slot, wantedWeights[root], blk.Weight)
+		}
+	}
+}
+
+func TestForkChoiceStore_NotInitialized(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	if _, err := bs.ForkChoiceStore(ctx, &ptypes.Empty{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition error without a targets fetcher, received %v", err)
+	}
+	bs.targetsFetcher = &mockChainService{targets: make(map[uint64]*pbp2p.AttestationTarget)}
+	_, err := bs.ForkChoiceStore(ctx, &ptypes.Empty{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition error without a justified state, received %v", err)
+	}
+	if !strings.Contains(err.Error(), "fork choice store not yet initialized") {
+		t.Errorf("Unexpected error message, received %v", err)
+	}
+}
+
+func TestAttestationTargetCache_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveJustifiedState(&pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot}); err != nil {
+		t.Fatal(err)
+	}
+	targets := map[uint64]*pbp2p.AttestationTarget{
+		0: {Slot: params.BeaconConfig().GenesisSlot + 1, BlockRoot: []byte{'A'}},
+		3: {Slot: params.BeaconConfig().GenesisSlot + 2, BlockRoot: []byte{'B'}},
+	}
+	bs := &BeaconServer{
+		beaconDB:       db,
+		targetsFetcher: &mockChainService{targets: targets},
+	}
+	resp, err := bs.AttestationTargetCache(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(resp.Targets, targets) {
+		t.Errorf("Wanted attestation targets %v, received %v", targets, resp.Targets)
+	}
+}
+
+func TestAttestationTargetCache_NotPopulated(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.AttestationTargetCache(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Expected no error without a targets fetcher, received %v", err)
+	}
+	if resp.Targets == nil || len(resp.Targets) != 0 {
+		t.Errorf("Expected an empty map of targets without a targets fetcher, received %v", resp.Targets)
+	}
+
+	bs.targetsFetcher = &mockChainService{targets: map[uint64]*pbp2p.AttestationTarget{0: {}}}
+	resp, err = bs.AttestationTargetCache(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Expected no error without a justified state, received %v", err)
+	}
+	if resp.Targets == nil || len(resp.Targets) != 0 {
+		t.Errorf("Expected an empty map of targets without a justified state, received %v", resp.Targets)
+	}
+}
+
+func TestBlockTree_ConflictingTargetsCountOnce(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	// Validator 0 first targets block A and then switches to block B, so only its latest
+	// target must be counted, giving both blocks a single vote.
+	//                   /->[A, Slot 1]->[C, Slot 2]
+	// [Justified Block]->[B, Slot 1]
+	justifiedState := &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorBalances: make([]uint64, 2),
+	}
+	for i := 0; i < len(justifiedState.ValidatorBalances); i++ {
+		justifiedState.ValidatorBalances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	if err := db.SaveJustifiedState(justifiedState); err != nil {
+		t.Fatal(err)
+	}
+	justifiedBlock := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot,
+	}
+	if err := db.SaveJustifiedBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
+	}
+	justifiedRoot, _ := hashutil.HashBeaconBlock(justifiedBlock)
+	validators := []*pbp2p.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}}
+	balances := []uint64{params.BeaconConfig().MaxDepositAmount}
+	blockA := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		ParentRootHash32: justifiedRoot[:],
+		RandaoReveal:     []byte("A"),
+	}
+	blockB := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 1,
+		ParentRootHash32: justifiedRoot[:],
+		RandaoReveal:     []byte("B"),
+	}
+	rootA, _ := hashutil.HashBeaconBlock(blockA)
+	rootB, _ := hashutil.HashBeaconBlock(blockB)
+	blockC := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: rootA[:],
+		RandaoReveal:     []byte("C"),
+	}
+	rootC, _ := hashutil.HashBeaconBlock(blockC)
+	for i, blk := range []*pbp2p.BeaconBlock{blockA, blockB, blockC} {
+		root := [][32]byte{rootA, rootB, rootC}[i]
+		if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+			Slot:              blk.Slot,
+			ValidatorRegistry: validators,
+			ValidatorBalances: balances,
+		}, root); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateChainHead(ctx, blockC, &pbp2p.BeaconState{Slot: blockC.Slot}); err != nil {
+		t.Fatal(err)
+	}
+
+	attestationTargets := make(map[uint64]*pbp2p.AttestationTarget)
+	attestationTargets[0] = &pbp2p.AttestationTarget{
+		Slot:       blockA.Slot,
+		ParentRoot: blockA.ParentRootHash32,
+		BlockRoot:  rootA[:],
+	}
+	attestationTargets[1] = &pbp2p.AttestationTarget{
+		Slot:       blockA.Slot,
+		ParentRoot: blockA.ParentRootHash32,
+		BlockRoot:  rootA[:],
+	}
+	// The latest target of validator 0 replaces its earlier conflicting target.
+	attestationTargets[0] = &pbp2p.AttestationTarget{
+		Slot:       blockB.Slot,
+		ParentRoot: blockB.ParentRootHash32,
+		BlockRoot:  rootB[:],
+	}
+	bs := &BeaconServer{
+		beaconDB:       db,
+		targetsFetcher: &mockChainService{targets: attestationTargets},
+	}
+	wantVotes := map[string]uint64{
+		"A": params.BeaconConfig().MaxDepositAmount,
+		"B": params.BeaconConfig().MaxDepositAmount,
+	}
+	var firstResp *pb.BlockTreeResponse
+	for i := 0; i < 5; i++ {
+		resp, err := bs.BlockTree(ctx, &pb.BlockTreeRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sort.Slice(resp.Tree, func(i, j int) bool {
+			return string(resp.Tree[i].Block.RandaoReveal) < string(resp.Tree[j].Block.RandaoReveal)
+		})
+		if len(resp.Tree) < len(wantVotes) {
+			t.Fatalf("Expected at least %d nodes in block tree, received %d", len(wantVotes), len(resp.Tree))
+		}
+		for _, node := range resp.Tree {
+			want, ok := wantVotes[string(node.Block.RandaoReveal)]
+			if ok && node.ParticipatedVotes != want {
+				t.Errorf(
+					"Expected block %s to have %d participated votes, received %d",
+					node.Block.RandaoReveal,
+					want,
+					node.ParticipatedVotes,
+				)
+			}
+		}
+		if firstResp == nil {
+			firstResp = resp
+			continue
+		}
+		if !proto.Equal(resp, firstResp) {
+			t.Fatalf("Expected block tree to be the same on every request, received %v and %v", firstResp, resp)
+		}
+	}
+}
+
+func TestBlockTree_WarnsPastBreadthThreshold(t *testing.T) {
+	hook := logTest.NewGlobal()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	// Three sibling blocks at slot 1 exceed a breadth threshold of 2.
+	//                   /->[A, Slot 1]->[D, Slot 2]
+	// [Justified Block]->[B, Slot 1]
+	//                   \->[C, Slot 1]
+	justifiedState := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+	}
+	if err := db.SaveJustifiedState(justifiedState); err != nil {
+		t.Fatal(err)
+	}
+	justifiedBlock := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot,
+	}
+	if err := db.SaveJustifiedBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
+	}
+	justifiedRoot, _ := hashutil.HashBeaconBlock(justifiedBlock)
+	blocks := []*pbp2p.BeaconBlock{}
+	for _, reveal := range []string{"A", "B", "C"} {
+		blocks = append(blocks, &pbp2p.BeaconBlock{
+			Slot:             params.BeaconConfig().GenesisSlot + 1,
+			ParentRootHash32: justifiedRoot[:],
+			RandaoReveal:     []byte(reveal),
+		})
+	}
+	rootA, _ := hashutil.HashBeaconBlock(blocks[0])
+	blocks = append(blocks, &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 2,
+		ParentRootHash32: rootA[:],
+		RandaoReveal:     []byte("D"),
+	})
+	for _, blk := range blocks {
+		root, _ := hashutil.HashBeaconBlock(blk)
+		if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{Slot: blk.Slot}, root); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bs := &BeaconServer{
+		beaconDB:           db,
+		targetsFetcher:     &mockChainService{targets: make(map[uint64]*pbp2p.AttestationTarget)},
+		treeBreadthWarning: 3,
+	}
+	if _, err := bs.BlockTree(ctx, &pb.BlockTreeRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsDoNotContain(t, hook, "Block tree is unusually wide")
+
+	bs.treeBreadthWarning = 2
+	resp, err := bs.BlockTree(ctx, &pb.BlockTreeRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Block tree is unusually wide")
+	if len(resp.Tree) != 3 {
+		t.Errorf("Expected the block tree to still be returned with 3 nodes, received %d", len(resp.Tree))
+	}
+}
+
+func TestBlockTreeBySlots_ArgsValildation(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	// We want to ensure that if our block tree looks as follows, the RPC response
+	// returns the correct information.
+	//                   /->[A, Slot 3, 3 Votes]->[B, Slot 4, 3 Votes]
+	// [Justified Block]->[C, Slot 3, 2 Votes]
+	//                   \->[D, Slot 3, 2 Votes]->[SKIP SLOT]->[E, Slot 5, 1 Vote]
+	justifiedState := &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorBalances: make([]uint64, 11),
+	}
+	for i := 0; i < len(justifiedState.ValidatorBalances); i++ {
+		justifiedState.ValidatorBalances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	if err := db.SaveJustifiedState(justifiedState); err != nil {
+		t.Fatal(err)
+	}
+	justifiedBlock := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot,
+	}
+	if err := db.SaveJustifiedBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
+	}
+	justifiedRoot, _ := hashutil.HashBeaconBlock(justifiedBlock)
+	validators := []*pbp2p.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}}
+	balances := []uint64{params.BeaconConfig().MaxDepositAmount}
+	b1 := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 3,
+		ParentRootHash32: justifiedRoot[:],
+		RandaoReveal:     []byte("A"),
+	}
+	b1Root, _ := hashutil.HashBeaconBlock(b1)
+	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 3,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}, b1Root); err != nil {
+		t.Fatal(err)
+	}
+	b2 := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 3,
 		ParentRootHash32: justifiedRoot[:],
 		RandaoReveal:     []byte("C"),
 	}
@@ -1324,76 +2569,2233 @@ func TestBlockTreeBySlots_OK(t *testing.T) {
 			t.Fatal(err)
 		}
 	}
-
 	headState := &pbp2p.BeaconState{
 		Slot: b4.Slot,
 	}
 	if err := db.UpdateChainHead(ctx, b4, headState); err != nil {
 		t.Fatal(err)
 	}
-
 	bs := &BeaconServer{
 		beaconDB:       db,
 		targetsFetcher: &mockChainService{targets: attestationTargets},
 	}
+	if _, err := bs.BlockTreeBySlots(ctx, nil); err == nil {
+		// There should be a "argument 'TreeBlockSlotRequest' cannot be nil" error
+		t.Fatal(err)
+	}
 	slotRange := &pb.TreeBlockSlotRequest{
-		SlotFrom: params.BeaconConfig().GenesisSlot + 3,
-		SlotTo:   params.BeaconConfig().GenesisSlot + 4,
+		SlotFrom: params.BeaconConfig().GenesisSlot + 4,
+		SlotTo:   params.BeaconConfig().GenesisSlot + 3,
 	}
-	resp, err := bs.BlockTreeBySlots(ctx, slotRange)
-	if err != nil {
+	if _, err := bs.BlockTreeBySlots(ctx, slotRange); err == nil {
+		// There should be a 'Upper limit of slot range cannot be lower than the lower limit' error.
 		t.Fatal(err)
 	}
-	if len(resp.Tree) != 2 {
-		t.Logf("Incorrect number of nodes in tree, expected: %d, actual: %d", 2, len(resp.Tree))
-	}
 }
-
-func Benchmark_Eth1Data(b *testing.B) {
-	db := internal.SetupDB(b)
-	defer internal.TeardownDB(b, db)
+func TestBlockTreeBySlots_NilTargetsFetcher(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
 	ctx := context.Background()
 
-	hashesByHeight := make(map[int][]byte)
-
-	beaconState := &pbp2p.BeaconState{
-		Eth1DataVotes: []*pbp2p.Eth1DataVote{},
-		LatestEth1Data: &pbp2p.Eth1Data{
-			BlockHash32: []byte("stub"),
-		},
+	bs := &BeaconServer{beaconDB: db}
+	_, err := bs.BlockTreeBySlots(ctx, &pb.TreeBlockSlotRequest{})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("Expected FailedPrecondition error, received %v", err)
 	}
-	numOfVotes := 1000
-	for i := 0; i < numOfVotes; i++ {
-		blockhash := []byte{'b', 'l', 'o', 'c', 'k', byte(i)}
-		deposit := []byte{'d', 'e', 'p', 'o', 's', 'i', 't', byte(i)}
-		beaconState.Eth1DataVotes = append(beaconState.Eth1DataVotes,
-			&pbp2p.Eth1DataVote{
-				VoteCount: uint64(i),
-				Eth1Data: &pbp2p.Eth1Data{
-					BlockHash32:       blockhash,
-					DepositRootHash32: deposit,
-				},
-			})
-		hashesByHeight[i] = blockhash
+	if !strings.Contains(err.Error(), "attestation targets not yet available") {
+		t.Errorf("Unexpected error message, received %v", err)
 	}
-	hashesByHeight[numOfVotes+1] = []byte("stub")
+}
 
-	if err := db.SaveState(ctx, beaconState); err != nil {
-		b.Fatal(err)
-	}
-	currentHeight := params.BeaconConfig().Eth1FollowDistance + 5
-	beaconServer := &BeaconServer{
-		beaconDB: db,
-		powChainService: &mockPOWChainService{
+func TestBlockTreeBySlots_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	// We want to ensure that if our block tree looks as follows, the RPC response
+	// returns the correct information.
+	//                   /->[A, Slot 3, 3 Votes]->[B, Slot 4, 3 Votes]
+	// [Justified Block]->[C, Slot 3, 2 Votes]
+	//                   \->[D, Slot 3, 2 Votes]->[SKIP SLOT]->[E, Slot 5, 1 Vote]
+	justifiedState := &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorBalances: make([]uint64, 11),
+	}
+	for i := 0; i < len(justifiedState.ValidatorBalances); i++ {
+		justifiedState.ValidatorBalances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	if err := db.SaveJustifiedState(justifiedState); err != nil {
+		t.Fatal(err)
+	}
+	justifiedBlock := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot,
+	}
+	if err := db.SaveJustifiedBlock(justifiedBlock); err != nil {
+		t.Fatal(err)
+	}
+	justifiedRoot, _ := hashutil.HashBeaconBlock(justifiedBlock)
+	validators := []*pbp2p.Validator{{ExitEpoch: params.BeaconConfig().FarFutureEpoch}}
+	balances := []uint64{params.BeaconConfig().MaxDepositAmount}
+	b1 := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 3,
+		ParentRootHash32: justifiedRoot[:],
+		RandaoReveal:     []byte("A"),
+	}
+	b1Root, _ := hashutil.HashBeaconBlock(b1)
+	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 3,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}, b1Root); err != nil {
+		t.Fatal(err)
+	}
+	b2 := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 3,
+		ParentRootHash32: justifiedRoot[:],
+		RandaoReveal:     []byte("C"),
+	}
+	b2Root, _ := hashutil.HashBeaconBlock(b2)
+	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 3,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}, b2Root); err != nil {
+		t.Fatal(err)
+	}
+	b3 := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 3,
+		ParentRootHash32: justifiedRoot[:],
+		RandaoReveal:     []byte("D"),
+	}
+	b3Root, _ := hashutil.HashBeaconBlock(b3)
+	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 3,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}, b3Root); err != nil {
+		t.Fatal(err)
+	}
+	b4 := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 4,
+		ParentRootHash32: b1Root[:],
+		RandaoReveal:     []byte("B"),
+	}
+	b4Root, _ := hashutil.HashBeaconBlock(b4)
+	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 4,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}, b4Root); err != nil {
+		t.Fatal(err)
+	}
+	b5 := &pbp2p.BeaconBlock{
+		Slot:             params.BeaconConfig().GenesisSlot + 5,
+		ParentRootHash32: b3Root[:],
+		RandaoReveal:     []byte("E"),
+	}
+	b5Root, _ := hashutil.HashBeaconBlock(b5)
+	if err := db.SaveHistoricalState(ctx, &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot + 5,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}, b5Root); err != nil {
+		t.Fatal(err)
+	}
+	attestationTargets := make(map[uint64]*pbp2p.AttestationTarget)
+	// We give block A 3 votes.
+	attestationTargets[0] = &pbp2p.AttestationTarget{
+		Slot:       b1.Slot,
+		ParentRoot: b1.ParentRootHash32,
+		BlockRoot:  b1Root[:],
+	}
+	attestationTargets[1] = &pbp2p.AttestationTarget{
+		Slot:       b1.Slot,
+		ParentRoot: b1.ParentRootHash32,
+		BlockRoot:  b1Root[:],
+	}
+	attestationTargets[2] = &pbp2p.AttestationTarget{
+		Slot:       b1.Slot,
+		ParentRoot: b1.ParentRootHash32,
+		BlockRoot:  b1Root[:],
+	}
+
+	// We give block C 2 votes.
+	attestationTargets[3] = &pbp2p.AttestationTarget{
+		Slot:       b2.Slot,
+		ParentRoot: b2.ParentRootHash32,
+		BlockRoot:  b2Root[:],
+	}
+	attestationTargets[4] = &pbp2p.AttestationTarget{
+		Slot:       b2.Slot,
+		ParentRoot: b2.ParentRootHash32,
+		BlockRoot:  b2Root[:],
+	}
+
+	// We give block D 2 votes.
+	attestationTargets[5] = &pbp2p.AttestationTarget{
+		Slot:       b3.Slot,
+		ParentRoot: b3.ParentRootHash32,
+		BlockRoot:  b3Root[:],
+	}
+	attestationTargets[6] = &pbp2p.AttestationTarget{
+		Slot:       b3.Slot,
+		ParentRoot: b3.ParentRootHash32,
+		BlockRoot:  b3Root[:],
+	}
+
+	// We give block B 3 votes.
+	attestationTargets[7] = &pbp2p.AttestationTarget{
+		Slot:       b4.Slot,
+		ParentRoot: b4.ParentRootHash32,
+		BlockRoot:  b4Root[:],
+	}
+	attestationTargets[8] = &pbp2p.AttestationTarget{
+		Slot:       b4.Slot,
+		ParentRoot: b4.ParentRootHash32,
+		BlockRoot:  b4Root[:],
+	}
+	attestationTargets[9] = &pbp2p.AttestationTarget{
+		Slot:       b4.Slot,
+		ParentRoot: b4.ParentRootHash32,
+		BlockRoot:  b4Root[:],
+	}
+
+	// We give block E 1 vote.
+	attestationTargets[10] = &pbp2p.AttestationTarget{
+		Slot:       b5.Slot,
+		ParentRoot: b5.ParentRootHash32,
+		BlockRoot:  b5Root[:],
+	}
+
+	tree := []*pb.BlockTreeResponse_TreeNode{
+		{
+			Block:             b1,
+			ParticipatedVotes: 3 * params.BeaconConfig().MaxDepositAmount,
+			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
+		},
+		{
+			Block:             b2,
+			ParticipatedVotes: 2 * params.BeaconConfig().MaxDepositAmount,
+			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
+		},
+		{
+			Block:             b3,
+			ParticipatedVotes: 2 * params.BeaconConfig().MaxDepositAmount,
+			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
+		},
+		{
+			Block:             b4,
+			ParticipatedVotes: 3 * params.BeaconConfig().MaxDepositAmount,
+			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
+		},
+		{
+			Block:             b5,
+			ParticipatedVotes: 1 * params.BeaconConfig().MaxDepositAmount,
+			TotalVotes:        params.BeaconConfig().MaxDepositAmount,
+		},
+	}
+	for _, node := range tree {
+		if err := db.SaveBlock(node.Block); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	headState := &pbp2p.BeaconState{
+		Slot: b4.Slot,
+	}
+	if err := db.UpdateChainHead(ctx, b4, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{
+		beaconDB:       db,
+		targetsFetcher: &mockChainService{targets: attestationTargets},
+	}
+	slotRange := &pb.TreeBlockSlotRequest{
+		SlotFrom: params.BeaconConfig().GenesisSlot + 3,
+		SlotTo:   params.BeaconConfig().GenesisSlot + 4,
+	}
+	resp, err := bs.BlockTreeBySlots(ctx, slotRange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Tree) != 2 {
+		t.Logf("Incorrect number of nodes in tree, expected: %d, actual: %d", 2, len(resp.Tree))
+	}
+}
+
+func TestDetectedSlashings_NoneDetected(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveBlock(&pbp2p.BeaconBlock{Slot: params.BeaconConfig().GenesisSlot + 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.DetectedSlashings(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Slashings == nil || len(resp.Slashings) != 0 {
+		t.Errorf("Expected an empty list of slashings, received %v", resp.Slashings)
+	}
+}
+
+func TestDetectedSlashings_DoubleProposal(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	slot := params.BeaconConfig().GenesisSlot + 1
+	for _, parentRoot := range [][]byte{[]byte("A"), []byte("B")} {
+		if err := db.SaveBlock(&pbp2p.BeaconBlock{Slot: slot, ParentRootHash32: parentRoot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.DetectedSlashings(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Slashings) != 1 {
+		t.Fatalf("Expected 1 detected slashing, received %d", len(resp.Slashings))
+	}
+	wanted := &pb.DetectedSlashingsResponse_DetectedSlashing{
+		ValidatorIndex: proposerIdx,
+		Offense:        pb.SlashingOffense_DOUBLE_PROPOSAL,
+		Slot:           slot,
+	}
+	if !proto.Equal(resp.Slashings[0], wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, resp.Slashings[0])
+	}
+}
+
+func TestDetectedSlashings_DoubleVote(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	slot := params.BeaconConfig().GenesisSlot + 2
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0].Committee
+	bitfield, err := bitutil.SetBitfield(0, len(committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, blockRoot := range [][]byte{[]byte("A"), []byte("B")} {
+		att := &pbp2p.Attestation{
+			Data: &pbp2p.AttestationData{
+				Slot:                  slot,
+				Shard:                 committees[0].Shard,
+				BeaconBlockRootHash32: blockRoot,
+			},
+			AggregationBitfield: bitfield,
+		}
+		if err := db.SaveAttestation(ctx, att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.DetectedSlashings(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Slashings) != 1 {
+		t.Fatalf("Expected 1 detected slashing, received %d", len(resp.Slashings))
+	}
+	wanted := &pb.DetectedSlashingsResponse_DetectedSlashing{
+		ValidatorIndex: committee[0],
+		Offense:        pb.SlashingOffense_DOUBLE_VOTE,
+		Slot:           slot,
+	}
+	if !proto.Equal(resp.Slashings[0], wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, resp.Slashings[0])
+	}
+}
+
+func TestEpochAttestationStats_PopulatedEpoch(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	sb, err := backend.NewSimulatedBackend()
+	if err != nil {
+		t.Fatalf("Could not create a new simulated backend %v", err)
+	}
+	defer internal.TeardownDB(t, sb.DB())
+	privKeys, err := sb.SetupBackend(100)
+	if err != nil {
+		t.Fatalf("Could not set up backend %v", err)
+	}
+	for i := uint64(0); i < params.BeaconConfig().SlotsPerEpoch; i++ {
+		if err := sb.GenerateBlockAndAdvanceChain(&backend.SimulatedObjects{}, privKeys); err != nil {
+			t.Fatalf("Could not generate block and advance chain %v", err)
+		}
+	}
+
+	data1 := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot, Shard: 1}
+	data2 := &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot + 1, Shard: 2}
+	attestations := map[uint64][]*pbp2p.Attestation{
+		params.BeaconConfig().GenesisSlot + 1: {
+			{Data: data1, AggregationBitfield: []byte{0xC0}},
+		},
+		params.BeaconConfig().GenesisSlot + 2: {
+			{Data: data1, AggregationBitfield: []byte{0x20}},
+			{Data: data2, AggregationBitfield: []byte{0xF0}},
+		},
+	}
+	for _, blk := range sb.InMemoryBlocks() {
+		blk.Body.Attestations = append(blk.Body.Attestations, attestations[blk.Slot]...)
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.EpochAttestationStats(ctx, &pb.EpochAttestationStatsRequest{
+		Epoch: params.BeaconConfig().GenesisEpoch,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &pb.EpochAttestationStatsResponse{
+		BlockCount:             params.BeaconConfig().SlotsPerEpoch,
+		AttestationCount:       3,
+		AverageAggregationBits: float32(7) / float32(3),
+		DistinctDataRoots:      2,
+	}
+	if !proto.Equal(resp, wanted) {
+		t.Errorf("Wanted epoch attestation stats %v, received %v", wanted, resp)
+	}
+}
+
+func TestEpochAttestationStats_BlocksNotAvailable(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveBlock(&pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot + 1,
+		Body: &pbp2p.BeaconBlockBody{},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	want := "blocks for epoch 1 are not available"
+	if _, err := bs.EpochAttestationStats(ctx, &pb.EpochAttestationStatsRequest{
+		Epoch: params.BeaconConfig().GenesisEpoch + 1,
+	}); err == nil || err.Error() != want {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
+func TestBeaconCommittee_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch * 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	slot := params.BeaconConfig().GenesisSlot + params.BeaconConfig().SlotsPerEpoch + 2
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	for i, wanted := range committees {
+		resp, err := bs.BeaconCommittee(ctx, &pb.BeaconCommitteeRequest{
+			Slot:           slot,
+			CommitteeIndex: uint64(i),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(resp.Committee, wanted.Committee) {
+			t.Errorf("Wanted committee %v at index %d, received %v", wanted.Committee, i, resp.Committee)
+		}
+		if resp.Shard != wanted.Shard {
+			t.Errorf("Wanted shard %d at index %d, received %d", wanted.Shard, i, resp.Shard)
+		}
+	}
+}
+
+func TestBeaconCommittee_SlotOutsideLookahead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	_, err = bs.BeaconCommittee(ctx, &pb.BeaconCommitteeRequest{
+		Slot: params.BeaconConfig().GenesisSlot + 2*params.BeaconConfig().SlotsPerEpoch,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument error, received %v", err)
+	}
+	want := fmt.Sprintf("slot %d is outside of the committee lookahead", 2*params.BeaconConfig().SlotsPerEpoch)
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %q, received %v", want, err)
+	}
+}
+
+func TestBeaconCommittee_CommitteeIndexOutOfRange(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	_, err = bs.BeaconCommittee(ctx, &pb.BeaconCommitteeRequest{
+		Slot:           params.BeaconConfig().GenesisSlot + 1,
+		CommitteeIndex: 1,
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument error, received %v", err)
+	}
+	want := "committee index 1 out of range, slot 1 has 1 committees"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %q, received %v", want, err)
+	}
+}
+
+func Benchmark_Eth1Data(b *testing.B) {
+	db := internal.SetupDBWithStorage(b, db.MemoryStorage)
+	defer internal.TeardownDB(b, db)
+	ctx := context.Background()
+
+	hashesByHeight := make(map[int][]byte)
+
+	beaconState := &pbp2p.BeaconState{
+		Eth1DataVotes: []*pbp2p.Eth1DataVote{},
+		LatestEth1Data: &pbp2p.Eth1Data{
+			BlockHash32: []byte("stub"),
+		},
+	}
+	numOfVotes := 1000
+	for i := 0; i < numOfVotes; i++ {
+		blockhash := []byte{'b', 'l', 'o', 'c', 'k', byte(i)}
+		deposit := []byte{'d', 'e', 'p', 'o', 's', 'i', 't', byte(i)}
+		beaconState.Eth1DataVotes = append(beaconState.Eth1DataVotes,
+			&pbp2p.Eth1DataVote{
+				VoteCount: uint64(i),
+				Eth1Data: &pbp2p.Eth1Data{
+					BlockHash32:       blockhash,
+					DepositRootHash32: deposit,
+				},
+			})
+		hashesByHeight[i] = blockhash
+	}
+	hashesByHeight[numOfVotes+1] = []byte("stub")
+
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		b.Fatal(err)
+	}
+	currentHeight := params.BeaconConfig().Eth1FollowDistance + 5
+	beaconServer := &BeaconServer{
+		beaconDB: db,
+		powChainService: &mockPOWChainService{
 			latestBlockNumber: big.NewInt(int64(currentHeight)),
 			hashesByHeight:    hashesByHeight,
 		},
 	}
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
-		_, err := beaconServer.Eth1Data(context.Background(), nil)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, err := beaconServer.Eth1Data(context.Background(), nil)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestEth1DataVotes_SortedByVoteCount(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	votes := []*pbp2p.Eth1DataVote{
+		{Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte("a")}, VoteCount: 1},
+		{Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte("b")}, VoteCount: 5},
+		{Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte("c")}, VoteCount: 3},
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Eth1DataVotes: votes}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.Eth1DataVotes(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []*pbp2p.Eth1DataVote{votes[1], votes[2], votes[0]}
+	if len(resp.Eth1DataVotes) != len(wanted) {
+		t.Fatalf("Wanted %d votes, received %d", len(wanted), len(resp.Eth1DataVotes))
+	}
+	for i := range wanted {
+		if !proto.Equal(resp.Eth1DataVotes[i], wanted[i]) {
+			t.Errorf("Wanted vote %v at index %d, received %v", wanted[i], i, resp.Eth1DataVotes[i])
+		}
+	}
+}
+
+func TestEth1DataVotes_NoVotes(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.Eth1DataVotes(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Eth1DataVotes) != 0 {
+		t.Errorf("Wanted no votes, received %v", resp.Eth1DataVotes)
+	}
+}
+
+func TestNextEth1VotingPeriod_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	periodSlots := params.BeaconConfig().EpochsPerEth1VotingPeriod * params.BeaconConfig().SlotsPerEpoch
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	genesisTime := uint64(1000)
+	tests := []struct {
+		slot uint64
+		want *pb.Eth1VotingPeriodResponse
+	}{
+		{
+			slot: genesisSlot,
+			want: &pb.Eth1VotingPeriodResponse{
+				CurrentPeriod: 0,
+				PeriodEndSlot: genesisSlot + periodSlots - 1,
+				PeriodEndTime: genesisTime + (periodSlots-1)*secondsPerSlot,
+			},
+		},
+		{
+			slot: genesisSlot + periodSlots - 2,
+			want: &pb.Eth1VotingPeriodResponse{
+				CurrentPeriod: 0,
+				PeriodEndSlot: genesisSlot + periodSlots - 1,
+				PeriodEndTime: genesisTime + (periodSlots-1)*secondsPerSlot,
+			},
+		},
+		{
+			// The votes of the first period were reset when processing the boundary slot.
+			slot: genesisSlot + periodSlots - 1,
+			want: &pb.Eth1VotingPeriodResponse{
+				CurrentPeriod: 1,
+				PeriodEndSlot: genesisSlot + 2*periodSlots - 1,
+				PeriodEndTime: genesisTime + (2*periodSlots-1)*secondsPerSlot,
+			},
+		},
+		{
+			slot: genesisSlot + periodSlots,
+			want: &pb.Eth1VotingPeriodResponse{
+				CurrentPeriod: 1,
+				PeriodEndSlot: genesisSlot + 2*periodSlots - 1,
+				PeriodEndTime: genesisTime + (2*periodSlots-1)*secondsPerSlot,
+			},
+		},
+	}
+	bs := &BeaconServer{beaconDB: db}
+	for _, tt := range tests {
+		blk := &pbp2p.BeaconBlock{Slot: tt.slot}
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		beaconState := &pbp2p.BeaconState{Slot: tt.slot, GenesisTime: genesisTime}
+		if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := bs.NextEth1VotingPeriod(ctx, &ptypes.Empty{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(resp, tt.want) {
+			t.Errorf("Slot %d: wanted %v, received %v", tt.slot-genesisSlot, tt.want, resp)
+		}
+	}
+}
+
+func TestEth1VoteCandidates_OrderedByHeight(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	vote := func(hash string, count uint64) *pbp2p.Eth1DataVote {
+		return &pbp2p.Eth1DataVote{
+			VoteCount: count,
+			Eth1Data:  &pbp2p.Eth1Data{BlockHash32: []byte(hash), DepositRootHash32: []byte("deposit")},
+		}
+	}
+	beaconState := &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+		Eth1DataVotes: []*pbp2p.Eth1DataVote{
+			vote("block3", 1),
+			// Blocks which are not newer than the latest eth1 data, within the follow distance
+			// or unknown to the eth1 chain are not candidates.
+			vote("latest", 4),
+			vote("recent", 5),
+			vote("unknown", 6),
+			vote("block2", 2),
+			vote("ancestor", 3),
+		},
+		LatestEth1Data: &pbp2p.Eth1Data{BlockHash32: []byte("latest")},
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	followDistance := params.BeaconConfig().Eth1FollowDistance
+	currentHeight := followDistance + 5
+	bs := &BeaconServer{
+		beaconDB: db,
+		powChainService: &mockPOWChainService{
+			latestBlockNumber: new(big.Int).SetUint64(currentHeight),
+			hashesByHeight: map[int][]byte{
+				1:                      []byte("latest"),
+				2:                      []byte("block2"),
+				3:                      []byte("block3"),
+				5:                      []byte("ancestor"),
+				int(currentHeight - 1): []byte("recent"),
+			},
+		},
+	}
+	res, err := bs.Eth1VoteCandidates(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockHash := func(hash string) []byte {
+		h := bytesutil.ToBytes32([]byte(hash))
+		return h[:]
+	}
+	want := &pb.Eth1VoteCandidatesResponse{
+		Candidates: []*pb.Eth1VoteCandidatesResponse_Candidate{
+			{BlockHash: blockHash("block2"), Height: 2, VoteCount: 2},
+			{BlockHash: blockHash("block3"), Height: 3, VoteCount: 1},
+			{BlockHash: blockHash("ancestor"), Height: 5, VoteCount: 3, FollowDistanceAncestor: true},
+		},
+		VotingPeriod: 0,
+		MinHeight:    1,
+		MaxHeight:    5,
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted candidates %v, received %v", want, res)
+	}
+}
+
+func TestEth1VoteCandidates_NoLatestEth1Data(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState := &pbp2p.BeaconState{
+		Slot:           params.BeaconConfig().GenesisSlot,
+		Eth1DataVotes:  []*pbp2p.Eth1DataVote{{VoteCount: 1, Eth1Data: &pbp2p.Eth1Data{BlockHash32: []byte("block1")}}},
+		LatestEth1Data: &pbp2p.Eth1Data{},
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	currentHeight := params.BeaconConfig().Eth1FollowDistance + 2
+	bs := &BeaconServer{
+		beaconDB: db,
+		powChainService: &mockPOWChainService{
+			latestBlockNumber: new(big.Int).SetUint64(currentHeight),
+			hashesByHeight: map[int][]byte{
+				1: []byte("block1"),
+				2: []byte("ancestor"),
+			},
+		},
+	}
+	res, err := bs.Eth1VoteCandidates(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Candidates) != 1 || !res.Candidates[0].FollowDistanceAncestor || res.Candidates[0].Height != 2 {
+		t.Errorf("Expected only the follow distance ancestor at height 2 as candidate, received %v", res.Candidates)
+	}
+}
+
+func TestBlocksBySlot_MarksCanonicalBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	slot := params.BeaconConfig().GenesisSlot + 1
+	canonical := &pbp2p.BeaconBlock{Slot: slot, ParentRootHash32: []byte("A")}
+	fork := &pbp2p.BeaconBlock{Slot: slot, ParentRootHash32: []byte("B")}
+	for _, blk := range []*pbp2p.BeaconBlock{canonical, fork} {
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.UpdateChainHead(ctx, canonical, &pbp2p.BeaconState{Slot: slot}); err != nil {
+		t.Fatal(err)
+	}
+	canonicalRoot, err := hashutil.HashBeaconBlock(canonical)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.BlocksBySlot(ctx, &pb.BlocksBySlotRequest{Slot: slot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Blocks) != 2 {
+		t.Fatalf("Expected 2 blocks, received %d", len(resp.Blocks))
+	}
+	for _, blk := range resp.Blocks {
+		isCanonical := bytes.Equal(blk.BlockRoot, canonicalRoot[:])
+		if blk.Canonical != isCanonical {
+			t.Errorf("Block with root %#x has canonical %v, wanted %v", blk.BlockRoot, blk.Canonical, isCanonical)
+		}
+	}
+}
+
+func TestBlocksBySlot_NoBlocks(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.BlocksBySlot(ctx, &pb.BlocksBySlotRequest{Slot: params.BeaconConfig().GenesisSlot + 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Blocks) != 0 {
+		t.Errorf("Wanted no blocks, received %v", resp.Blocks)
+	}
+}
+
+func TestBlockOperationCounts_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	blk := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot + 1,
+		Body: &pbp2p.BeaconBlockBody{
+			Deposits:          []*pbp2p.Deposit{{MerkleTreeIndex: 1}},
+			Attestations:      []*pbp2p.Attestation{{AggregationBitfield: []byte{0x80}}, {AggregationBitfield: []byte{0x40}}},
+			ProposerSlashings: []*pbp2p.ProposerSlashing{{ProposerIndex: 2}},
+			AttesterSlashings: []*pbp2p.AttesterSlashing{{}, {}, {}},
+			VoluntaryExits:    []*pbp2p.VoluntaryExit{{ValidatorIndex: 4}},
+		},
+	}
+	if err := db.SaveBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	root, err := hashutil.HashBeaconBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.BlockOperationCounts(ctx, &pb.BlockByRootRequest{BlockRoot: root[:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.BlockOperationCountsResponse{
+		Deposits:          1,
+		Attestations:      2,
+		ProposerSlashings: 1,
+		AttesterSlashings: 3,
+		VoluntaryExits:    1,
+	}
+	if !proto.Equal(resp, want) {
+		t.Errorf("Wanted %v, received %v", want, resp)
+	}
+}
+
+func TestBlockOperationCounts_UnknownRoot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	_, err := bs.BlockOperationCounts(ctx, &pb.BlockByRootRequest{BlockRoot: []byte("unknown")})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected NotFound error, received %v", err)
+	}
+}
+
+func TestBlockAttestations_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	atts := []*pbp2p.Attestation{
+		{Data: &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot, Shard: 1}, AggregationBitfield: []byte{0xC0, 0x01}},
+		{Data: &pbp2p.AttestationData{Slot: params.BeaconConfig().GenesisSlot, Shard: 2}, AggregationBitfield: []byte{0x80}},
+	}
+	blk := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot + 1,
+		Body: &pbp2p.BeaconBlockBody{Attestations: atts},
+	}
+	emptyBlk := &pbp2p.BeaconBlock{
+		Slot: params.BeaconConfig().GenesisSlot + 2,
+		Body: &pbp2p.BeaconBlockBody{},
+	}
+	bs := &BeaconServer{beaconDB: db}
+	tests := []struct {
+		blk  *pbp2p.BeaconBlock
+		want []*pb.BlockAttestationsResponse_IncludedAttestation
+	}{
+		{
+			blk: blk,
+			want: []*pb.BlockAttestationsResponse_IncludedAttestation{
+				{Attestation: atts[0], AggregationBitCount: 3},
+				{Attestation: atts[1], AggregationBitCount: 1},
+			},
+		},
+		{
+			blk:  emptyBlk,
+			want: []*pb.BlockAttestationsResponse_IncludedAttestation{},
+		},
+	}
+	for _, tt := range tests {
+		if err := db.SaveBlock(tt.blk); err != nil {
+			t.Fatal(err)
+		}
+		root, err := hashutil.HashBeaconBlock(tt.blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := bs.BlockAttestations(ctx, &pb.BlockByRootRequest{BlockRoot: root[:]})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Attestations == nil {
+			t.Error("Expected an empty rather than nil list of attestations")
+		}
+		if !proto.Equal(resp, &pb.BlockAttestationsResponse{Attestations: tt.want}) {
+			t.Errorf("Wanted attestations %v, received %v", tt.want, resp.Attestations)
+		}
+	}
+}
+
+func TestBlockAttestations_UnknownRoot(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	bs := &BeaconServer{beaconDB: db}
+	_, err := bs.BlockAttestations(ctx, &pb.BlockByRootRequest{BlockRoot: []byte("unknown")})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("Expected NotFound error, received %v", err)
+	}
+}
+
+func TestActiveBalance_HistoricalAndHeadState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot: helpers.StartSlot(genesisEpoch),
+		ValidatorRegistry: []*pbp2p.Validator{
+			{ActivationEpoch: genesisEpoch, ExitEpoch: farFuture},
+			{ActivationEpoch: genesisEpoch + 1, ExitEpoch: farFuture},
+		},
+		ValidatorBalances: []uint64{maxDeposit, maxDeposit},
+	})
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot: helpers.StartSlot(genesisEpoch + 2),
+		ValidatorRegistry: []*pbp2p.Validator{
+			{ActivationEpoch: genesisEpoch, ExitEpoch: farFuture},
+			{ActivationEpoch: genesisEpoch + 1, ExitEpoch: farFuture},
+			{ActivationEpoch: genesisEpoch, ExitEpoch: genesisEpoch + 1},
+		},
+		ValidatorBalances: []uint64{maxDeposit + 1, maxDeposit / 2, maxDeposit},
+	})
+
+	bs := &BeaconServer{beaconDB: db}
+	tests := []struct {
+		epoch uint64
+		want  *pb.ActiveBalanceResponse
+	}{
+		{
+			epoch: genesisEpoch,
+			want:  &pb.ActiveBalanceResponse{TotalActiveBalance: maxDeposit, ActiveValidatorCount: 1},
+		},
+		{
+			epoch: genesisEpoch + 2,
+			want:  &pb.ActiveBalanceResponse{TotalActiveBalance: maxDeposit + maxDeposit/2, ActiveValidatorCount: 2},
+		},
+	}
+	for _, tt := range tests {
+		resp, err := bs.ActiveBalance(ctx, &pb.ActiveBalanceRequest{Epoch: tt.epoch})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(resp, tt.want) {
+			t.Errorf("Epoch %d: wanted %v, received %v", tt.epoch-genesisEpoch, tt.want, resp)
+		}
+	}
+}
+
+func TestActiveBalance_UnavailableEpochs(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot: helpers.StartSlot(genesisEpoch + 2),
+	})
+
+	bs := &BeaconServer{beaconDB: db}
+	if _, err := bs.ActiveBalance(ctx, &pb.ActiveBalanceRequest{Epoch: genesisEpoch + 3}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error for a future epoch, received %v", err)
+	}
+	_, err := bs.ActiveBalance(ctx, &pb.ActiveBalanceRequest{Epoch: genesisEpoch + 1})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound error for an epoch without a saved state, received %v", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "no state available for epoch 1") {
+		t.Errorf("Expected missing state error, received %v", err)
+	}
+}
+
+func TestActiveValidators_Paginated(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot: helpers.StartSlot(genesisEpoch),
+		ValidatorRegistry: []*pbp2p.Validator{
+			{ActivationEpoch: genesisEpoch, ExitEpoch: farFuture},
+			{ActivationEpoch: genesisEpoch + 1, ExitEpoch: farFuture},
+			{ActivationEpoch: genesisEpoch, ExitEpoch: farFuture},
+		},
+	})
+	validators := make([]*pbp2p.Validator, 5)
+	for i := range validators {
+		validators[i] = &pbp2p.Validator{ActivationEpoch: genesisEpoch, ExitEpoch: farFuture}
+	}
+	validators[1].ExitEpoch = genesisEpoch + 1
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot:              helpers.StartSlot(genesisEpoch + 1),
+		ValidatorRegistry: validators,
+	})
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.ActiveValidators(ctx, &pb.ActiveValidatorsRequest{Epoch: genesisEpoch})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.ActiveValidatorsResponse{ValidatorIndices: []uint64{0, 2}, TotalSize: 2}
+	if !proto.Equal(resp, want) {
+		t.Errorf("Wanted %v for the historical epoch, received %v", want, resp)
+	}
+
+	var indices []uint64
+	req := &pb.ActiveValidatorsRequest{Epoch: genesisEpoch + 1, PageSize: 3}
+	for pages := 1; ; pages++ {
+		resp, err := bs.ActiveValidators(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.TotalSize != 4 {
+			t.Errorf("Wanted total size 4, received %d", resp.TotalSize)
+		}
+		indices = append(indices, resp.ValidatorIndices...)
+		if resp.NextPageToken == "" {
+			if pages != 2 {
+				t.Errorf("Wanted 2 pages, received %d", pages)
+			}
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if !reflect.DeepEqual(indices, []uint64{0, 2, 3, 4}) {
+		t.Errorf("Wanted active indices [0 2 3 4], received %v", indices)
+	}
+}
+
+func TestActiveValidators_UnavailableEpochs(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot: helpers.StartSlot(genesisEpoch + 2),
+	})
+
+	bs := &BeaconServer{beaconDB: db}
+	if _, err := bs.ActiveValidators(ctx, &pb.ActiveValidatorsRequest{Epoch: genesisEpoch + 3}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error for a future epoch, received %v", err)
+	}
+	if _, err := bs.ActiveValidators(ctx, &pb.ActiveValidatorsRequest{Epoch: genesisEpoch + 1}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound error for an epoch without a saved state, received %v", err)
+	}
+}
+
+func TestJustifiedCheckpointHistory_SkipsMissingStates(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot:           helpers.StartSlot(genesisEpoch),
+		JustifiedEpoch: genesisEpoch,
+		JustifiedRoot:  []byte("genesis"),
+	})
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot:           helpers.StartSlot(genesisEpoch + 2),
+		JustifiedEpoch: genesisEpoch + 1,
+		JustifiedRoot:  []byte("epoch 1"),
+	})
+	// A canonical block at the start of epoch 3 without a saved state.
+	blk := &pbp2p.BeaconBlock{Slot: helpers.StartSlot(genesisEpoch + 3)}
+	if err := db.SaveBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, blk, &pbp2p.BeaconState{Slot: blk.Slot}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.JustifiedCheckpointHistory(ctx, &pb.JustifiedHistoryRequest{
+		StartEpoch: genesisEpoch,
+		EndEpoch:   genesisEpoch + 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.JustifiedHistoryResponse{
+		Checkpoints: []*pb.JustifiedHistoryResponse_EpochCheckpoint{
+			{Epoch: genesisEpoch, JustifiedEpoch: genesisEpoch, JustifiedRoot: []byte("genesis")},
+			{Epoch: genesisEpoch + 2, JustifiedEpoch: genesisEpoch + 1, JustifiedRoot: []byte("epoch 1")},
+		},
+	}
+	if !proto.Equal(resp, want) {
+		t.Errorf("Wanted %v, received %v", want, resp)
+	}
+}
+
+func TestJustifiedCheckpointHistory_ArgsValidation(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	bs := &BeaconServer{beaconDB: db}
+	tests := []*pb.JustifiedHistoryRequest{
+		{StartEpoch: genesisEpoch + 5, EndEpoch: genesisEpoch + 4},
+		{StartEpoch: genesisEpoch, EndEpoch: genesisEpoch + maxJustifiedHistorySpan},
+	}
+	for _, req := range tests {
+		if _, err := bs.JustifiedCheckpointHistory(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument error for range %d-%d, received %v", req.StartEpoch-genesisEpoch, req.EndEpoch-genesisEpoch, err)
+		}
+	}
+}
+
+func TestSkippedSlots_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	for _, slot := range []uint64{genesisSlot + 1, genesisSlot + 2, genesisSlot + 4} {
+		blk := &pbp2p.BeaconBlock{Slot: slot}
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, blk, &pbp2p.BeaconState{Slot: slot}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A block at slot 3 which is not part of the canonical chain.
+	if err := db.SaveBlock(&pbp2p.BeaconBlock{Slot: genesisSlot + 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.SkippedSlots(ctx, &pb.SkippedSlotsRequest{
+		SlotFrom: genesisSlot + 1,
+		SlotTo:   genesisSlot + 10,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uint64{genesisSlot + 3}
+	if !reflect.DeepEqual(resp.Slots, want) {
+		t.Errorf("Wanted skipped slots %v, received %v", want, resp.Slots)
+	}
+}
+
+func TestSkippedSlots_ArgsValidation(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	bs := &BeaconServer{beaconDB: db}
+	tests := []*pb.SkippedSlotsRequest{
+		{SlotFrom: genesisSlot + 5, SlotTo: genesisSlot + 4},
+		{SlotFrom: genesisSlot, SlotTo: genesisSlot + maxSkippedSlotsSpan},
+	}
+	for _, req := range tests {
+		if _, err := bs.SkippedSlots(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument error for range %d-%d, received %v", req.SlotFrom-genesisSlot, req.SlotTo-genesisSlot, err)
+		}
+	}
+}
+
+func TestHistoricalBlockRoots_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	rootsLength := params.BeaconConfig().LatestBlockRootsLength
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	headSlot := genesisSlot + rootsLength + 10
+	blockRoots := make([][]byte, rootsLength)
+	for i := range blockRoots {
+		blockRoots[i] = []byte{byte(i), byte(i >> 8)}
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot:                   headSlot,
+		LatestBlockRootHash32S: blockRoots,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	// The range wraps around the end of the block roots of the state.
+	slotFrom := headSlot - 12
+	res, err := bs.HistoricalBlockRoots(ctx, &pb.HistoricalBlockRootsRequest{
+		SlotFrom: slotFrom,
+		SlotTo:   headSlot - 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.BlockRoots) != 12 {
+		t.Fatalf("Wanted 12 block roots, received %d", len(res.BlockRoots))
+	}
+	for i, root := range res.BlockRoots {
+		slot := slotFrom + uint64(i)
+		if root.Slot != slot {
+			t.Errorf("Wanted block root %d at slot %d, received slot %d", i, slot-genesisSlot, root.Slot-genesisSlot)
+		}
+		if want := blockRoots[slot%rootsLength]; !bytes.Equal(root.BlockRoot, want) {
+			t.Errorf("Wanted block root %#x at slot %d, received %#x", want, slot-genesisSlot, root.BlockRoot)
+		}
+	}
+}
+
+func TestHistoricalBlockRoots_ArgsValidation(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	rootsLength := params.BeaconConfig().LatestBlockRootsLength
+	headSlot := params.BeaconConfig().GenesisSlot + rootsLength + 10
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot:                   headSlot,
+		LatestBlockRootHash32S: make([][]byte, rootsLength),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	tests := []*pb.HistoricalBlockRootsRequest{
+		{SlotFrom: headSlot - 4, SlotTo: headSlot - 5},
+		// The root of the head slot itself is not known to the state yet.
+		{SlotFrom: headSlot - 4, SlotTo: headSlot},
+		// The root of the slot is no longer retained.
+		{SlotFrom: headSlot - rootsLength - 1, SlotTo: headSlot - 1},
+	}
+	for _, req := range tests {
+		if _, err := bs.HistoricalBlockRoots(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf(
+				"Expected InvalidArgument error for range %d-%d, received %v",
+				req.SlotFrom-params.BeaconConfig().GenesisSlot,
+				req.SlotTo-params.BeaconConfig().GenesisSlot,
+				err,
+			)
+		}
+	}
+}
+
+func TestHistoricalBlockRoots_NoBeaconState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	bs := &BeaconServer{beaconDB: db}
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	req := &pb.HistoricalBlockRootsRequest{SlotFrom: genesisSlot, SlotTo: genesisSlot}
+	if _, err := bs.HistoricalBlockRoots(context.Background(), req); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition error, received %v", err)
+	}
+}
+
+func TestSlotAttestationCoverage_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch * 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attSlot := params.BeaconConfig().GenesisSlot + 1
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attSlot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0]
+	if len(committee.Committee) < 2 {
+		t.Fatalf("Expected a committee of at least 2 validators, received %d", len(committee.Committee))
+	}
+	firstMember, err := bitutil.SetBitfield(0, len(committee.Committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondMember, err := bitutil.SetBitfield(1, len(committee.Committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestation := func(bitfield []byte) *pbp2p.Attestation {
+		return &pbp2p.Attestation{
+			Data:                &pbp2p.AttestationData{Slot: attSlot, Shard: committee.Shard},
+			AggregationBitfield: bitfield,
+		}
+	}
+	// The first member is included twice but must only be counted once.
+	blockAttestations := [][]*pbp2p.Attestation{
+		{attestation(firstMember)},
+		{attestation(firstMember), attestation(secondMember)},
+	}
+	for i, atts := range blockAttestations {
+		slot := attSlot + uint64(i) + 1
+		blk := &pbp2p.BeaconBlock{Slot: slot, Body: &pbp2p.BeaconBlockBody{Attestations: atts}}
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		beaconState.Slot = slot
+		if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.SlotAttestationCoverage(ctx, &pb.SlotCoverageRequest{Slot: attSlot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Committees) != len(committees) {
+		t.Fatalf("Expected coverage of %d committees, received %d", len(committees), len(resp.Committees))
+	}
+	want := &pb.SlotCoverageResponse_CommitteeCoverage{
+		Shard:           committee.Shard,
+		CommitteeSize:   uint64(len(committee.Committee)),
+		IncludedMembers: 2,
+		Coverage:        2 / float32(len(committee.Committee)),
+	}
+	if !proto.Equal(resp.Committees[0], want) {
+		t.Errorf("Wanted coverage %v, received %v", want, resp.Committees[0])
+	}
+	for _, c := range resp.Committees[1:] {
+		if c.IncludedMembers != 0 {
+			t.Errorf("Expected no included members for shard %d, received %d", c.Shard, c.IncludedMembers)
+		}
+	}
+}
+
+func TestSlotAttestationCoverage_SlotBeyondHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 2}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	_, err := bs.SlotAttestationCoverage(ctx, &pb.SlotCoverageRequest{Slot: params.BeaconConfig().GenesisSlot + 3})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument error, received %v", err)
+	}
+	if !strings.Contains(err.Error(), "slot 3 is beyond the head slot 2") {
+		t.Errorf("Unexpected error message, received %v", err)
+	}
+}
+
+func TestMissedAttesters_ExcludesIncludedAttesters(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	helpers.RestartCommitteeCache()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch * 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	// The head state is in the epoch following the one whose attestations are checked.
+	beaconState.Slot = genesisSlot + params.BeaconConfig().SlotsPerEpoch + 1
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, genesisSlot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0]
+	if len(committee.Committee) < 2 {
+		t.Fatalf("Expected a committee of at least 2 validators, received %d", len(committee.Committee))
+	}
+	bitfield, err := bitutil.SetBitfield(0, len(committee.Committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Only the first member of the committee attested, in an attestation included in the next epoch.
+	blk := &pbp2p.BeaconBlock{
+		Slot: beaconState.Slot,
+		Body: &pbp2p.BeaconBlockBody{
+			Attestations: []*pbp2p.Attestation{{
+				Data:                &pbp2p.AttestationData{Slot: genesisSlot, Shard: committee.Shard},
+				AggregationBitfield: bitfield,
+			}},
+		},
+	}
+	if err := db.SaveBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	// Every validator is assigned to a committee once per epoch.
+	var want []uint64
+	for i := uint64(0); i < uint64(len(beaconState.ValidatorRegistry)); i++ {
+		if i != committee.Committee[0] {
+			want = append(want, i)
+		}
+	}
+	bs := &BeaconServer{beaconDB: db}
+	var missed []uint64
+	req := &pb.MissedAttestersRequest{PageSize: 100}
+	for {
+		res, err := bs.MissedAttesters(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.TotalSize != uint64(len(want)) {
+			t.Errorf("Wanted total size %d, received %d", len(want), res.TotalSize)
+		}
+		if res.Epoch != params.BeaconConfig().GenesisEpoch {
+			t.Errorf("Expected attestations of epoch 0 to be checked, received epoch %d", res.Epoch-params.BeaconConfig().GenesisEpoch)
+		}
+		missed = append(missed, res.ValidatorIndices...)
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+	if !reflect.DeepEqual(missed, want) {
+		t.Errorf("Wanted missed attesters %v, received %v", want, missed)
+	}
+}
+
+func TestMissedAttesters_GenesisEpoch(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 1}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	if _, err := bs.MissedAttesters(ctx, &pb.MissedAttestersRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition error before the end of the first epoch, received %v", err)
+	}
+}
+
+func TestEpochShuffling_Paginated(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	validatorCount := params.BeaconConfig().SlotsPerEpoch * 4
+	beaconState, err := genesisState(validatorCount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	epoch := params.BeaconConfig().GenesisEpoch + 1
+	var wanted []uint64
+	for slot := helpers.StartSlot(epoch); slot < helpers.StartSlot(epoch+1); slot++ {
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, committee := range committees {
+			wanted = append(wanted, committee.Committee...)
+		}
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	var indices []uint64
+	req := &pb.EpochShufflingRequest{Epoch: epoch, PageSize: 100}
+	for pages := 1; ; pages++ {
+		resp, err := bs.EpochShuffling(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.TotalSize != validatorCount {
+			t.Errorf("Wanted total size %d, received %d", validatorCount, resp.TotalSize)
+		}
+		indices = append(indices, resp.ValidatorIndices...)
+		if resp.NextPageToken == "" {
+			if pages != 3 {
+				t.Errorf("Wanted 3 pages, received %d", pages)
+			}
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if !reflect.DeepEqual(indices, wanted) {
+		t.Errorf("Wanted shuffling %v, received %v", wanted, indices)
+	}
+
+	seen := make(map[uint64]bool)
+	for _, idx := range indices {
+		seen[idx] = true
+	}
+	if uint64(len(seen)) != validatorCount {
+		t.Errorf("Wanted every one of the %d validators in the shuffling once, received %d", validatorCount, len(seen))
+	}
+}
+
+func TestEpochShuffling_EpochOutsideLookahead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	epoch := params.BeaconConfig().GenesisEpoch + params.BeaconConfig().MinSeedLookahead + 1
+	_, err = bs.EpochShuffling(ctx, &pb.EpochShufflingRequest{Epoch: epoch})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument error, received %v", err)
+	}
+	want := fmt.Sprintf("epoch %d is outside of the seed lookahead", params.BeaconConfig().MinSeedLookahead+1)
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %q, received %v", want, err)
+	}
+}
+
+func TestProposerReward_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch * 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saveCanonicalHistoricalState(t, db, beaconState)
+	parentRoot, err := hashutil.HashBeaconBlock(&pbp2p.BeaconBlock{Slot: beaconState.Slot})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, genesisSlot, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0]
+	firstMember, err := bitutil.SetBitfield(0, len(committee.Committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secondMember, err := bitutil.SetBitfield(1, len(committee.Committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestation := func(bitfield []byte) *pbp2p.Attestation {
+		return &pbp2p.Attestation{
+			Data:                &pbp2p.AttestationData{Slot: genesisSlot, Shard: committee.Shard},
+			AggregationBitfield: bitfield,
+		}
+	}
+
+	// The block skips a slot after its parent, so the proposer is selected at the block slot.
+	blockSlot := genesisSlot + 2
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, blockSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slashedIdx := (proposerIdx + 1) % uint64(len(beaconState.ValidatorRegistry))
+	proposal := &pbp2p.ProposalSignedData{Slot: genesisSlot, Shard: 1, BlockRootHash32: []byte{'A'}}
+	blk := &pbp2p.BeaconBlock{
+		Slot:             blockSlot,
+		ParentRootHash32: parentRoot[:],
+		Body: &pbp2p.BeaconBlockBody{
+			// The first member is included twice but only earns the proposer a single reward.
+			Attestations: []*pbp2p.Attestation{
+				attestation(firstMember),
+				attestation(firstMember),
+				attestation(secondMember),
+			},
+			ProposerSlashings: []*pbp2p.ProposerSlashing{
+				{
+					ProposerIndex:  slashedIdx,
+					ProposalData_1: proposal,
+					ProposalData_2: proposal,
+				},
+			},
+		},
+	}
+	if err := db.SaveBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	activeIndices := helpers.ActiveValidatorIndices(beaconState.ValidatorRegistry, helpers.CurrentEpoch(beaconState))
+	baseRewardQuotient := helpers.BaseRewardQuotient(helpers.TotalBalance(beaconState, activeIndices))
+	inclusionReward := 2 * (helpers.BaseReward(beaconState, proposerIdx, baseRewardQuotient) /
+		params.BeaconConfig().AttestationInclusionRewardQuotient)
+	whistleblowerReward := params.BeaconConfig().MaxDepositAmount / params.BeaconConfig().WhistlerBlowerRewardQuotient
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.ProposerReward(ctx, &pb.BlockByRootRequest{BlockRoot: blockRoot[:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.ProposerRewardResponse{
+		ProposerIndex:              proposerIdx,
+		AttestationInclusionReward: inclusionReward,
+		SlashingReward:             whistleblowerReward,
+		TotalReward:                inclusionReward + whistleblowerReward,
+	}
+	if !proto.Equal(resp, want) {
+		t.Errorf("Wanted %v, received %v", want, resp)
+	}
+}
+
+func TestProposerReward_UnknownBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	bs := &BeaconServer{beaconDB: db}
+	_, err := bs.ProposerReward(context.Background(), &pb.BlockByRootRequest{BlockRoot: []byte{'A'}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound error, received %v", err)
+	}
+}
+
+func TestUpcomingActivations_AppliesBalanceChurn(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	beaconState := &pbp2p.BeaconState{
+		Slot:                         helpers.StartSlot(genesisEpoch + 2),
+		FinalizedEpoch:               genesisEpoch + 1,
+		ValidatorRegistryUpdateEpoch: genesisEpoch,
+	}
+	// The balance churn of the active validators allows activating 2 validators.
+	activeCount := 4 * params.BeaconConfig().MaxBalanceChurnQuotient
+	for i := uint64(0); i < activeCount; i++ {
+		beaconState.ValidatorRegistry = append(beaconState.ValidatorRegistry, &pbp2p.Validator{
+			ActivationEpoch: genesisEpoch,
+			ExitEpoch:       farFuture,
+		})
+		beaconState.ValidatorBalances = append(beaconState.ValidatorBalances, maxDeposit)
+	}
+	for _, balance := range []uint64{maxDeposit - 1, maxDeposit, maxDeposit, maxDeposit} {
+		beaconState.ValidatorRegistry = append(beaconState.ValidatorRegistry, &pbp2p.Validator{
+			ActivationEpoch: farFuture,
+			ExitEpoch:       farFuture,
+		})
+		beaconState.ValidatorBalances = append(beaconState.ValidatorBalances, balance)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	resp, err := bs.UpcomingActivations(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.UpcomingActivationsResponse{
+		ValidatorIndices: []uint64{activeCount + 1, activeCount + 2},
+		ActivationEpoch:  helpers.EntryExitEffectEpoch(genesisEpoch + 2),
+	}
+	if !proto.Equal(resp, want) {
+		t.Errorf("Wanted %v, received %v", want, resp)
+	}
+
+	// Without finalizing an epoch since the last registry update, no validator is activated.
+	beaconState.FinalizedEpoch = beaconState.ValidatorRegistryUpdateEpoch
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	resp, err = bs.UpcomingActivations(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.ValidatorIndices) != 0 {
+		t.Errorf("Expected no upcoming activations, received %v", resp.ValidatorIndices)
+	}
+}
+
+func TestLastFinalizedSlot_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	finalizedSlot := params.BeaconConfig().GenesisSlot + 3*params.BeaconConfig().SlotsPerEpoch
+	if err := db.SaveFinalizedBlock(&pbp2p.BeaconBlock{Slot: finalizedSlot}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.LastFinalizedSlot(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Slot != finalizedSlot {
+		t.Errorf(
+			"Expected finalized slot %d, received %d",
+			finalizedSlot-params.BeaconConfig().GenesisSlot,
+			res.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+}
+
+func TestLastFinalizedSlot_NotFinalized(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	bs := &BeaconServer{beaconDB: db}
+	if _, err := bs.LastFinalizedSlot(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Expected FailedPrecondition error before the first finalization, received %v", err)
+	}
+}
+
+func TestFinalityDistance_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	tests := []struct {
+		state *pbp2p.BeaconState
+		want  *pb.FinalityDistanceResponse
+	}{
+		{
+			state: &pbp2p.BeaconState{
+				Slot:           helpers.StartSlot(genesisEpoch+5) + 3,
+				FinalizedEpoch: genesisEpoch + 3,
+			},
+			want: &pb.FinalityDistanceResponse{
+				Slots:          2*slotsPerEpoch + 3,
+				Epochs:         2,
+				FinalizedEpoch: genesisEpoch + 3,
+			},
+		},
+		{
+			state: &pbp2p.BeaconState{
+				Slot:           helpers.StartSlot(genesisEpoch+2) + 1,
+				FinalizedEpoch: genesisEpoch,
+			},
+			want: &pb.FinalityDistanceResponse{
+				Slots:          2*slotsPerEpoch + 1,
+				Epochs:         2,
+				FinalizedEpoch: genesisEpoch,
+				NeverFinalized: true,
+			},
+		},
+	}
+	bs := &BeaconServer{beaconDB: db}
+	for _, tt := range tests {
+		if err := db.SaveState(ctx, tt.state); err != nil {
+			t.Fatal(err)
+		}
+		res, err := bs.FinalityDistance(ctx, &ptypes.Empty{})
 		if err != nil {
-			b.Fatal(err)
+			t.Fatal(err)
+		}
+		if !proto.Equal(res, tt.want) {
+			t.Errorf("Wanted finality distance %v, received %v", tt.want, res)
+		}
+	}
+}
+
+func TestStateSchemaInfo_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Fork = &pbp2p.Fork{
+		PreviousVersion: 1,
+		CurrentVersion:  2,
+		Epoch:           params.BeaconConfig().GenesisEpoch,
+	}
+	beaconState.Eth1DataVotes = []*pbp2p.Eth1DataVote{{VoteCount: 1}, {VoteCount: 2}}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.StateSchemaInfo(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.StateSchemaInfoResponse{
+		ForkVersion:                2,
+		Slot:                       beaconState.Slot,
+		ValidatorRegistryCount:     8,
+		ValidatorBalancesCount:     8,
+		LatestRandaoMixesCount:     uint64(len(beaconState.LatestRandaoMixes)),
+		LatestCrosslinksCount:      uint64(len(beaconState.LatestCrosslinks)),
+		LatestBlockRootsCount:      uint64(len(beaconState.LatestBlockRootHash32S)),
+		HistoricalRootsCount:       0,
+		LatestSlashedBalancesCount: uint64(len(beaconState.LatestSlashedBalances)),
+		LatestAttestationsCount:    0,
+		LatestIndexRootsCount:      uint64(len(beaconState.LatestIndexRootHash32S)),
+		Eth1DataVotesCount:         2,
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, received %v", want, res)
+	}
+}
+
+func TestStateSchemaInfo_EmptyState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.StateSchemaInfo(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pb.StateSchemaInfoResponse{Slot: params.BeaconConfig().GenesisSlot}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, received %v", want, res)
+	}
+}
+
+func TestProposedBlock_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch * 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saveCanonicalHistoricalState(t, db, beaconState)
+	parentRoot, err := hashutil.HashBeaconBlock(&pbp2p.BeaconBlock{Slot: beaconState.Slot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	blockSlot := params.BeaconConfig().GenesisSlot + 1
+	blk := &pbp2p.BeaconBlock{
+		Slot:             blockSlot,
+		ParentRootHash32: parentRoot[:],
+	}
+	if err := db.SaveBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	headState := proto.Clone(beaconState).(*pbp2p.BeaconState)
+	headState.Slot = blockSlot
+	if err := db.UpdateChainHead(ctx, blk, headState); err != nil {
+		t.Fatal(err)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState, blockSlot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.ProposedBlock(ctx, &pb.ProposedBlockRequest{ValidatorIndex: proposerIdx, Slot: blockSlot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Found {
+		t.Fatal("Expected block proposed by the validator to be found")
+	}
+	if !proto.Equal(res.Block, blk) {
+		t.Errorf("Wanted block %v, received %v", blk, res.Block)
+	}
+	if !bytes.Equal(res.BlockRoot, blockRoot[:]) {
+		t.Errorf("Wanted block root %#x, received %#x", blockRoot, res.BlockRoot)
+	}
+
+	otherIdx := (proposerIdx + 1) % uint64(len(beaconState.ValidatorRegistry))
+	res, err = bs.ProposedBlock(ctx, &pb.ProposedBlockRequest{ValidatorIndex: otherIdx, Slot: blockSlot})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Found || res.Block != nil {
+		t.Errorf("Expected block to not be found for validator %d which did not propose it", otherIdx)
+	}
+}
+
+func TestProposedBlock_NoCanonicalBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch * 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	saveCanonicalHistoricalState(t, db, beaconState)
+
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.ProposedBlock(ctx, &pb.ProposedBlockRequest{Slot: params.BeaconConfig().GenesisSlot + 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Found {
+		t.Error("Expected no block to be found at a slot without a canonical block")
+	}
+}
+
+func TestCrosslinks_OrderedByShard(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// The head state only has crosslinks for the first two shards.
+	latestCrosslinks := []*pbp2p.Crosslink{
+		{Epoch: params.BeaconConfig().GenesisEpoch + 2, CrosslinkDataRootHash32: []byte("shard0")},
+		{Epoch: params.BeaconConfig().GenesisEpoch + 3, CrosslinkDataRootHash32: []byte("shard1")},
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{LatestCrosslinks: latestCrosslinks}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.Crosslinks(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uint64(len(res.Crosslinks)) != params.BeaconConfig().ShardCount {
+		t.Fatalf("Expected %d crosslinks, received %d", params.BeaconConfig().ShardCount, len(res.Crosslinks))
+	}
+	for i, c := range res.Crosslinks {
+		if c.Shard != uint64(i) {
+			t.Errorf("Expected crosslink at position %d to be for shard %d, received shard %d", i, i, c.Shard)
+		}
+		want := &pbp2p.Crosslink{}
+		if i < len(latestCrosslinks) {
+			want = latestCrosslinks[i]
+		}
+		if !proto.Equal(c.LatestCrosslink, want) {
+			t.Errorf("Wanted crosslink %v for shard %d, received %v", want, i, c.LatestCrosslink)
+		}
+	}
+}
+
+func TestChurnLimit_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// 128 active validators and 2 exited validators with a maximum deposit balance each.
+	activeCount := 2 * 2 * params.BeaconConfig().MaxBalanceChurnQuotient
+	validators := make([]*pbp2p.Validator, activeCount+2)
+	balances := make([]uint64, len(validators))
+	for i := range validators {
+		validators[i] = &pbp2p.Validator{
+			ActivationEpoch: params.BeaconConfig().GenesisEpoch,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+		}
+		balances[i] = params.BeaconConfig().MaxDepositAmount
+	}
+	validators[0].ExitEpoch = params.BeaconConfig().GenesisEpoch
+	validators[1].ExitEpoch = params.BeaconConfig().GenesisEpoch
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot:              params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: validators,
+		ValidatorBalances: balances,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.ChurnLimit(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ActiveValidatorCount != activeCount {
+		t.Errorf("Wanted %d active validators, received %d", activeCount, res.ActiveValidatorCount)
+	}
+	if want := activeCount * params.BeaconConfig().MaxDepositAmount; res.TotalActiveBalance != want {
+		t.Errorf("Wanted total active balance %d, received %d", want, res.TotalActiveBalance)
+	}
+	if want := 2 * params.BeaconConfig().MaxDepositAmount; res.BalanceChurnLimit != want {
+		t.Errorf("Wanted balance churn limit %d, received %d", want, res.BalanceChurnLimit)
+	}
+}
+
+func TestChurnLimit_AtLeastMaxDeposit(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		Slot: params.BeaconConfig().GenesisSlot,
+		ValidatorRegistry: []*pbp2p.Validator{
+			{ActivationEpoch: params.BeaconConfig().GenesisEpoch, ExitEpoch: params.BeaconConfig().FarFutureEpoch},
+		},
+		ValidatorBalances: []uint64{params.BeaconConfig().MaxDepositAmount},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.ChurnLimit(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.BalanceChurnLimit != params.BeaconConfig().MaxDepositAmount {
+		t.Errorf("Wanted balance churn limit %d, received %d", params.BeaconConfig().MaxDepositAmount, res.BalanceChurnLimit)
+	}
+	if res.ActiveValidatorCount != 1 {
+		t.Errorf("Wanted 1 active validator, received %d", res.ActiveValidatorCount)
+	}
+}
+
+func TestAttestingBalances_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	helpers.RestartCommitteeCache()
+	// The committees cached for the slots of this state must not leak into other tests.
+	defer helpers.RestartCommitteeCache()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch * 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	epochStart := genesisSlot + params.BeaconConfig().SlotsPerEpoch
+	beaconState.Slot = epochStart + 2
+	boundaryRoot := []byte("boundary")
+	beaconState.LatestBlockRootHash32S[epochStart%params.BeaconConfig().LatestBlockRootsLength] = boundaryRoot
+
+	attestation := func(slot uint64, epochBoundaryRoot []byte) *pbp2p.PendingAttestation {
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bitfield, err := bitutil.SetBitfield(0, len(committees[0].Committee))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &pbp2p.PendingAttestation{
+			Data: &pbp2p.AttestationData{
+				Slot:                    slot,
+				Shard:                   committees[0].Shard,
+				EpochBoundaryRootHash32: epochBoundaryRoot,
+			},
+			AggregationBitfield: bitfield,
+		}
+	}
+	// A single validator attests in the previous epoch and at the current epoch boundary,
+	// while the attester voting for another boundary block is not counted.
+	beaconState.LatestAttestations = []*pbp2p.PendingAttestation{
+		attestation(genesisSlot+1, []byte("previous boundary")),
+		attestation(epochStart, boundaryRoot),
+		attestation(epochStart+1, []byte("fork")),
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.AttestingBalances(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	if res.CurrentAttestingBalance != maxDeposit {
+		t.Errorf("Wanted current attesting balance %d, received %d", maxDeposit, res.CurrentAttestingBalance)
+	}
+	if res.PreviousAttestingBalance != maxDeposit {
+		t.Errorf("Wanted previous attesting balance %d, received %d", maxDeposit, res.PreviousAttestingBalance)
+	}
+	wantTotal := uint64(len(beaconState.ValidatorRegistry)) * maxDeposit
+	if res.CurrentTotalBalance != wantTotal || res.PreviousTotalBalance != wantTotal {
+		t.Errorf(
+			"Wanted total balances %d, received %d and %d",
+			wantTotal,
+			res.CurrentTotalBalance,
+			res.PreviousTotalBalance,
+		)
+	}
+}
+
+func TestAttestingBalances_GenesisEpoch(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.AttestingBalances(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.CurrentAttestingBalance != 0 || res.PreviousAttestingBalance != 0 || res.PreviousTotalBalance != 0 {
+		t.Errorf("Expected no attesting balance and no previous epoch at genesis, received %v", res)
+	}
+	if want := params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().MaxDepositAmount; res.CurrentTotalBalance != want {
+		t.Errorf("Wanted current total balance %d, received %d", want, res.CurrentTotalBalance)
+	}
+}
+
+func TestTotalDeposited_IncludesPendingDeposits(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	for i := uint64(0); i < 4; i++ {
+		depositData, err := helpers.EncodeDepositData(
+			&pbp2p.DepositInput{Pubkey: []byte{byte(i)}},
+			(i+1)*params.BeaconConfig().MinDepositAmount,
+			time.Now().Unix(),
+		)
+		if err != nil {
+			t.Fatalf("Could not encode deposit input: %v", err)
+		}
+		dep := &pbp2p.Deposit{DepositData: depositData, MerkleTreeIndex: i}
+		db.InsertDeposit(ctx, dep, big.NewInt(int64(i)))
+		// Only the last two deposits are still waiting to be included in a block.
+		if i >= 2 {
+			db.InsertPendingDeposit(ctx, dep, big.NewInt(int64(i)))
 		}
 	}
+
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.TotalDeposited(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	minDeposit := params.BeaconConfig().MinDepositAmount
+	if res.TotalAmount != 10*minDeposit {
+		t.Errorf("Wanted total amount %d, received %d", 10*minDeposit, res.TotalAmount)
+	}
+	if res.PendingAmount != 7*minDeposit {
+		t.Errorf("Wanted pending amount %d, received %d", 7*minDeposit, res.PendingAmount)
+	}
+	if res.DepositCount != 4 {
+		t.Errorf("Wanted 4 deposits, received %d", res.DepositCount)
+	}
+}
+
+func TestTotalDeposited_NoDeposits(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	bs := &BeaconServer{beaconDB: db}
+	res, err := bs.TotalDeposited(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.TotalAmount != 0 || res.PendingAmount != 0 || res.DepositCount != 0 {
+		t.Errorf("Expected an empty response without deposits, received %v", res)
+	}
 }
@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+)
+
+// eth1DataCache holds the last eth1 data selected by Eth1Data. The selection depends on the
+// beacon state's eth1 data votes and on the heights of their blocks in the eth1 chain, so an
+// entry is keyed by a hash of the votes and by the latest eth1 block height along with the
+// hash of the block at that height. An eth1 reorg changes the block hash at the cached height,
+// which invalidates the entry as block heights of the votes may have changed.
+type eth1DataCache struct {
+	lock      sync.Mutex
+	votesRoot [32]byte
+	height    *big.Int
+	blockHash common.Hash
+	response  *pb.Eth1DataResponse
+}
+
+// get returns the cached eth1 data for the given votes root and eth1 height, if any.
+func (c *eth1DataCache) get(votesRoot [32]byte, height *big.Int) (*pb.Eth1DataResponse, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.response == nil || c.votesRoot != votesRoot || c.height.Cmp(height) != 0 {
+		return nil, false
+	}
+	return c.response, true
+}
+
+// cachedHeight returns the eth1 height and block hash the cached eth1 data was computed at.
+func (c *eth1DataCache) cachedHeight() (*big.Int, common.Hash, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.response == nil {
+		return nil, common.Hash{}, false
+	}
+	return c.height, c.blockHash, true
+}
+
+// put replaces the cached eth1 data.
+func (c *eth1DataCache) put(votesRoot [32]byte, height *big.Int, blockHash common.Hash, res *pb.Eth1DataResponse) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.votesRoot = votesRoot
+	c.height = new(big.Int).Set(height)
+	c.blockHash = blockHash
+	c.response = res
+}
+
+// invalidate drops the cached eth1 data.
+func (c *eth1DataCache) invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.response = nil
+}
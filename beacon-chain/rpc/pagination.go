@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultPageSize is the number of items returned by paginated requests which leave the
+	// page size unset.
+	defaultPageSize = 250
+	// maxPageSize bounds the number of items returned in a single page of a paginated request.
+	maxPageSize = 1000
+)
+
+// paginate returns the start and end positions of the requested page within a list of
+// totalSize items, along with the token of the following page. Page tokens are the
+// decimal page number and the token of the last page is empty.
+func paginate(pageSize int32, pageToken string, totalSize int) (int, int, string, error) {
+	if pageSize < 0 || pageSize > maxPageSize {
+		return 0, 0, "", status.Errorf(
+			codes.InvalidArgument,
+			"page size %d is not within the range 0-%d",
+			pageSize,
+			maxPageSize,
+		)
+	}
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	page := 0
+	if pageToken != "" {
+		var err error
+		page, err = strconv.Atoi(pageToken)
+		if err != nil || page < 0 {
+			return 0, 0, "", status.Errorf(codes.InvalidArgument, "invalid page token %q", pageToken)
+		}
+	}
+	if page > totalSize/int(pageSize) {
+		return 0, 0, "", status.Errorf(
+			codes.InvalidArgument,
+			"page %d is beyond the %d available items",
+			page,
+			totalSize,
+		)
+	}
+	start := page * int(pageSize)
+	end := start + int(pageSize)
+	if end >= totalSize {
+		return start, totalSize, "", nil
+	}
+	return start, end, strconv.Itoa(page + 1), nil
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPaginate_OK(t *testing.T) {
+	tests := []struct {
+		pageSize  int32
+		pageToken string
+		totalSize int
+		start     int
+		end       int
+		nextToken string
+	}{
+		{pageSize: 3, pageToken: "", totalSize: 10, start: 0, end: 3, nextToken: "1"},
+		{pageSize: 3, pageToken: "1", totalSize: 10, start: 3, end: 6, nextToken: "2"},
+		{pageSize: 3, pageToken: "3", totalSize: 10, start: 9, end: 10, nextToken: ""},
+		{pageSize: 5, pageToken: "1", totalSize: 10, start: 5, end: 10, nextToken: ""},
+		{pageSize: 0, pageToken: "", totalSize: defaultPageSize + 1, start: 0, end: defaultPageSize, nextToken: "1"},
+		{pageSize: 3, pageToken: "", totalSize: 0, start: 0, end: 0, nextToken: ""},
+	}
+	for _, tt := range tests {
+		start, end, nextToken, err := paginate(tt.pageSize, tt.pageToken, tt.totalSize)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if start != tt.start || end != tt.end || nextToken != tt.nextToken {
+			t.Errorf(
+				"Page %q of size %d over %d items: wanted (%d, %d, %q), received (%d, %d, %q)",
+				tt.pageToken, tt.pageSize, tt.totalSize, tt.start, tt.end, tt.nextToken, start, end, nextToken,
+			)
+		}
+	}
+}
+
+func TestPaginate_InvalidArguments(t *testing.T) {
+	tests := []struct {
+		pageSize  int32
+		pageToken string
+	}{
+		{pageSize: -1},
+		{pageSize: maxPageSize + 1},
+		{pageSize: 3, pageToken: "abc"},
+		{pageSize: 3, pageToken: "-1"},
+		{pageSize: 3, pageToken: "4"},
+	}
+	for _, tt := range tests {
+		if _, _, _, err := paginate(tt.pageSize, tt.pageToken, 10); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument error for page %q of size %d, received %v", tt.pageToken, tt.pageSize, err)
+		}
+	}
+}
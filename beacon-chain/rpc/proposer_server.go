@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -71,6 +72,31 @@ func (ps *ProposerServer) ProposerIndex(ctx context.Context, req *pb.ProposerInd
 	}, nil
 }
 
+// CurrentProposer returns the index and public key of the validator expected to propose a block
+// at the current slot of the head state, as selected by the head state's shuffling.
+func (ps *ProposerServer) CurrentProposer(ctx context.Context, _ *ptypes.Empty) (*pb.CurrentProposerResponse, error) {
+	beaconState, err := ps.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not get beacon state: %v", err)
+	}
+	proposerIndex, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"could not get proposer index at slot %d: %v",
+			beaconState.Slot-params.BeaconConfig().GenesisSlot,
+			err,
+		)
+	}
+	if proposerIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+		return nil, fmt.Errorf("proposer index %d is not in the validator registry", proposerIndex)
+	}
+	return &pb.CurrentProposerResponse{
+		Slot:           beaconState.Slot,
+		ValidatorIndex: proposerIndex,
+		Pubkey:         beaconState.ValidatorRegistry[proposerIndex].Pubkey,
+	}, nil
+}
+
 // ProposeBlock is called by a proposer during its assigned slot to create a block in an attempt
 // to get it processed by the beacon node as the canonical head.
 func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *pbp2p.BeaconBlock) (*pb.ProposeResponse, error) {
@@ -4,9 +4,12 @@ import (
 	"context"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	ptypes "github.com/gogo/protobuf/types"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
@@ -436,3 +439,51 @@ func TestPendingAttestations_OK(t *testing.T) {
 		t.Error("Expected pending attestations list to be non-empty")
 	}
 }
+
+func TestCurrentProposer_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	beaconState.Slot = params.BeaconConfig().GenesisSlot + 3
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	wantedIndex, err := helpers.BeaconProposerIndex(beaconState, beaconState.Slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proposerServer := &ProposerServer{beaconDB: db}
+	res, err := proposerServer.CurrentProposer(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	want := &pb.CurrentProposerResponse{
+		Slot:           beaconState.Slot,
+		ValidatorIndex: wantedIndex,
+		Pubkey:         beaconState.ValidatorRegistry[wantedIndex].Pubkey,
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted %v, received %v", want, res)
+	}
+}
+
+func TestCurrentProposer_NoActiveValidators(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot}); err != nil {
+		t.Fatal(err)
+	}
+	proposerServer := &ProposerServer{beaconDB: db}
+	want := "could not get proposer index at slot 0"
+	if _, err := proposerServer.CurrentProposer(ctx, &ptypes.Empty{}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
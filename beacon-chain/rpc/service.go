@@ -37,6 +37,7 @@ func init() {
 
 type chainService interface {
 	StateInitializedFeed() *event.Feed
+	ProcessedBlockFeed() *event.Feed
 	blockchain.BlockReceiver
 	blockchain.ForkChoice
 	blockchain.TargetsFetcher
@@ -63,6 +64,7 @@ type powChainService interface {
 
 type syncService interface {
 	Status() error
+	HighestObservedSlot() (uint64, bool)
 }
 
 // Service defining an RPC server for a beacon node.
@@ -83,6 +85,7 @@ type Service struct {
 	incomingAttestation chan *pbp2p.Attestation
 	credentialError     error
 	p2p                 p2p.Broadcaster
+	blockTreeBreadth    uint64
 }
 
 // Config options for the beacon node RPC server.
@@ -96,6 +99,9 @@ type Config struct {
 	OperationService operationService
 	SyncService      syncService
 	Broadcaster      p2p.Broadcaster
+	// BlockTreeBreadthWarning is the number of blocks at a single slot above which BlockTree warns
+	// of an unusually wide tree, defaulting to defaultBlockTreeBreadthWarning if unset.
+	BlockTreeBreadthWarning uint64
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
@@ -116,6 +122,7 @@ func NewRPCService(ctx context.Context, cfg *Config) *Service {
 		withKey:             cfg.KeyFlag,
 		canonicalStateChan:  make(chan *pbp2p.BeaconState, params.BeaconConfig().DefaultBufferSize),
 		incomingAttestation: make(chan *pbp2p.Attestation, params.BeaconConfig().DefaultBufferSize),
+		blockTreeBreadth:    cfg.BlockTreeBreadthWarning,
 	}
 }
 
@@ -164,6 +171,8 @@ func (s *Service) Start() {
 		incomingAttestation: s.incomingAttestation,
 		canonicalStateChan:  s.canonicalStateChan,
 		chainStartChan:      make(chan time.Time, 1),
+		syncService:         s.syncService,
+		treeBreadthWarning:  s.blockTreeBreadth,
 	}
 	proposerServer := &ProposerServer{
 		beaconDB:           s.beaconDB,
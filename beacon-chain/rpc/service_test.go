@@ -33,9 +33,13 @@ func (t *TestLogger) Errorf(format string, args ...interface{}) {
 
 type mockOperationService struct {
 	pendingAttestations []*pb.Attestation
+	incomingAttFeed     *event.Feed
 }
 
 func (ms *mockOperationService) IncomingAttFeed() *event.Feed {
+	if ms.incomingAttFeed != nil {
+		return ms.incomingAttFeed
+	}
 	return new(event.Feed)
 }
 
@@ -94,6 +98,10 @@ func (m *mockChainService) StateInitializedFeed() *event.Feed {
 	return m.stateInitializedFeed
 }
 
+func (m *mockChainService) ProcessedBlockFeed() *event.Feed {
+	return m.blockFeed
+}
+
 func (m *mockChainService) ReceiveBlock(ctx context.Context, block *pb.BeaconBlock) (*pb.BeaconState, error) {
 	return &pb.BeaconState{}, nil
 }
@@ -132,10 +140,17 @@ func newMockChainService() *mockChainService {
 }
 
 type mockSyncService struct {
+	status              error
+	highestObservedSlot uint64
+	chainHeadReceived   bool
 }
 
 func (ms *mockSyncService) Status() error {
-	return nil
+	return ms.status
+}
+
+func (ms *mockSyncService) HighestObservedSlot() (uint64, bool) {
+	return ms.highestObservedSlot, ms.chainHeadReceived
 }
 
 func TestLifecycle_OK(t *testing.T) {
@@ -0,0 +1,97 @@
+package rpc
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+var droppedStreamItems = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "rpc_stream_dropped_items_total",
+		Help: "Count of feed items dropped because a streaming rpc client could not keep up.",
+	},
+	[]string{"stream"},
+)
+
+// streamBuffer relays the items sent on a feed into a bounded buffer read by a streaming rpc.
+// Feeds block their producer until every subscriber has received an item, so a slow client
+// reading from the feed directly would stall the node. Instead, once the buffer is full the
+// oldest buffered item is dropped to make room for the newest one and the drop is counted.
+type streamBuffer struct {
+	sub      event.Subscription
+	feedChan reflect.Value
+	items    chan interface{}
+	stream   string
+	quit     chan struct{}
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+// newStreamBuffer subscribes feedChan to the feed and relays the items received on it into a
+// buffer of up to size items, labelling dropped items in metrics with the stream name.
+func newStreamBuffer(feed *event.Feed, feedChan interface{}, size int, stream string) *streamBuffer {
+	sb := &streamBuffer{
+		sub:      feed.Subscribe(feedChan),
+		feedChan: reflect.ValueOf(feedChan),
+		items:    make(chan interface{}, size),
+		stream:   stream,
+		quit:     make(chan struct{}),
+	}
+	sb.wg.Add(1)
+	go sb.relay()
+	return sb
+}
+
+// Items returns the channel the buffered feed items are read from, oldest first.
+func (sb *streamBuffer) Items() <-chan interface{} {
+	return sb.items
+}
+
+// Err returns the error channel of the feed subscription, closed once it is unsubscribed.
+func (sb *streamBuffer) Err() <-chan error {
+	return sb.sub.Err()
+}
+
+// Unsubscribe cancels the feed subscription and stops relaying items into the buffer.
+func (sb *streamBuffer) Unsubscribe() {
+	sb.stopOnce.Do(func() {
+		sb.sub.Unsubscribe()
+		close(sb.quit)
+	})
+	sb.wg.Wait()
+}
+
+func (sb *streamBuffer) relay() {
+	defer sb.wg.Done()
+	cases := []reflect.SelectCase{
+		{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(sb.quit)},
+		{Dir: reflect.SelectRecv, Chan: sb.feedChan},
+	}
+	for {
+		chosen, item, ok := reflect.Select(cases)
+		if chosen == 0 || !ok {
+			return
+		}
+		sb.push(item.Interface())
+	}
+}
+
+// push adds an item to the buffer, dropping the oldest buffered item if the buffer is full.
+func (sb *streamBuffer) push(item interface{}) {
+	for {
+		select {
+		case sb.items <- item:
+			return
+		default:
+		}
+		select {
+		case <-sb.items:
+			droppedStreamItems.WithLabelValues(sb.stream).Inc()
+		default:
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package rpc
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+func TestStreamBuffer_SlowConsumerDropsOldest(t *testing.T) {
+	dropCounter := droppedStreamItems.WithLabelValues("TestSlowConsumer")
+	droppedBefore := testutil.ToFloat64(dropCounter)
+	feed := new(event.Feed)
+	buffer := newStreamBuffer(feed, make(chan *pbp2p.BeaconBlock), 2, "TestSlowConsumer")
+
+	// Nothing reads from the buffer, every send past its size has to drop a block
+	// instead of blocking the feed.
+	for slot := uint64(1); slot <= 5; slot++ {
+		if sent := feed.Send(&pbp2p.BeaconBlock{Slot: slot}); sent != 1 {
+			t.Fatalf("Expected the block at slot %d to be sent to 1 subscriber, sent to %d", slot, sent)
+		}
+	}
+	buffer.Unsubscribe()
+
+	if dropped := testutil.ToFloat64(dropCounter) - droppedBefore; dropped != 3 {
+		t.Errorf("Expected 3 dropped blocks, received %v", dropped)
+	}
+	for _, wanted := range []uint64{4, 5} {
+		block := (<-buffer.Items()).(*pbp2p.BeaconBlock)
+		if block.Slot != wanted {
+			t.Errorf("Expected buffered block at slot %d, received slot %d", wanted, block.Slot)
+		}
+	}
+	if len(buffer.Items()) != 0 {
+		t.Errorf("Expected an empty buffer, %d blocks remain", len(buffer.Items()))
+	}
+}
+
+func TestStreamBuffer_KeepsUpWithConsumer(t *testing.T) {
+	dropCounter := droppedStreamItems.WithLabelValues("TestFastConsumer")
+	droppedBefore := testutil.ToFloat64(dropCounter)
+	feed := new(event.Feed)
+	buffer := newStreamBuffer(feed, make(chan *pbp2p.BeaconBlock), 1, "TestFastConsumer")
+	defer buffer.Unsubscribe()
+
+	for slot := uint64(1); slot <= 3; slot++ {
+		feed.Send(&pbp2p.BeaconBlock{Slot: slot})
+		if block := (<-buffer.Items()).(*pbp2p.BeaconBlock); block.Slot != slot {
+			t.Errorf("Expected block at slot %d, received slot %d", slot, block.Slot)
+		}
+	}
+	if dropped := testutil.ToFloat64(dropCounter) - droppedBefore; dropped != 0 {
+		t.Errorf("Expected no dropped blocks, received %v", dropped)
+	}
+}
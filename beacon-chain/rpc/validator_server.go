@@ -15,11 +15,26 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// compressedPubkeyLength is the length of the compressed BLS public keys deserialized by the
+// bls package.
+const compressedPubkeyLength = 48
+
+// maxValidatorBalancesBatch bounds the number of validators whose balances can be requested at once.
+const maxValidatorBalancesBatch = 1000
+
+// maxPerformanceSummarySpan bounds the number of epochs summarized by a single
+// ValidatorPerformanceSummary request.
+const maxPerformanceSummarySpan = 64
+
 // ValidatorServer defines a server implementation of the gRPC Validator service,
 // providing RPC endpoints for obtaining validator assignments per epoch, the slots
 // and shards in which particular validators need to perform their responsibilities,
@@ -304,6 +319,653 @@ func (vs *ValidatorServer) ExitedValidators(
 	return resp, nil
 }
 
+// WithdrawalCredentials looks up the withdrawal credentials of the requested validators
+// from the validator registry of the head state. Public keys which are not in the
+// registry are marked as not found.
+func (vs *ValidatorServer) WithdrawalCredentials(
+	ctx context.Context,
+	req *pb.WithdrawalCredentialsRequest) (*pb.WithdrawalCredentialsResponse, error) {
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	validatorIndexMap := stateutils.ValidatorIndexMap(beaconState)
+	credentials := make([]*pb.WithdrawalCredentialsResponse_Credentials, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		credentials[i] = &pb.WithdrawalCredentialsResponse_Credentials{
+			PublicKey: pubKey,
+		}
+		idx, ok := validatorIndexMap[bytesutil.ToBytes32(pubKey)]
+		if !ok {
+			continue
+		}
+		credentials[i].WithdrawalCredentialsHash32 = beaconState.ValidatorRegistry[idx].WithdrawalCredentialsHash32
+		credentials[i].Found = true
+	}
+	return &pb.WithdrawalCredentialsResponse{
+		Credentials: credentials,
+	}, nil
+}
+
+// ValidatorDuties returns the attestation slot, committee and shard of each requested
+// validator for the given epoch along with any slots in which it is due to propose.
+func (vs *ValidatorServer) ValidatorDuties(
+	ctx context.Context,
+	req *pb.ValidatorDutiesRequest) (*pb.ValidatorDutiesResponse, error) {
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	prevEpoch := helpers.PrevEpoch(beaconState)
+	nextEpoch := helpers.NextEpoch(beaconState)
+	if req.Epoch < prevEpoch || req.Epoch > nextEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch %d is outside of the committee lookahead: %d <= epoch <= %d",
+			req.Epoch-params.BeaconConfig().GenesisEpoch,
+			prevEpoch-params.BeaconConfig().GenesisEpoch,
+			nextEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+
+	validatorIndexMap := stateutils.ValidatorIndexMap(beaconState)
+	duties := make([]*pb.ValidatorDutiesResponse_Duty, len(req.PublicKeys))
+	dutiesByIndex := make(map[uint64]*pb.ValidatorDutiesResponse_Duty)
+	for i, pubKey := range req.PublicKeys {
+		duties[i] = &pb.ValidatorDutiesResponse_Duty{
+			PublicKey: pubKey,
+		}
+		if idx, ok := validatorIndexMap[bytesutil.ToBytes32(pubKey)]; ok {
+			dutiesByIndex[uint64(idx)] = duties[i]
+		}
+	}
+
+	startSlot := helpers.StartSlot(req.Epoch)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+		if err != nil {
+			return nil, fmt.Errorf("could not get crosslink committees at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if len(committees) == 0 || len(committees[0].Committee) == 0 {
+			return nil, fmt.Errorf("empty first committee at slot %d", slot-params.BeaconConfig().GenesisSlot)
+		}
+		proposer, err := helpers.BeaconProposerIndex(beaconState, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not get proposer index at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if duty, ok := dutiesByIndex[proposer]; ok {
+			duty.ProposalSlots = append(duty.ProposalSlots, slot)
+		}
+		for committeeIndex, committee := range committees {
+			for _, validatorIndex := range committee.Committee {
+				duty, ok := dutiesByIndex[validatorIndex]
+				if !ok || duty.Found {
+					continue
+				}
+				duty.AttestationSlot = slot
+				duty.CommitteeIndex = uint64(committeeIndex)
+				duty.Shard = committee.Shard
+				duty.Found = true
+			}
+		}
+	}
+	return &pb.ValidatorDutiesResponse{
+		Duties: duties,
+	}, nil
+}
+
+// ValidatorBalanceDelta returns the signed change of a validator's balance between the historical
+// states of the canonical chain at the start and end slots. A validator which was not yet in the
+// registry at the start slot is treated as having had a zero balance.
+func (vs *ValidatorServer) ValidatorBalanceDelta(
+	ctx context.Context,
+	req *pb.ValidatorBalanceDeltaRequest) (*pb.ValidatorBalanceDeltaResponse, error) {
+	if req.StartSlot > req.EndSlot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"start slot %d is greater than end slot %d",
+			req.StartSlot-params.BeaconConfig().GenesisSlot,
+			req.EndSlot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	slotState := func(slot uint64) (*pbp2p.BeaconState, error) {
+		beaconState, err := canonicalHistoricalState(ctx, vs.beaconDB, slot)
+		if err != nil {
+			return nil, status.Errorf(
+				codes.NotFound,
+				"no state available for slot %d: %v",
+				slot-params.BeaconConfig().GenesisSlot,
+				err,
+			)
+		}
+		return beaconState, nil
+	}
+	startState, err := slotState(req.StartSlot)
+	if err != nil {
+		return nil, err
+	}
+	endState, err := slotState(req.EndSlot)
+	if err != nil {
+		return nil, err
+	}
+	if req.ValidatorIndex >= uint64(len(endState.ValidatorBalances)) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"validator index %d is not in the registry at slot %d",
+			req.ValidatorIndex,
+			req.EndSlot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	var startBalance uint64
+	if req.ValidatorIndex < uint64(len(startState.ValidatorBalances)) {
+		startBalance = startState.ValidatorBalances[req.ValidatorIndex]
+	}
+	endBalance := endState.ValidatorBalances[req.ValidatorIndex]
+	return &pb.ValidatorBalanceDeltaResponse{
+		StartBalance: startBalance,
+		EndBalance:   endBalance,
+		Delta:        int64(endBalance) - int64(startBalance),
+	}, nil
+}
+
+// ValidatorAttestations returns the attestations for the requested epoch range which were included
+// in canonical blocks and have the aggregation bit of the validator's committee position set.
+// Committees are computed from the head state, so only the previous and current epochs of the
+// head state can be requested.
+func (vs *ValidatorServer) ValidatorAttestations(
+	ctx context.Context,
+	req *pb.ValidatorAttestationsRequest) (*pb.ValidatorAttestationsResponse, error) {
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"start epoch %d is greater than end epoch %d",
+			req.StartEpoch-params.BeaconConfig().GenesisEpoch,
+			req.EndEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	headState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	prevEpoch := helpers.PrevEpoch(headState)
+	currentEpoch := helpers.CurrentEpoch(headState)
+	if req.StartEpoch < prevEpoch || req.EndEpoch > currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch range %d-%d is outside of the available history: %d <= epoch <= %d",
+			req.StartEpoch-params.BeaconConfig().GenesisEpoch,
+			req.EndEpoch-params.BeaconConfig().GenesisEpoch,
+			prevEpoch-params.BeaconConfig().GenesisEpoch,
+			currentEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	if req.ValidatorIndex >= uint64(len(headState.ValidatorRegistry)) {
+		return nil, status.Errorf(codes.InvalidArgument, "validator index %d is not in the registry", req.ValidatorIndex)
+	}
+
+	// Attestations can be included up to an epoch after their slot, so we scan the
+	// canonical blocks up to the end of the epoch following the requested range.
+	startSlot := helpers.StartSlot(req.StartEpoch)
+	endSlot := helpers.StartSlot(req.EndEpoch+2) - 1
+	if endSlot > headState.Slot {
+		endSlot = headState.Slot
+	}
+	attestations := make([]*pbp2p.Attestation, 0)
+	for slot := startSlot; slot <= endSlot; slot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blk, err := vs.beaconDB.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if blk == nil || blk.Body == nil {
+			continue
+		}
+		for _, att := range blk.Body.Attestations {
+			attEpoch := helpers.SlotToEpoch(att.Data.Slot)
+			if attEpoch < req.StartEpoch || attEpoch > req.EndEpoch {
+				continue
+			}
+			participants, err := helpers.AttestationParticipants(headState, att.Data, att.AggregationBitfield)
+			if err != nil {
+				return nil, fmt.Errorf("could not get attestation participants: %v", err)
+			}
+			for _, idx := range participants {
+				if idx == req.ValidatorIndex {
+					attestations = append(attestations, att)
+					break
+				}
+			}
+		}
+	}
+	return &pb.ValidatorAttestationsResponse{
+		Attestations: attestations,
+	}, nil
+}
+
+// WithdrawableValidators returns a page of the indices of the validators in the registry of the
+// head state whose withdrawal epoch is at or before the requested epoch.
+func (vs *ValidatorServer) WithdrawableValidators(
+	ctx context.Context,
+	req *pb.WithdrawableValidatorsRequest) (*pb.WithdrawableValidatorsResponse, error) {
+	// Epochs are offset by the genesis epoch, so lower values denote negative epochs.
+	if req.Epoch < params.BeaconConfig().GenesisEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch %d is before the genesis epoch",
+			int64(req.Epoch-params.BeaconConfig().GenesisEpoch),
+		)
+	}
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	withdrawable := make([]uint64, 0)
+	for i, v := range beaconState.ValidatorRegistry {
+		if v.WithdrawalEpoch <= req.Epoch {
+			withdrawable = append(withdrawable, uint64(i))
+		}
+	}
+	start, end, nextPageToken, err := paginate(req.PageSize, req.PageToken, len(withdrawable))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.WithdrawableValidatorsResponse{
+		ValidatorIndices: withdrawable[start:end],
+		NextPageToken:    nextPageToken,
+		TotalSize:        uint64(len(withdrawable)),
+	}, nil
+}
+
+// AggregatePublicKey aggregates the public keys of the requested validators in the registry of the
+// head state, in the same way public keys are aggregated to verify aggregate signatures.
+func (vs *ValidatorServer) AggregatePublicKey(
+	ctx context.Context,
+	req *pb.AggregatePublicKeyRequest) (*pb.AggregatePublicKeyResponse, error) {
+	if len(req.ValidatorIndices) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no validator indices requested")
+	}
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	var aggregate *bls.PublicKey
+	for _, idx := range req.ValidatorIndices {
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) {
+			return nil, status.Errorf(
+				codes.InvalidArgument,
+				"validator index %d is out of range of the %d validators in the registry",
+				idx,
+				len(beaconState.ValidatorRegistry),
+			)
+		}
+		pub, err := bls.PublicKeyFromBytes(beaconState.ValidatorRegistry[idx].Pubkey)
+		if err != nil {
+			return nil, fmt.Errorf("could not deserialize validator %d public key: %v", idx, err)
+		}
+		if aggregate == nil {
+			aggregate = pub
+			continue
+		}
+		aggregate = aggregate.Aggregate(pub)
+	}
+	return &pb.AggregatePublicKeyResponse{
+		AggregatePubkey: aggregate.Marshal(),
+	}, nil
+}
+
+// ValidatorAttested reports whether the requested validator's bit is set in an attestation for the
+// requested slot included in a canonical block, along with the first block which included it. The
+// validator's position in its committee at the slot is computed from the head state, so only slots
+// from the previous epoch onwards can be checked.
+func (vs *ValidatorServer) ValidatorAttested(
+	ctx context.Context,
+	req *pb.ValidatorAttestedRequest) (*pb.ValidatorAttestedResponse, error) {
+	headState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	if req.Slot > headState.Slot {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"slot %d is beyond the head slot %d",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			headState.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	if req.ValidatorIndex >= uint64(len(headState.ValidatorRegistry)) {
+		return nil, status.Errorf(codes.InvalidArgument, "validator index %d is not in the registry", req.ValidatorIndex)
+	}
+	shard, position, err := committeePosition(headState, req.Slot, req.ValidatorIndex)
+	if err != nil {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"could not get crosslink committees at slot %d: %v",
+			req.Slot-params.BeaconConfig().GenesisSlot,
+			err,
+		)
+	}
+	if position < 0 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"validator %d is not in a committee at slot %d",
+			req.ValidatorIndex,
+			req.Slot-params.BeaconConfig().GenesisSlot,
+		)
+	}
+	blk, err := vs.includingBlock(ctx, req.Slot, shard, position, headState.Slot)
+	if err != nil {
+		return nil, err
+	}
+	if blk == nil {
+		return &pb.ValidatorAttestedResponse{
+			Attested: false,
+		}, nil
+	}
+	root, err := hashutil.HashBeaconBlock(blk)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash block at slot %d: %v", blk.Slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	return &pb.ValidatorAttestedResponse{
+		Attested:      true,
+		BlockRoot:     root[:],
+		InclusionSlot: blk.Slot,
+	}, nil
+}
+
+// ValidatePubkey deserializes the requested compressed public key with the same BLS library used to
+// verify signatures, so tools can check a key is well formed before depositing or importing it.
+func (vs *ValidatorServer) ValidatePubkey(
+	ctx context.Context,
+	req *pb.ValidatePubkeyRequest) (*pb.ValidatePubkeyResponse, error) {
+	// The bls package pads or truncates keys to the compressed length, so the length has to be
+	// checked beforehand.
+	if len(req.Pubkey) != compressedPubkeyLength {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"public key is %d bytes long, wanted %d",
+			len(req.Pubkey),
+			compressedPubkeyLength,
+		)
+	}
+	pub, err := bls.PublicKeyFromBytes(req.Pubkey)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid public key %#x: %v", req.Pubkey, err)
+	}
+	return &pb.ValidatePubkeyResponse{
+		Pubkey: pub.Marshal(),
+	}, nil
+}
+
+// ValidatorBalances looks up the balance and effective balance in the head state of every requested
+// validator, by index or public key. Validators missing from the registry are returned as not found
+// instead of failing the whole request.
+func (vs *ValidatorServer) ValidatorBalances(
+	ctx context.Context,
+	req *pb.ValidatorBalancesRequest) (*pb.ValidatorBalancesResponse, error) {
+	requested := len(req.ValidatorIndices) + len(req.PublicKeys)
+	if requested > maxValidatorBalancesBatch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"requested the balances of %d validators, wanted at most %d",
+			requested,
+			maxValidatorBalancesBatch,
+		)
+	}
+	beaconState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch beacon state: %v", err)
+	}
+	balance := func(idx uint64) *pb.ValidatorBalancesResponse_Balance {
+		if idx >= uint64(len(beaconState.ValidatorRegistry)) || idx >= uint64(len(beaconState.ValidatorBalances)) {
+			return &pb.ValidatorBalancesResponse_Balance{ValidatorIndex: idx}
+		}
+		return &pb.ValidatorBalancesResponse_Balance{
+			ValidatorIndex:   idx,
+			PublicKey:        beaconState.ValidatorRegistry[idx].Pubkey,
+			Found:            true,
+			Balance:          beaconState.ValidatorBalances[idx],
+			EffectiveBalance: helpers.EffectiveBalance(beaconState, idx),
+		}
+	}
+
+	balances := make([]*pb.ValidatorBalancesResponse_Balance, 0, requested)
+	for _, idx := range req.ValidatorIndices {
+		balances = append(balances, balance(idx))
+	}
+	if len(req.PublicKeys) > 0 {
+		indices := make(map[[48]byte]uint64, len(beaconState.ValidatorRegistry))
+		for i, v := range beaconState.ValidatorRegistry {
+			indices[bytesutil.ToBytes48(v.Pubkey)] = uint64(i)
+		}
+		for _, pubkey := range req.PublicKeys {
+			idx, ok := indices[bytesutil.ToBytes48(pubkey)]
+			if !ok || len(pubkey) != compressedPubkeyLength {
+				balances = append(balances, &pb.ValidatorBalancesResponse_Balance{PublicKey: pubkey})
+				continue
+			}
+			balances = append(balances, balance(idx))
+		}
+	}
+	return &pb.ValidatorBalancesResponse{
+		Balances: balances,
+	}, nil
+}
+
+// ValidatorPerformanceSummary summarizes how the requested validator performed its duties over an
+// epoch range. Every epoch is evaluated from the historical state saved at its start slot: the
+// validator's attestation duty counts as included if a canonical block up to an epoch later carries
+// its aggregation bit, and its proposal duties count as proposed if the slot has a canonical block.
+// The balance change is taken between the states at the start of the range and at the start of the
+// epoch following it, so the range must end before the current epoch.
+func (vs *ValidatorServer) ValidatorPerformanceSummary(
+	ctx context.Context,
+	req *pb.ValidatorPerformanceSummaryRequest) (*pb.ValidatorPerformanceSummaryResponse, error) {
+	if req.StartEpoch > req.EndEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"start epoch %d is greater than end epoch %d",
+			req.StartEpoch-params.BeaconConfig().GenesisEpoch,
+			req.EndEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+	if req.EndEpoch-req.StartEpoch >= maxPerformanceSummarySpan {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"epoch range of %d epochs exceeds the maximum of %d",
+			req.EndEpoch-req.StartEpoch+1,
+			maxPerformanceSummarySpan,
+		)
+	}
+	headState, err := vs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve head state: %v", err)
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	if req.EndEpoch >= currentEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"end epoch %d is not before the current epoch %d",
+			req.EndEpoch-params.BeaconConfig().GenesisEpoch,
+			currentEpoch-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+
+	epochState := func(epoch uint64) (*pbp2p.BeaconState, error) {
+		beaconState, err := canonicalHistoricalState(ctx, vs.beaconDB, helpers.StartSlot(epoch))
+		if err != nil {
+			return nil, status.Errorf(
+				codes.NotFound,
+				"no state available for epoch %d: %v",
+				epoch-params.BeaconConfig().GenesisEpoch,
+				err,
+			)
+		}
+		return beaconState, nil
+	}
+	endState, err := epochState(req.EndEpoch + 1)
+	if err != nil {
+		return nil, err
+	}
+	if req.ValidatorIndex >= uint64(len(endState.ValidatorBalances)) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"validator index %d is not in the registry at epoch %d",
+			req.ValidatorIndex,
+			req.EndEpoch+1-params.BeaconConfig().GenesisEpoch,
+		)
+	}
+
+	res := &pb.ValidatorPerformanceSummaryResponse{}
+	var startBalance uint64
+	for epoch := req.StartEpoch; epoch <= req.EndEpoch; epoch++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		beaconState, err := epochState(epoch)
+		if err != nil {
+			return nil, err
+		}
+		if req.ValidatorIndex >= uint64(len(beaconState.ValidatorRegistry)) {
+			continue
+		}
+		if epoch == req.StartEpoch {
+			startBalance = beaconState.ValidatorBalances[req.ValidatorIndex]
+		}
+		if !helpers.IsActiveValidator(beaconState.ValidatorRegistry[req.ValidatorIndex], epoch) {
+			continue
+		}
+		res.EpochsActive++
+
+		for slot := helpers.StartSlot(epoch); slot < helpers.StartSlot(epoch+1); slot++ {
+			shard, position, err := committeePosition(beaconState, slot, req.ValidatorIndex)
+			if err != nil {
+				return nil, fmt.Errorf("could not get crosslink committees at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+			}
+			if position >= 0 {
+				blk, err := vs.includingBlock(ctx, slot, shard, position, headState.Slot)
+				if err != nil {
+					return nil, err
+				}
+				if blk != nil {
+					res.AttestationsIncluded++
+				} else {
+					res.MissedDuties++
+				}
+			}
+
+			// The genesis block has no proposer.
+			if slot == params.BeaconConfig().GenesisSlot {
+				continue
+			}
+			proposerIdx, err := helpers.BeaconProposerIndex(beaconState, slot)
+			if err != nil {
+				return nil, fmt.Errorf("could not get proposer index at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+			}
+			if proposerIdx != req.ValidatorIndex {
+				continue
+			}
+			blk, err := vs.beaconDB.CanonicalBlockBySlot(ctx, slot)
+			if err != nil {
+				return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+			}
+			if blk != nil {
+				res.Proposals++
+			} else {
+				res.MissedDuties++
+			}
+		}
+	}
+	res.BalanceChange = int64(endState.ValidatorBalances[req.ValidatorIndex]) - int64(startBalance)
+	return res, nil
+}
+
+// committeePosition returns the shard of the validator's crosslink committee at the slot and the
+// validator's position within it. The position is -1 if the validator is in no committee at the slot.
+func committeePosition(beaconState *pbp2p.BeaconState, slot uint64, validatorIndex uint64) (uint64, int, error) {
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, slot, false /* registryChange */)
+	if err != nil {
+		return 0, -1, err
+	}
+	for _, committee := range committees {
+		for i, idx := range committee.Committee {
+			if idx == validatorIndex {
+				return committee.Shard, i, nil
+			}
+		}
+	}
+	return 0, -1, nil
+}
+
+// includingBlock returns the first canonical block after the slot, and at most an epoch later, which
+// includes an attestation for the slot and shard with the aggregation bit at the committee position
+// set. Blocks after the head slot are not searched, and nil is returned if no block includes one.
+func (vs *ValidatorServer) includingBlock(
+	ctx context.Context,
+	slot uint64,
+	shard uint64,
+	position int,
+	headSlot uint64) (*pbp2p.BeaconBlock, error) {
+	// Attestations can be included up to an epoch after their slot.
+	endSlot := slot + params.BeaconConfig().SlotsPerEpoch
+	if endSlot > headSlot {
+		endSlot = headSlot
+	}
+	for blkSlot := slot + 1; blkSlot <= endSlot; blkSlot++ {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		blk, err := vs.beaconDB.CanonicalBlockBySlot(ctx, blkSlot)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", blkSlot-params.BeaconConfig().GenesisSlot, err)
+		}
+		if blk == nil || blk.Body == nil {
+			continue
+		}
+		for _, att := range blk.Body.Attestations {
+			if att.Data.Slot != slot || att.Data.Shard != shard {
+				continue
+			}
+			bitSet, err := bitutil.CheckBit(att.AggregationBitfield, position)
+			if err != nil {
+				return nil, fmt.Errorf("could not check aggregation bitfield: %v", err)
+			}
+			if bitSet {
+				return blk, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// canonicalHistoricalState retrieves the historical state saved for the canonical block at the
+// given slot, returning an error if there is no such block or state.
+func canonicalHistoricalState(ctx context.Context, beaconDB *db.BeaconDB, slot uint64) (*pbp2p.BeaconState, error) {
+	block, err := beaconDB.CanonicalBlockBySlot(ctx, slot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve canonical block at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("no canonical block at slot %d", slot-params.BeaconConfig().GenesisSlot)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		return nil, fmt.Errorf("could not hash canonical block: %v", err)
+	}
+	beaconState, err := beaconDB.HistoricalStateFromSlot(ctx, slot, blockRoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve historical state at slot %d: %v", slot-params.BeaconConfig().GenesisSlot, err)
+	}
+	if beaconState.Slot != slot {
+		return nil, fmt.Errorf("no historical state saved at slot %d", slot-params.BeaconConfig().GenesisSlot)
+	}
+	return beaconState, nil
+}
+
 func (vs *ValidatorServer) validatorStatus(
 	ctx context.Context, pubKey []byte, chainStarted bool,
 	chainStartKeys map[[96]byte]bool, idxMap map[[32]byte]int,
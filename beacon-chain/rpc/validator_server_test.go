@@ -3,9 +3,11 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"crypto/rand"
 	"fmt"
 	"math"
 	"math/big"
+	"reflect"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,10 +20,16 @@ import (
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bitutil"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func genesisState(validators uint64) (*pbp2p.BeaconState, error) {
@@ -999,3 +1007,802 @@ func TestAddNonActivePublicKeysAssignmentStatus(t *testing.T) {
 		t.Errorf("Unknown public key status wasn't returned: %v", assignments)
 	}
 }
+
+func TestWithdrawalCredentials_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState := &pbp2p.BeaconState{
+		ValidatorRegistry: []*pbp2p.Validator{
+			{
+				Pubkey:                      []byte("pk1"),
+				WithdrawalCredentialsHash32: []byte("credentials1"),
+			},
+			{
+				Pubkey:                      []byte("pk2"),
+				WithdrawalCredentialsHash32: []byte("credentials2"),
+			},
+		},
+	}
+	if err := db.SaveState(context.Background(), beaconState); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	resp, err := vs.WithdrawalCredentials(context.Background(), &pb.WithdrawalCredentialsRequest{
+		PublicKeys: [][]byte{[]byte("pk2"), []byte("pk3"), []byte("pk1")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := []*pb.WithdrawalCredentialsResponse_Credentials{
+		{PublicKey: []byte("pk2"), WithdrawalCredentialsHash32: []byte("credentials2"), Found: true},
+		{PublicKey: []byte("pk3")},
+		{PublicKey: []byte("pk1"), WithdrawalCredentialsHash32: []byte("credentials1"), Found: true},
+	}
+	if len(resp.Credentials) != len(wanted) {
+		t.Fatalf("Wanted %d credentials, received %d", len(wanted), len(resp.Credentials))
+	}
+	for i := range wanted {
+		if !proto.Equal(resp.Credentials[i], wanted[i]) {
+			t.Errorf("Wanted credentials %v at index %d, received %v", wanted[i], i, resp.Credentials[i])
+		}
+	}
+}
+
+func TestValidatorDuties_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, err := genesisState(params.BeaconConfig().DepositsForChainStart)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.SaveState(context.Background(), beaconState); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	pubKeys := [][]byte{
+		beaconState.ValidatorRegistry[0].Pubkey,
+		[]byte("unknown"),
+		beaconState.ValidatorRegistry[5].Pubkey,
+	}
+	resp, err := vs.ValidatorDuties(context.Background(), &pb.ValidatorDutiesRequest{
+		Epoch:      params.BeaconConfig().GenesisEpoch,
+		PublicKeys: pubKeys,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Duties) != len(pubKeys) {
+		t.Fatalf("Wanted %d duties, received %d", len(pubKeys), len(resp.Duties))
+	}
+	if resp.Duties[1].Found {
+		t.Errorf("Wanted unknown public key to have no duties, received %v", resp.Duties[1])
+	}
+
+	startSlot := params.BeaconConfig().GenesisSlot
+	for i, idx := range []uint64{0, 5} {
+		duty := resp.Duties[i*2]
+		if !duty.Found {
+			t.Fatalf("Wanted duty to be found for validator %d", idx)
+		}
+		committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, duty.AttestationSlot, false /* registryChange */)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if duty.CommitteeIndex >= uint64(len(committees)) {
+			t.Fatalf("Committee index %d out of range for validator %d", duty.CommitteeIndex, idx)
+		}
+		committee := committees[duty.CommitteeIndex]
+		if committee.Shard != duty.Shard {
+			t.Errorf("Wanted shard %d for validator %d, received %d", committee.Shard, idx, duty.Shard)
+		}
+		inCommittee := false
+		for _, v := range committee.Committee {
+			if v == idx {
+				inCommittee = true
+			}
+		}
+		if !inCommittee {
+			t.Errorf("Validator %d is not in committee %d at slot %d", idx, duty.CommitteeIndex, duty.AttestationSlot)
+		}
+
+		var wantedProposalSlots []uint64
+		for s := startSlot; s < startSlot+params.BeaconConfig().SlotsPerEpoch; s++ {
+			proposer, err := helpers.BeaconProposerIndex(beaconState, s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if proposer == idx {
+				wantedProposalSlots = append(wantedProposalSlots, s)
+			}
+		}
+		if len(duty.ProposalSlots) != len(wantedProposalSlots) {
+			t.Fatalf("Wanted proposal slots %v for validator %d, received %v", wantedProposalSlots, idx, duty.ProposalSlots)
+		}
+		for j := range wantedProposalSlots {
+			if duty.ProposalSlots[j] != wantedProposalSlots[j] {
+				t.Errorf("Wanted proposal slots %v for validator %d, received %v", wantedProposalSlots, idx, duty.ProposalSlots)
+			}
+		}
+	}
+}
+
+func TestValidatorDuties_EpochOutsideLookahead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState, err := genesisState(params.BeaconConfig().DepositsForChainStart)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	if err := db.SaveState(context.Background(), beaconState); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	_, err = vs.ValidatorDuties(context.Background(), &pb.ValidatorDutiesRequest{
+		Epoch:      params.BeaconConfig().GenesisEpoch + 2,
+		PublicKeys: [][]byte{beaconState.ValidatorRegistry[0].Pubkey},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Wanted InvalidArgument error, received %v", err)
+	}
+	want := "epoch 2 is outside of the committee lookahead: 0 <= epoch <= 1"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %q, received %v", want, err)
+	}
+}
+
+func TestWithdrawableValidators_Paginated(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		ValidatorRegistry: []*pbp2p.Validator{
+			{WithdrawalEpoch: genesisEpoch + 1},
+			{WithdrawalEpoch: farFuture},
+			{WithdrawalEpoch: genesisEpoch + 3},
+			{WithdrawalEpoch: genesisEpoch + 2},
+			{WithdrawalEpoch: genesisEpoch + 2},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	resp, err := vs.WithdrawableValidators(ctx, &pb.WithdrawableValidatorsRequest{Epoch: genesisEpoch})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.ValidatorIndices) != 0 || resp.TotalSize != 0 {
+		t.Errorf("Expected no withdrawable validators at genesis, received %v", resp)
+	}
+
+	var indices []uint64
+	req := &pb.WithdrawableValidatorsRequest{Epoch: genesisEpoch + 2, PageSize: 2}
+	for {
+		resp, err := vs.WithdrawableValidators(ctx, req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.TotalSize != 3 {
+			t.Errorf("Expected a total of 3 withdrawable validators, received %d", resp.TotalSize)
+		}
+		indices = append(indices, resp.ValidatorIndices...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	if !reflect.DeepEqual(indices, []uint64{0, 3, 4}) {
+		t.Errorf("Expected withdrawable validators [0 3 4], received %v", indices)
+	}
+}
+
+func TestWithdrawableValidators_EpochBeforeGenesis(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	vs := &ValidatorServer{beaconDB: db}
+	req := &pb.WithdrawableValidatorsRequest{Epoch: params.BeaconConfig().GenesisEpoch - 1}
+	if _, err := vs.WithdrawableValidators(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error, received %v", err)
+	}
+}
+
+func TestAggregatePublicKey_VerifiesAggregateSignature(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	privKeys := make([]*bls.SecretKey, 4)
+	validators := make([]*pbp2p.Validator, len(privKeys))
+	for i := range privKeys {
+		priv, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		privKeys[i] = priv
+		validators[i] = &pbp2p.Validator{Pubkey: priv.PublicKey().Marshal()}
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{ValidatorRegistry: validators}); err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	indices := []uint64{0, 2, 3}
+	resp, err := vs.AggregatePublicKey(ctx, &pb.AggregatePublicKeyRequest{ValidatorIndices: indices})
+	if err != nil {
+		t.Fatal(err)
+	}
+	aggregatePub, err := bls.PublicKeyFromBytes(resp.AggregatePubkey)
+	if err != nil {
+		t.Fatalf("Could not deserialize aggregate public key: %v", err)
+	}
+
+	msg := []byte("message")
+	domain := params.BeaconConfig().DomainAttestation
+	var sigs []*bls.Signature
+	pubKeys := make([]*bls.PublicKey, 0, len(indices))
+	for _, idx := range indices {
+		sigs = append(sigs, privKeys[idx].Sign(msg, domain))
+		pubKeys = append(pubKeys, privKeys[idx].PublicKey())
+	}
+	aggregateSig := bls.AggregateSignatures(sigs)
+	if !aggregateSig.VerifyAggregate(pubKeys, msg, domain) {
+		t.Fatal("Expected the aggregate signature to verify against the individual public keys")
+	}
+	if !aggregateSig.Verify(msg, aggregatePub, domain) {
+		t.Error("Expected the aggregate signature to verify against the aggregate public key")
+	}
+
+	resp, err = vs.AggregatePublicKey(ctx, &pb.AggregatePublicKeyRequest{ValidatorIndices: []uint64{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(resp.AggregatePubkey, validators[1].Pubkey) {
+		t.Errorf("Expected the public key of a single validator %#x, received %#x", validators[1].Pubkey, resp.AggregatePubkey)
+	}
+}
+
+func TestAggregatePublicKey_InvalidIndices(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	priv, err := bls.RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		ValidatorRegistry: []*pbp2p.Validator{{Pubkey: priv.PublicKey().Marshal()}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	_, err = vs.AggregatePublicKey(ctx, &pb.AggregatePublicKeyRequest{ValidatorIndices: []uint64{0, 1}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument error, received %v", err)
+	}
+	want := "validator index 1 is out of range"
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %q, received %v", want, err)
+	}
+	if _, err := vs.AggregatePublicKey(ctx, &pb.AggregatePublicKeyRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument error for no indices, received %v", err)
+	}
+}
+
+func TestValidatePubkey_ValidKey(t *testing.T) {
+	priv, err := bls.RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := priv.PublicKey().Marshal()
+
+	vs := &ValidatorServer{}
+	res, err := vs.ValidatePubkey(context.Background(), &pb.ValidatePubkeyRequest{Pubkey: pubkey})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.Pubkey, pubkey) {
+		t.Errorf("Wanted public key %#x, received %#x", pubkey, res.Pubkey)
+	}
+}
+
+func TestValidatePubkey_MalformedKeys(t *testing.T) {
+	priv, err := bls.RandKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubkey := priv.PublicKey().Marshal()
+	tests := []struct {
+		pubkey []byte
+		want   string
+	}{
+		{pubkey: nil, want: "public key is 0 bytes long, wanted 48"},
+		{pubkey: pubkey[:47], want: "public key is 47 bytes long, wanted 48"},
+		{pubkey: append(pubkey, 0), want: "public key is 49 bytes long, wanted 48"},
+		{pubkey: bytes.Repeat([]byte{0xFF}, 48), want: "invalid public key"},
+	}
+
+	vs := &ValidatorServer{}
+	for _, tt := range tests {
+		_, err := vs.ValidatePubkey(context.Background(), &pb.ValidatePubkeyRequest{Pubkey: tt.pubkey})
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected InvalidArgument error for public key %#x, received %v", tt.pubkey, err)
+			continue
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Expected error to contain %q, received %v", tt.want, err)
+		}
+	}
+}
+
+func TestValidatorBalances_IndicesAndPublicKeys(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	maxDeposit := params.BeaconConfig().MaxDepositAmount
+	pubkey := func(i byte) []byte {
+		return bytes.Repeat([]byte{i}, compressedPubkeyLength)
+	}
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{
+		ValidatorRegistry: []*pbp2p.Validator{{Pubkey: pubkey(1)}, {Pubkey: pubkey(2)}},
+		ValidatorBalances: []uint64{maxDeposit + 5, maxDeposit - 5},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	res, err := vs.ValidatorBalances(ctx, &pb.ValidatorBalancesRequest{
+		ValidatorIndices: []uint64{1, 5},
+		PublicKeys:       [][]byte{pubkey(1), pubkey(3)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*pb.ValidatorBalancesResponse_Balance{
+		{ValidatorIndex: 1, PublicKey: pubkey(2), Found: true, Balance: maxDeposit - 5, EffectiveBalance: maxDeposit - 5},
+		{ValidatorIndex: 5},
+		{ValidatorIndex: 0, PublicKey: pubkey(1), Found: true, Balance: maxDeposit + 5, EffectiveBalance: maxDeposit},
+		{PublicKey: pubkey(3)},
+	}
+	if len(res.Balances) != len(want) {
+		t.Fatalf("Wanted %d balances, received %d", len(want), len(res.Balances))
+	}
+	for i := range want {
+		if !proto.Equal(res.Balances[i], want[i]) {
+			t.Errorf("Wanted balance %v at position %d, received %v", want[i], i, res.Balances[i])
+		}
+	}
+}
+
+func TestValidatorBalances_BatchTooLarge(t *testing.T) {
+	vs := &ValidatorServer{}
+	req := &pb.ValidatorBalancesRequest{
+		ValidatorIndices: make([]uint64, maxValidatorBalancesBatch),
+		PublicKeys:       [][]byte{{'A'}},
+	}
+	_, err := vs.ValidatorBalances(context.Background(), req)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument error, received %v", err)
+	}
+	want := fmt.Sprintf("requested the balances of %d validators", maxValidatorBalancesBatch+1)
+	if !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error to contain %q, received %v", want, err)
+	}
+}
+
+func saveCanonicalHistoricalState(t *testing.T, beaconDB *db.BeaconDB, beaconState *pbp2p.BeaconState) {
+	ctx := context.Background()
+	block := &pbp2p.BeaconBlock{Slot: beaconState.Slot}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(ctx, block, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	blockRoot, err := hashutil.HashBeaconBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveHistoricalState(ctx, beaconState, blockRoot); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestValidatorBalanceDelta_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	startSlot := params.BeaconConfig().GenesisSlot + 2
+	endSlot := params.BeaconConfig().GenesisSlot + 10
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot:              startSlot,
+		ValidatorRegistry: []*pbp2p.Validator{{Pubkey: []byte{'A'}}},
+		ValidatorBalances: []uint64{32},
+	})
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot:              endSlot,
+		ValidatorRegistry: []*pbp2p.Validator{{Pubkey: []byte{'A'}}, {Pubkey: []byte{'B'}}},
+		ValidatorBalances: []uint64{30, 16},
+	})
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+
+	tests := []struct {
+		validatorIndex uint64
+		want           *pb.ValidatorBalanceDeltaResponse
+	}{
+		{
+			validatorIndex: 0,
+			want:           &pb.ValidatorBalanceDeltaResponse{StartBalance: 32, EndBalance: 30, Delta: -2},
+		},
+		{
+			// The validator at index 1 was not in the registry at the start slot.
+			validatorIndex: 1,
+			want:           &pb.ValidatorBalanceDeltaResponse{StartBalance: 0, EndBalance: 16, Delta: 16},
+		},
+	}
+	for _, tt := range tests {
+		res, err := vs.ValidatorBalanceDelta(context.Background(), &pb.ValidatorBalanceDeltaRequest{
+			ValidatorIndex: tt.validatorIndex,
+			StartSlot:      startSlot,
+			EndSlot:        endSlot,
+		})
+		if err != nil {
+			t.Fatalf("Could not call RPC method: %v", err)
+		}
+		if !proto.Equal(res, tt.want) {
+			t.Errorf("Wanted %v for validator %d, received %v", tt.want, tt.validatorIndex, res)
+		}
+	}
+}
+
+func TestValidatorBalanceDelta_MissingHistoricalState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	startSlot := params.BeaconConfig().GenesisSlot + 2
+	saveCanonicalHistoricalState(t, db, &pbp2p.BeaconState{
+		Slot:              startSlot,
+		ValidatorRegistry: []*pbp2p.Validator{{Pubkey: []byte{'A'}}},
+		ValidatorBalances: []uint64{32},
+	})
+	vs := &ValidatorServer{
+		beaconDB: db,
+	}
+	_, err := vs.ValidatorBalanceDelta(context.Background(), &pb.ValidatorBalanceDeltaRequest{
+		StartSlot: startSlot,
+		EndSlot:   startSlot + 1,
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound error, received %v", err)
+	}
+	want := "no canonical block at slot 3"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error containing %q, received %v", want, err)
+	}
+}
+
+func TestValidatorAttestations_FiltersByCommitteePosition(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	helpers.RestartCommitteeCache()
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	attested, validatorIndex := attestationAtGenesis(t, beaconState)
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attested.Data.Slot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0].Committee
+	if len(committee) < 2 {
+		t.Fatalf("Expected a committee of at least 2 validators, received %d", len(committee))
+	}
+	// The second attestation only has the bit of the next committee member set.
+	notAttested := proto.Clone(attested).(*pbp2p.Attestation)
+	notAttested.AggregationBitfield, err = bitutil.SetBitfield(1, len(committee))
+	if err != nil {
+		t.Fatal(err)
+	}
+	blk := &pbp2p.BeaconBlock{
+		Slot: beaconState.Slot,
+		Body: &pbp2p.BeaconBlockBody{
+			Attestations: []*pbp2p.Attestation{notAttested, attested},
+		},
+	}
+	if err := db.SaveBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	res, err := vs.ValidatorAttestations(ctx, &pb.ValidatorAttestationsRequest{
+		ValidatorIndex: validatorIndex,
+		StartEpoch:     genesisEpoch,
+		EndEpoch:       genesisEpoch,
+	})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if len(res.Attestations) != 1 || !proto.Equal(res.Attestations[0], attested) {
+		t.Errorf("Wanted only attestation %v, received %v", attested, res.Attestations)
+	}
+}
+
+func TestValidatorAttested_ReportsIncludingBlock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	helpers.RestartCommitteeCache()
+
+	beaconState, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	attested, validatorIndex := attestationAtGenesis(t, beaconState)
+	committees, err := helpers.CrosslinkCommitteesAtSlot(beaconState, attested.Data.Slot, false /* registryChange */)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committee := committees[0].Committee
+	if len(committee) < 2 {
+		t.Fatalf("Expected a committee of at least 2 validators, received %d", len(committee))
+	}
+	blk := &pbp2p.BeaconBlock{
+		Slot: beaconState.Slot,
+		Body: &pbp2p.BeaconBlockBody{
+			Attestations: []*pbp2p.Attestation{attested},
+		},
+	}
+	if err := db.SaveBlock(blk); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	blkRoot, err := hashutil.HashBeaconBlock(blk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	res, err := vs.ValidatorAttested(ctx, &pb.ValidatorAttestedRequest{
+		ValidatorIndex: validatorIndex,
+		Slot:           attested.Data.Slot,
+	})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if !res.Attested || !bytes.Equal(res.BlockRoot, blkRoot[:]) || res.InclusionSlot != blk.Slot {
+		t.Errorf(
+			"Wanted attestation included in block %#x at slot %d, received %v",
+			blkRoot,
+			blk.Slot-params.BeaconConfig().GenesisSlot,
+			res,
+		)
+	}
+
+	// The next committee member's bit is not set in the included attestation.
+	res, err = vs.ValidatorAttested(ctx, &pb.ValidatorAttestedRequest{
+		ValidatorIndex: committee[1],
+		Slot:           attested.Data.Slot,
+	})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	if res.Attested || len(res.BlockRoot) != 0 {
+		t.Errorf("Expected validator %d to not have attested, received %v", committee[1], res)
+	}
+}
+
+func TestValidatorAttested_SlotBeyondHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: params.BeaconConfig().GenesisSlot + 2}); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{beaconDB: db}
+	_, err := vs.ValidatorAttested(ctx, &pb.ValidatorAttestedRequest{Slot: params.BeaconConfig().GenesisSlot + 3})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument error, received %v", err)
+	}
+	if !strings.Contains(err.Error(), "slot 3 is beyond the head slot 2") {
+		t.Errorf("Unexpected error message, received %v", err)
+	}
+}
+
+func TestValidatorAttestations_OutsideAvailableHistory(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: helpers.StartSlot(genesisEpoch + 3)}); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{beaconDB: db}
+	tests := []*pb.ValidatorAttestationsRequest{
+		{StartEpoch: genesisEpoch + 3, EndEpoch: genesisEpoch + 2},
+		{StartEpoch: genesisEpoch + 1, EndEpoch: genesisEpoch + 3},
+		{StartEpoch: genesisEpoch + 3, EndEpoch: genesisEpoch + 4},
+	}
+	for _, req := range tests {
+		if _, err := vs.ValidatorAttestations(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf(
+				"Expected InvalidArgument error for epochs %d-%d, received %v",
+				req.StartEpoch-genesisEpoch,
+				req.EndEpoch-genesisEpoch,
+				err,
+			)
+		}
+	}
+}
+
+func TestValidatorPerformanceSummary_OK(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+	helpers.RestartCommitteeCache()
+
+	genesis, err := genesisState(2 * params.BeaconConfig().SlotsPerEpoch)
+	if err != nil {
+		t.Fatalf("Could not setup genesis state: %v", err)
+	}
+	genesisSlot := params.BeaconConfig().GenesisSlot
+	nextEpochSlot := genesisSlot + params.BeaconConfig().SlotsPerEpoch
+	validatorIndex, err := helpers.BeaconProposerIndex(genesis, genesisSlot+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The validator proposes the block at the first slot after genesis and misses its
+	// remaining proposals in the epoch.
+	var missedProposals uint64
+	skipped := make(map[uint64]bool)
+	for slot := genesisSlot + 2; slot < nextEpochSlot; slot++ {
+		proposerIdx, err := helpers.BeaconProposerIndex(genesis, slot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if proposerIdx == validatorIndex {
+			skipped[slot] = true
+			missedProposals++
+		}
+	}
+	var attestation *pbp2p.Attestation
+	for slot := genesisSlot; slot < nextEpochSlot && attestation == nil; slot++ {
+		committees, err := helpers.CrosslinkCommitteesAtSlot(genesis, slot, false /* registryChange */)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, committee := range committees {
+			for i, idx := range committee.Committee {
+				if idx != validatorIndex {
+					continue
+				}
+				bitfield, err := bitutil.SetBitfield(i, len(committee.Committee))
+				if err != nil {
+					t.Fatal(err)
+				}
+				attestation = &pbp2p.Attestation{
+					Data:                &pbp2p.AttestationData{Slot: slot, Shard: committee.Shard},
+					AggregationBitfield: bitfield,
+				}
+			}
+		}
+	}
+	if attestation == nil {
+		t.Fatalf("Validator %d has no committee assignment in the genesis epoch", validatorIndex)
+	}
+
+	for slot := genesisSlot; slot <= nextEpochSlot; slot++ {
+		if skipped[slot] {
+			continue
+		}
+		beaconState := proto.Clone(genesis).(*pbp2p.BeaconState)
+		beaconState.Slot = slot
+		blk := &pbp2p.BeaconBlock{Slot: slot, Body: &pbp2p.BeaconBlockBody{}}
+		if slot == nextEpochSlot {
+			beaconState.ValidatorBalances[validatorIndex] += 1000
+			blk.Body.Attestations = []*pbp2p.Attestation{attestation}
+		}
+		if err := db.SaveBlock(blk); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, blk, beaconState); err != nil {
+			t.Fatal(err)
+		}
+		blkRoot, err := hashutil.HashBeaconBlock(blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveHistoricalState(ctx, beaconState, blkRoot); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	vs := &ValidatorServer{beaconDB: db}
+	res, err := vs.ValidatorPerformanceSummary(ctx, &pb.ValidatorPerformanceSummaryRequest{
+		ValidatorIndex: validatorIndex,
+		StartEpoch:     params.BeaconConfig().GenesisEpoch,
+		EndEpoch:       params.BeaconConfig().GenesisEpoch,
+	})
+	if err != nil {
+		t.Fatalf("Could not call RPC method: %v", err)
+	}
+	want := &pb.ValidatorPerformanceSummaryResponse{
+		EpochsActive:         1,
+		AttestationsIncluded: 1,
+		MissedDuties:         missedProposals,
+		Proposals:            1,
+		BalanceChange:        1000,
+	}
+	if !proto.Equal(res, want) {
+		t.Errorf("Wanted performance summary %v, received %v", want, res)
+	}
+}
+
+func TestValidatorPerformanceSummary_InvalidRange(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	genesisEpoch := params.BeaconConfig().GenesisEpoch
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: helpers.StartSlot(genesisEpoch + 3)}); err != nil {
+		t.Fatal(err)
+	}
+	vs := &ValidatorServer{beaconDB: db}
+	tests := []struct {
+		req  *pb.ValidatorPerformanceSummaryRequest
+		code codes.Code
+	}{
+		{
+			req:  &pb.ValidatorPerformanceSummaryRequest{StartEpoch: genesisEpoch + 2, EndEpoch: genesisEpoch + 1},
+			code: codes.InvalidArgument,
+		},
+		{
+			req:  &pb.ValidatorPerformanceSummaryRequest{StartEpoch: genesisEpoch, EndEpoch: genesisEpoch + maxPerformanceSummarySpan},
+			code: codes.InvalidArgument,
+		},
+		{
+			req:  &pb.ValidatorPerformanceSummaryRequest{StartEpoch: genesisEpoch + 1, EndEpoch: genesisEpoch + 3},
+			code: codes.InvalidArgument,
+		},
+		{
+			req:  &pb.ValidatorPerformanceSummaryRequest{StartEpoch: genesisEpoch, EndEpoch: genesisEpoch + 1},
+			code: codes.NotFound,
+		},
+	}
+	for _, tt := range tests {
+		if _, err := vs.ValidatorPerformanceSummary(ctx, tt.req); status.Code(err) != tt.code {
+			t.Errorf(
+				"Expected %v error for epochs %d-%d, received %v",
+				tt.code,
+				tt.req.StartEpoch-genesisEpoch,
+				tt.req.EndEpoch-genesisEpoch,
+				err,
+			)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package sync
 import (
 	"context"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -60,8 +61,10 @@ type Querier struct {
 	atGenesis                 bool
 	bestPeer                  peer.ID
 	chainHeadResponses        map[peer.ID]*pb.ChainHeadResponse
+	chainHeadReceived         bool
 	canonicalBlockRoot        []byte
 	finalizedBlockRoot        []byte
+	headLock                  sync.RWMutex
 }
 
 // NewQuerierService constructs a new Sync Querier Service.
@@ -170,10 +173,12 @@ func (q *Querier) run() {
 			q.RequestLatestHead()
 		case <-timeout:
 			queryLog.WithField("peerID", q.bestPeer.Pretty()).Info("Peer with highest canonical head")
+			q.headLock.RLock()
 			queryLog.Infof(
 				"Latest chain head is at slot: %d and state root: %#x",
 				q.currentHeadSlot-params.BeaconConfig().GenesisSlot, q.currentStateRoot,
 			)
+			q.headLock.RUnlock()
 			ticker.Stop()
 			responseSub.Unsubscribe()
 			q.cancel()
@@ -184,6 +189,9 @@ func (q *Querier) run() {
 			if !hasReceivedResponse {
 				timeout = time.After(10 * time.Second)
 				hasReceivedResponse = true
+				q.headLock.Lock()
+				q.chainHeadReceived = true
+				q.headLock.Unlock()
 			}
 			response := msg.Data.(*pb.ChainHeadResponse)
 			if _, ok := q.chainHeadResponses[msg.Peer]; !ok {
@@ -193,6 +201,7 @@ func (q *Querier) run() {
 				}).Info("Received chain head from peer")
 				q.chainHeadResponses[msg.Peer] = response
 			}
+			q.headLock.Lock()
 			if response.CanonicalSlot > q.currentHeadSlot {
 				q.currentHeadSlot = response.CanonicalSlot
 				q.currentStateRoot = response.CanonicalStateRootHash32
@@ -200,6 +209,7 @@ func (q *Querier) run() {
 				q.canonicalBlockRoot = response.CanonicalBlockRoot
 				q.finalizedBlockRoot = response.FinalizedBlockRoot
 			}
+			q.headLock.Unlock()
 		}
 	}
 }
@@ -211,6 +221,14 @@ func (q *Querier) RequestLatestHead() {
 	q.p2p.Broadcast(context.Background(), request)
 }
 
+// HighestObservedSlot returns the highest canonical head slot received from
+// peers, and whether any peer has responded with its chain head yet.
+func (q *Querier) HighestObservedSlot() (uint64, bool) {
+	q.headLock.RLock()
+	defer q.headLock.RUnlock()
+	return q.currentHeadSlot, q.chainHeadReceived
+}
+
 // IsSynced checks if the node is currently synced with the
 // rest of the network.
 func (q *Querier) IsSynced() (bool, error) {
@@ -229,6 +247,8 @@ func (q *Querier) IsSynced() (bool, error) {
 		return false, nil
 	}
 
+	q.headLock.RLock()
+	defer q.headLock.RUnlock()
 	if block.Slot >= q.currentHeadSlot {
 		return true, nil
 	}
@@ -167,6 +167,41 @@ func TestQuerier_ChainReqResponse(t *testing.T) {
 	hook.Reset()
 }
 
+func TestQuerier_HighestObservedSlotConcurrentAccess(t *testing.T) {
+	cfg := &QuerierConfig{
+		P2P:                &mockP2P{},
+		ResponseBufferSize: 100,
+		PowChain:           &afterGenesisPowChain{},
+	}
+	sq := NewQuerierService(context.Background(), cfg)
+
+	exitRoutine := make(chan bool)
+	go func() {
+		sq.run()
+		exitRoutine <- true
+	}()
+
+	highestSlot := params.BeaconConfig().GenesisSlot + 10
+	go func() {
+		for slot := params.BeaconConfig().GenesisSlot + 1; slot <= highestSlot; slot++ {
+			sq.responseBuf <- p2p.Message{
+				Data: &pb.ChainHeadResponse{CanonicalSlot: slot},
+			}
+		}
+	}()
+
+	for {
+		slot, received := sq.HighestObservedSlot()
+		if received && slot == highestSlot {
+			break
+		}
+	}
+
+	sq.cancel()
+	<-exitRoutine
+	close(exitRoutine)
+}
+
 func TestSyncedInGenesis(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -117,6 +117,12 @@ func (ss *Service) Status() error {
 	return nil
 }
 
+// HighestObservedSlot returns the highest chain head slot known among the node's
+// peers, and false if no peer has reported its chain head yet.
+func (ss *Service) HighestObservedSlot() (uint64, bool) {
+	return ss.Querier.HighestObservedSlot()
+}
+
 func (ss *Service) run() {
 	ss.Querier.Start()
 	synced, err := ss.Querier.IsSynced()
@@ -96,6 +96,98 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
 
+type SlashingOffense int32
+
+const (
+	SlashingOffense_UNKNOWN_OFFENSE SlashingOffense = 0
+	SlashingOffense_DOUBLE_PROPOSAL SlashingOffense = 1
+	SlashingOffense_DOUBLE_VOTE     SlashingOffense = 2
+	SlashingOffense_SURROUND_VOTE   SlashingOffense = 3
+)
+
+var SlashingOffense_name = map[int32]string{
+	0: "UNKNOWN_OFFENSE",
+	1: "DOUBLE_PROPOSAL",
+	2: "DOUBLE_VOTE",
+	3: "SURROUND_VOTE",
+}
+
+var SlashingOffense_value = map[string]int32{
+	"UNKNOWN_OFFENSE": 0,
+	"DOUBLE_PROPOSAL": 1,
+	"DOUBLE_VOTE":     2,
+	"SURROUND_VOTE":   3,
+}
+
+func (x SlashingOffense) String() string {
+	return proto.EnumName(SlashingOffense_name, int32(x))
+}
+
+func (SlashingOffense) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{2}
+}
+
+type BlockTreeResponse_FinalizationStatus int32
+
+const (
+	BlockTreeResponse_UNANNOTATED   BlockTreeResponse_FinalizationStatus = 0
+	BlockTreeResponse_NOT_JUSTIFIED BlockTreeResponse_FinalizationStatus = 1
+	BlockTreeResponse_JUSTIFIED     BlockTreeResponse_FinalizationStatus = 2
+	BlockTreeResponse_FINALIZED     BlockTreeResponse_FinalizationStatus = 3
+)
+
+var BlockTreeResponse_FinalizationStatus_name = map[int32]string{
+	0: "UNANNOTATED",
+	1: "NOT_JUSTIFIED",
+	2: "JUSTIFIED",
+	3: "FINALIZED",
+}
+
+var BlockTreeResponse_FinalizationStatus_value = map[string]int32{
+	"UNANNOTATED":   0,
+	"NOT_JUSTIFIED": 1,
+	"JUSTIFIED":     2,
+	"FINALIZED":     3,
+}
+
+func (x BlockTreeResponse_FinalizationStatus) String() string {
+	return proto.EnumName(BlockTreeResponse_FinalizationStatus_name, int32(x))
+}
+
+func (BlockTreeResponse_FinalizationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{29, 0}
+}
+
+type DepositStatusResponse_Status int32
+
+const (
+	// The node has no record of the deposit.
+	DepositStatusResponse_UNKNOWN DepositStatusResponse_Status = 0
+	// The deposit was seen in the deposit contract but is not yet processed into the state.
+	DepositStatusResponse_PENDING   DepositStatusResponse_Status = 1
+	DepositStatusResponse_PROCESSED DepositStatusResponse_Status = 2
+)
+
+var DepositStatusResponse_Status_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PENDING",
+	2: "PROCESSED",
+}
+
+var DepositStatusResponse_Status_value = map[string]int32{
+	"UNKNOWN":   0,
+	"PENDING":   1,
+	"PROCESSED": 2,
+}
+
+func (x DepositStatusResponse_Status) String() string {
+	return proto.EnumName(DepositStatusResponse_Status_name, int32(x))
+}
+
+func (DepositStatusResponse_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{83, 0}
+}
+
 type ValidatorPerformanceRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	PublicKey            []byte   `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
@@ -873,6 +965,61 @@ func (m *ProposerIndexResponse) GetIndex() uint64 {
 	return 0
 }
 
+type CurrentProposerResponse struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ValidatorIndex       uint64   `protobuf:"varint,2,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Pubkey               []byte   `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CurrentProposerResponse) Reset()         { *m = CurrentProposerResponse{} }
+func (m *CurrentProposerResponse) String() string { return proto.CompactTextString(m) }
+func (*CurrentProposerResponse) ProtoMessage()    {}
+func (*CurrentProposerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{15}
+}
+
+func (m *CurrentProposerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrentProposerResponse.Unmarshal(m, b)
+}
+func (m *CurrentProposerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrentProposerResponse.Marshal(b, m, deterministic)
+}
+func (m *CurrentProposerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrentProposerResponse.Merge(m, src)
+}
+func (m *CurrentProposerResponse) XXX_Size() int {
+	return xxx_messageInfo_CurrentProposerResponse.Size(m)
+}
+func (m *CurrentProposerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrentProposerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrentProposerResponse proto.InternalMessageInfo
+
+func (m *CurrentProposerResponse) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *CurrentProposerResponse) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *CurrentProposerResponse) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
 type StateRootResponse struct {
 	StateRoot            []byte   `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -884,7 +1031,7 @@ func (m *StateRootResponse) Reset()         { *m = StateRootResponse{} }
 func (m *StateRootResponse) String() string { return proto.CompactTextString(m) }
 func (*StateRootResponse) ProtoMessage()    {}
 func (*StateRootResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{15}
+	return fileDescriptor_9eb4e94b85965285, []int{16}
 }
 
 func (m *StateRootResponse) XXX_Unmarshal(b []byte) error {
@@ -923,7 +1070,7 @@ func (m *AttestResponse) Reset()         { *m = AttestResponse{} }
 func (m *AttestResponse) String() string { return proto.CompactTextString(m) }
 func (*AttestResponse) ProtoMessage()    {}
 func (*AttestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{16}
+	return fileDescriptor_9eb4e94b85965285, []int{17}
 }
 
 func (m *AttestResponse) XXX_Unmarshal(b []byte) error {
@@ -962,7 +1109,7 @@ func (m *ValidatorIndexRequest) Reset()         { *m = ValidatorIndexRequest{} }
 func (m *ValidatorIndexRequest) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexRequest) ProtoMessage()    {}
 func (*ValidatorIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{17}
+	return fileDescriptor_9eb4e94b85965285, []int{18}
 }
 
 func (m *ValidatorIndexRequest) XXX_Unmarshal(b []byte) error {
@@ -1001,7 +1148,7 @@ func (m *ValidatorIndexResponse) Reset()         { *m = ValidatorIndexResponse{}
 func (m *ValidatorIndexResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorIndexResponse) ProtoMessage()    {}
 func (*ValidatorIndexResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{18}
+	return fileDescriptor_9eb4e94b85965285, []int{19}
 }
 
 func (m *ValidatorIndexResponse) XXX_Unmarshal(b []byte) error {
@@ -1041,7 +1188,7 @@ func (m *CommitteeAssignmentsRequest) Reset()         { *m = CommitteeAssignment
 func (m *CommitteeAssignmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*CommitteeAssignmentsRequest) ProtoMessage()    {}
 func (*CommitteeAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{19}
+	return fileDescriptor_9eb4e94b85965285, []int{20}
 }
 
 func (m *CommitteeAssignmentsRequest) XXX_Unmarshal(b []byte) error {
@@ -1076,18 +1223,61 @@ func (m *CommitteeAssignmentsRequest) GetPublicKeys() [][]byte {
 	return nil
 }
 
+type PendingDepositsRequest struct {
+	// Caps the number of returned deposits. Zero or values above the MaxDeposits config
+	// value return up to MaxDeposits deposits.
+	MaxDeposits          uint64   `protobuf:"varint,1,opt,name=max_deposits,json=maxDeposits,proto3" json:"max_deposits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingDepositsRequest) Reset()         { *m = PendingDepositsRequest{} }
+func (m *PendingDepositsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingDepositsRequest) ProtoMessage()    {}
+func (*PendingDepositsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
+}
+
+func (m *PendingDepositsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingDepositsRequest.Unmarshal(m, b)
+}
+func (m *PendingDepositsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingDepositsRequest.Marshal(b, m, deterministic)
+}
+func (m *PendingDepositsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDepositsRequest.Merge(m, src)
+}
+func (m *PendingDepositsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingDepositsRequest.Size(m)
+}
+func (m *PendingDepositsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDepositsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDepositsRequest proto.InternalMessageInfo
+
+func (m *PendingDepositsRequest) GetMaxDeposits() uint64 {
+	if m != nil {
+		return m.MaxDeposits
+	}
+	return 0
+}
+
 type PendingDepositsResponse struct {
-	PendingDeposits      []*v1.Deposit `protobuf:"bytes,1,rep,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	PendingDeposits []*v1.Deposit `protobuf:"bytes,1,rep,name=pending_deposits,json=pendingDeposits,proto3" json:"pending_deposits,omitempty"`
+	// Readiness entries are index aligned with the pending deposits.
+	Readiness            []*DepositReadiness `protobuf:"bytes,2,rep,name=readiness,proto3" json:"readiness,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *PendingDepositsResponse) Reset()         { *m = PendingDepositsResponse{} }
 func (m *PendingDepositsResponse) String() string { return proto.CompactTextString(m) }
 func (*PendingDepositsResponse) ProtoMessage()    {}
 func (*PendingDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{20}
+	return fileDescriptor_9eb4e94b85965285, []int{22}
 }
 
 func (m *PendingDepositsResponse) XXX_Unmarshal(b []byte) error {
@@ -1115,6 +1305,70 @@ func (m *PendingDepositsResponse) GetPendingDeposits() []*v1.Deposit {
 	return nil
 }
 
+func (m *PendingDepositsResponse) GetReadiness() []*DepositReadiness {
+	if m != nil {
+		return m.Readiness
+	}
+	return nil
+}
+
+type DepositReadiness struct {
+	MerkleTreeIndex uint64 `protobuf:"varint,1,opt,name=merkle_tree_index,json=merkleTreeIndex,proto3" json:"merkle_tree_index,omitempty"`
+	// The eth1 block number at which the deposit passed the eth1 follow distance.
+	Eth1BlockNumber uint64 `protobuf:"varint,2,opt,name=eth1_block_number,json=eth1BlockNumber,proto3" json:"eth1_block_number,omitempty"`
+	// The timestamp of the eth1 block at which the deposit became ready.
+	Eth1BlockTime        uint64   `protobuf:"varint,3,opt,name=eth1_block_time,json=eth1BlockTime,proto3" json:"eth1_block_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositReadiness) Reset()         { *m = DepositReadiness{} }
+func (m *DepositReadiness) String() string { return proto.CompactTextString(m) }
+func (*DepositReadiness) ProtoMessage()    {}
+func (*DepositReadiness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+
+func (m *DepositReadiness) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositReadiness.Unmarshal(m, b)
+}
+func (m *DepositReadiness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositReadiness.Marshal(b, m, deterministic)
+}
+func (m *DepositReadiness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositReadiness.Merge(m, src)
+}
+func (m *DepositReadiness) XXX_Size() int {
+	return xxx_messageInfo_DepositReadiness.Size(m)
+}
+func (m *DepositReadiness) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositReadiness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositReadiness proto.InternalMessageInfo
+
+func (m *DepositReadiness) GetMerkleTreeIndex() uint64 {
+	if m != nil {
+		return m.MerkleTreeIndex
+	}
+	return 0
+}
+
+func (m *DepositReadiness) GetEth1BlockNumber() uint64 {
+	if m != nil {
+		return m.Eth1BlockNumber
+	}
+	return 0
+}
+
+func (m *DepositReadiness) GetEth1BlockTime() uint64 {
+	if m != nil {
+		return m.Eth1BlockTime
+	}
+	return 0
+}
+
 type CommitteeAssignmentResponse struct {
 	Assignment           []*CommitteeAssignmentResponse_CommitteeAssignment `protobuf:"bytes,1,rep,name=assignment,proto3" json:"assignment,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                                           `json:"-"`
@@ -1126,7 +1380,7 @@ func (m *CommitteeAssignmentResponse) Reset()         { *m = CommitteeAssignment
 func (m *CommitteeAssignmentResponse) String() string { return proto.CompactTextString(m) }
 func (*CommitteeAssignmentResponse) ProtoMessage()    {}
 func (*CommitteeAssignmentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{21}
+	return fileDescriptor_9eb4e94b85965285, []int{24}
 }
 
 func (m *CommitteeAssignmentResponse) XXX_Unmarshal(b []byte) error {
@@ -1174,7 +1428,7 @@ func (m *CommitteeAssignmentResponse_CommitteeAssignment) String() string {
 }
 func (*CommitteeAssignmentResponse_CommitteeAssignment) ProtoMessage() {}
 func (*CommitteeAssignmentResponse_CommitteeAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{21, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{24, 0}
 }
 
 func (m *CommitteeAssignmentResponse_CommitteeAssignment) XXX_Unmarshal(b []byte) error {
@@ -1252,7 +1506,7 @@ func (m *ValidatorStatusResponse) Reset()         { *m = ValidatorStatusResponse
 func (m *ValidatorStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*ValidatorStatusResponse) ProtoMessage()    {}
 func (*ValidatorStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{22}
+	return fileDescriptor_9eb4e94b85965285, []int{25}
 }
 
 func (m *ValidatorStatusResponse) XXX_Unmarshal(b []byte) error {
@@ -1319,7 +1573,7 @@ func (m *Eth1DataResponse) Reset()         { *m = Eth1DataResponse{} }
 func (m *Eth1DataResponse) String() string { return proto.CompactTextString(m) }
 func (*Eth1DataResponse) ProtoMessage()    {}
 func (*Eth1DataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{23}
+	return fileDescriptor_9eb4e94b85965285, []int{26}
 }
 
 func (m *Eth1DataResponse) XXX_Unmarshal(b []byte) error {
@@ -1347,6 +1601,105 @@ func (m *Eth1DataResponse) GetEth1Data() *v1.Eth1Data {
 	return nil
 }
 
+type BlockTreeRequest struct {
+	// Annotate finalization tags every tree node with its finalization status relative to the head state.
+	AnnotateFinalization bool `protobuf:"varint,1,opt,name=annotate_finalization,json=annotateFinalization,proto3" json:"annotate_finalization,omitempty"`
+	// Validator range limits the participated votes of every tree node to the votes of the validators
+	// in the range, while the total votes still cover every active validator. Votes of all validators
+	// are attributed if unset.
+	ValidatorRange       *ValidatorIndexRange `protobuf:"bytes,2,opt,name=validator_range,json=validatorRange,proto3" json:"validator_range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BlockTreeRequest) Reset()         { *m = BlockTreeRequest{} }
+func (m *BlockTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockTreeRequest) ProtoMessage()    {}
+func (*BlockTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{27}
+}
+
+func (m *BlockTreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockTreeRequest.Unmarshal(m, b)
+}
+func (m *BlockTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockTreeRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTreeRequest.Merge(m, src)
+}
+func (m *BlockTreeRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockTreeRequest.Size(m)
+}
+func (m *BlockTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTreeRequest proto.InternalMessageInfo
+
+func (m *BlockTreeRequest) GetAnnotateFinalization() bool {
+	if m != nil {
+		return m.AnnotateFinalization
+	}
+	return false
+}
+
+func (m *BlockTreeRequest) GetValidatorRange() *ValidatorIndexRange {
+	if m != nil {
+		return m.ValidatorRange
+	}
+	return nil
+}
+
+type ValidatorIndexRange struct {
+	StartIndex uint64 `protobuf:"varint,1,opt,name=start_index,json=startIndex,proto3" json:"start_index,omitempty"`
+	// The end of the range, exclusive.
+	EndIndex             uint64   `protobuf:"varint,2,opt,name=end_index,json=endIndex,proto3" json:"end_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorIndexRange) Reset()         { *m = ValidatorIndexRange{} }
+func (m *ValidatorIndexRange) String() string { return proto.CompactTextString(m) }
+func (*ValidatorIndexRange) ProtoMessage()    {}
+func (*ValidatorIndexRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{28}
+}
+
+func (m *ValidatorIndexRange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorIndexRange.Unmarshal(m, b)
+}
+func (m *ValidatorIndexRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorIndexRange.Marshal(b, m, deterministic)
+}
+func (m *ValidatorIndexRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorIndexRange.Merge(m, src)
+}
+func (m *ValidatorIndexRange) XXX_Size() int {
+	return xxx_messageInfo_ValidatorIndexRange.Size(m)
+}
+func (m *ValidatorIndexRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorIndexRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorIndexRange proto.InternalMessageInfo
+
+func (m *ValidatorIndexRange) GetStartIndex() uint64 {
+	if m != nil {
+		return m.StartIndex
+	}
+	return 0
+}
+
+func (m *ValidatorIndexRange) GetEndIndex() uint64 {
+	if m != nil {
+		return m.EndIndex
+	}
+	return 0
+}
+
 type BlockTreeResponse struct {
 	Tree                 []*BlockTreeResponse_TreeNode `protobuf:"bytes,1,rep,name=tree,proto3" json:"tree,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
@@ -1358,7 +1711,7 @@ func (m *BlockTreeResponse) Reset()         { *m = BlockTreeResponse{} }
 func (m *BlockTreeResponse) String() string { return proto.CompactTextString(m) }
 func (*BlockTreeResponse) ProtoMessage()    {}
 func (*BlockTreeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{24}
+	return fileDescriptor_9eb4e94b85965285, []int{29}
 }
 
 func (m *BlockTreeResponse) XXX_Unmarshal(b []byte) error {
@@ -1387,20 +1740,22 @@ func (m *BlockTreeResponse) GetTree() []*BlockTreeResponse_TreeNode {
 }
 
 type BlockTreeResponse_TreeNode struct {
-	Block                *v1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
-	BlockRoot            []byte          `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
-	ParticipatedVotes    uint64          `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
-	TotalVotes           uint64          `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Block             *v1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot         []byte          `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ParticipatedVotes uint64          `protobuf:"varint,3,opt,name=participated_votes,json=participatedVotes,proto3" json:"participated_votes,omitempty"`
+	TotalVotes        uint64          `protobuf:"varint,4,opt,name=total_votes,json=totalVotes,proto3" json:"total_votes,omitempty"`
+	// Only set if finalization annotation was requested.
+	FinalizationStatus   BlockTreeResponse_FinalizationStatus `protobuf:"varint,5,opt,name=finalization_status,json=finalizationStatus,proto3,enum=ethereum.beacon.rpc.v1.BlockTreeResponse_FinalizationStatus" json:"finalization_status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
 }
 
 func (m *BlockTreeResponse_TreeNode) Reset()         { *m = BlockTreeResponse_TreeNode{} }
 func (m *BlockTreeResponse_TreeNode) String() string { return proto.CompactTextString(m) }
 func (*BlockTreeResponse_TreeNode) ProtoMessage()    {}
 func (*BlockTreeResponse_TreeNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{24, 0}
+	return fileDescriptor_9eb4e94b85965285, []int{29, 0}
 }
 
 func (m *BlockTreeResponse_TreeNode) XXX_Unmarshal(b []byte) error {
@@ -1449,6 +1804,13 @@ func (m *BlockTreeResponse_TreeNode) GetTotalVotes() uint64 {
 	return 0
 }
 
+func (m *BlockTreeResponse_TreeNode) GetFinalizationStatus() BlockTreeResponse_FinalizationStatus {
+	if m != nil {
+		return m.FinalizationStatus
+	}
+	return BlockTreeResponse_UNANNOTATED
+}
+
 type TreeBlockSlotRequest struct {
 	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
 	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
@@ -1461,7 +1823,7 @@ func (m *TreeBlockSlotRequest) Reset()         { *m = TreeBlockSlotRequest{} }
 func (m *TreeBlockSlotRequest) String() string { return proto.CompactTextString(m) }
 func (*TreeBlockSlotRequest) ProtoMessage()    {}
 func (*TreeBlockSlotRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9eb4e94b85965285, []int{25}
+	return fileDescriptor_9eb4e94b85965285, []int{30}
 }
 
 func (m *TreeBlockSlotRequest) XXX_Unmarshal(b []byte) error {
@@ -1496,498 +1858,6683 @@ func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
-	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
-	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
-	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
-	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
-	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
-	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
-	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
-	proto.RegisterType((*AttestationDataRequest)(nil), "ethereum.beacon.rpc.v1.AttestationDataRequest")
-	proto.RegisterType((*AttestationDataResponse)(nil), "ethereum.beacon.rpc.v1.AttestationDataResponse")
-	proto.RegisterType((*PendingAttestationsRequest)(nil), "ethereum.beacon.rpc.v1.PendingAttestationsRequest")
-	proto.RegisterType((*PendingAttestationsResponse)(nil), "ethereum.beacon.rpc.v1.PendingAttestationsResponse")
-	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
-	proto.RegisterType((*ProposeRequest)(nil), "ethereum.beacon.rpc.v1.ProposeRequest")
-	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
-	proto.RegisterType((*ProposerIndexRequest)(nil), "ethereum.beacon.rpc.v1.ProposerIndexRequest")
-	proto.RegisterType((*ProposerIndexResponse)(nil), "ethereum.beacon.rpc.v1.ProposerIndexResponse")
-	proto.RegisterType((*StateRootResponse)(nil), "ethereum.beacon.rpc.v1.StateRootResponse")
-	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
-	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
-	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
-	proto.RegisterType((*CommitteeAssignmentsRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentsRequest")
-	proto.RegisterType((*PendingDepositsResponse)(nil), "ethereum.beacon.rpc.v1.PendingDepositsResponse")
-	proto.RegisterType((*CommitteeAssignmentResponse)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentResponse")
-	proto.RegisterType((*CommitteeAssignmentResponse_CommitteeAssignment)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentResponse.CommitteeAssignment")
-	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
-	proto.RegisterType((*Eth1DataResponse)(nil), "ethereum.beacon.rpc.v1.Eth1DataResponse")
-	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
-	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
-	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+type DetectedSlashingsResponse struct {
+	Slashings            []*DetectedSlashingsResponse_DetectedSlashing `protobuf:"bytes,1,rep,name=slashings,proto3" json:"slashings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
+func (m *DetectedSlashingsResponse) Reset()         { *m = DetectedSlashingsResponse{} }
+func (m *DetectedSlashingsResponse) String() string { return proto.CompactTextString(m) }
+func (*DetectedSlashingsResponse) ProtoMessage()    {}
+func (*DetectedSlashingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{31}
+}
 
-var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 2262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x4d, 0x6f, 0x1b, 0xc7,
-	0xf9, 0xcf, 0x52, 0x2f, 0x96, 0x1e, 0xc9, 0x12, 0x35, 0x7a, 0x35, 0x65, 0xc3, 0x9b, 0xcd, 0x1f,
-	0xb1, 0x2c, 0x44, 0x4b, 0x99, 0x0e, 0x9c, 0x44, 0x86, 0x91, 0x50, 0x12, 0x25, 0x2b, 0x11, 0x68,
-	0x79, 0x49, 0xdb, 0xff, 0x16, 0x05, 0xb6, 0xc3, 0xe5, 0x88, 0xdc, 0x88, 0xdc, 0x59, 0xef, 0x0c,
-	0x19, 0xb3, 0x87, 0x14, 0xed, 0xa5, 0x28, 0x7a, 0x73, 0xef, 0xcd, 0x97, 0x28, 0x50, 0xa0, 0x87,
-	0x02, 0xfd, 0x0c, 0x3d, 0x16, 0xe8, 0xa1, 0x08, 0xda, 0x7b, 0x3f, 0x41, 0x31, 0xb3, 0xb3, 0xcb,
-	0x15, 0xc9, 0x95, 0xa8, 0x9e, 0xc4, 0x7d, 0x5e, 0x67, 0x7e, 0xf3, 0xbc, 0xcd, 0x08, 0x0c, 0x3f,
-	0xa0, 0x9c, 0xe6, 0x6b, 0x04, 0x3b, 0xd4, 0xcb, 0x07, 0xbe, 0x93, 0xef, 0x3e, 0xca, 0x33, 0x12,
-	0x74, 0x5d, 0x87, 0x30, 0x53, 0x32, 0xd1, 0x1a, 0xe1, 0x4d, 0x12, 0x90, 0x4e, 0xdb, 0x0c, 0xc5,
-	0xcc, 0xc0, 0x77, 0xcc, 0xee, 0xa3, 0xdc, 0x66, 0x83, 0xd2, 0x46, 0x8b, 0xe4, 0xa5, 0x54, 0xad,
-	0x73, 0x9e, 0x27, 0x6d, 0x9f, 0xf7, 0x42, 0xa5, 0xdc, 0xfd, 0x41, 0x26, 0x77, 0xdb, 0x84, 0x71,
-	0xdc, 0xf6, 0x23, 0x81, 0x4b, 0x9e, 0xfd, 0x82, 0x2f, 0x3c, 0xf3, 0x9e, 0x1f, 0xb9, 0xcd, 0xdd,
-	0x55, 0x16, 0xb0, 0xef, 0xe6, 0xb1, 0xe7, 0x51, 0x8e, 0xb9, 0x4b, 0xbd, 0x88, 0xfb, 0x89, 0xfc,
-	0xe3, 0xec, 0x34, 0x88, 0xb7, 0xc3, 0xbe, 0xc3, 0x8d, 0x06, 0x09, 0xf2, 0xd4, 0x97, 0x12, 0xc3,
-	0xd2, 0xc6, 0x19, 0x6c, 0xbe, 0xc6, 0x2d, 0xb7, 0x8e, 0x39, 0x0d, 0xce, 0x48, 0x70, 0x4e, 0x83,
-	0x36, 0xf6, 0x1c, 0x62, 0x91, 0xb7, 0x1d, 0xc2, 0x38, 0x42, 0x30, 0xc9, 0x5a, 0x94, 0x6f, 0x68,
-	0xba, 0xb6, 0x35, 0x69, 0xc9, 0xdf, 0xe8, 0x1e, 0x80, 0xdf, 0xa9, 0xb5, 0x5c, 0xc7, 0xbe, 0x20,
-	0xbd, 0x8d, 0x8c, 0xae, 0x6d, 0xcd, 0x5b, 0xb3, 0x21, 0xe5, 0x1b, 0xd2, 0x33, 0x7e, 0xd4, 0xe0,
-	0xee, 0x68, 0x93, 0xcc, 0xa7, 0x1e, 0x23, 0x68, 0x03, 0x6e, 0xd5, 0x70, 0x4b, 0x90, 0x94, 0xd9,
-	0xe8, 0x13, 0x3d, 0x84, 0x2c, 0xa7, 0x1c, 0xb7, 0xec, 0x6e, 0xa4, 0xcf, 0xa4, 0xfd, 0x49, 0x6b,
-	0x51, 0xd2, 0x63, 0xb3, 0x0c, 0x3d, 0x81, 0xf5, 0x50, 0x14, 0x3b, 0xdc, 0xed, 0x92, 0xa4, 0xc6,
-	0x84, 0xd4, 0x58, 0x95, 0xec, 0xa2, 0xe4, 0x26, 0xf4, 0x8e, 0x41, 0xc7, 0x5d, 0x12, 0xe0, 0x06,
-	0x19, 0xd2, 0xb4, 0xa3, 0x55, 0x4d, 0xea, 0xda, 0x56, 0xc6, 0xba, 0xa7, 0xe4, 0x06, 0x4c, 0xec,
-	0x87, 0x42, 0xc6, 0x33, 0xc8, 0xc5, 0x34, 0x29, 0x22, 0x61, 0x8d, 0x70, 0xbb, 0x0f, 0x73, 0x7d,
-	0x8c, 0xd8, 0x86, 0xa6, 0x4f, 0x6c, 0xcd, 0x5b, 0x10, 0x83, 0xc4, 0x8c, 0x1f, 0x32, 0x09, 0xe0,
-	0x93, 0xfa, 0x0a, 0xa4, 0x27, 0xb0, 0x8a, 0x43, 0x2a, 0xa9, 0xdb, 0x43, 0xa6, 0xf6, 0x33, 0x1b,
-	0x9a, 0xb5, 0x1c, 0x0b, 0x9c, 0xc5, 0x76, 0xd1, 0x6b, 0x98, 0x61, 0x1c, 0xf3, 0x0e, 0x23, 0x02,
-	0xba, 0x89, 0xad, 0xb9, 0xc2, 0x9e, 0x39, 0x3a, 0x4a, 0xcd, 0x2b, 0xdc, 0x9b, 0x15, 0x69, 0xc3,
-	0x8a, 0x6d, 0xe5, 0x7c, 0x98, 0x0e, 0x69, 0x03, 0xc7, 0xaf, 0x0d, 0x1c, 0x3f, 0x3a, 0x86, 0xe9,
-	0x50, 0x49, 0x9e, 0xdc, 0x5c, 0x21, 0x7f, 0xad, 0x7b, 0xe5, 0x4b, 0xb9, 0xb6, 0x94, 0xba, 0xb1,
-	0x07, 0xeb, 0xa5, 0x77, 0x2e, 0x27, 0xf5, 0xfe, 0xe9, 0x8d, 0x8d, 0xee, 0x53, 0xd8, 0x18, 0xd6,
-	0x55, 0xc8, 0x5e, 0xab, 0xbc, 0x0f, 0x6b, 0x45, 0xce, 0x45, 0x4a, 0x0a, 0x48, 0x0e, 0x31, 0xc7,
-	0x91, 0xdf, 0x15, 0x98, 0x62, 0x4d, 0x1c, 0xd4, 0x55, 0xdc, 0x86, 0x1f, 0x71, 0x8e, 0x64, 0xfa,
-	0x39, 0x62, 0xfc, 0x33, 0x03, 0xeb, 0x43, 0x46, 0xd4, 0x02, 0x3e, 0x83, 0x8d, 0x10, 0x09, 0xbb,
-	0xd6, 0xa2, 0xce, 0x85, 0x1d, 0x50, 0xca, 0xed, 0x26, 0x66, 0xcd, 0xc7, 0x05, 0x05, 0xe7, 0x6a,
-	0xc8, 0xdf, 0x17, 0x6c, 0x8b, 0x52, 0xfe, 0x5c, 0x32, 0xd1, 0x53, 0xc8, 0x11, 0x9f, 0x3a, 0x4d,
-	0xbb, 0x46, 0x3b, 0x5e, 0x1d, 0x07, 0xbd, 0x4b, 0xaa, 0x61, 0x22, 0xae, 0x4b, 0x89, 0x7d, 0x25,
-	0x90, 0x50, 0x7e, 0x00, 0x8b, 0xdf, 0x76, 0x18, 0x77, 0xcf, 0x5d, 0x52, 0xb7, 0xa5, 0x90, 0x4a,
-	0x94, 0x85, 0x98, 0x5c, 0x12, 0x54, 0xf4, 0x0c, 0x36, 0xfb, 0x82, 0xc3, 0x2b, 0x9c, 0x94, 0x6e,
-	0x36, 0x62, 0x91, 0xc1, 0x45, 0x9e, 0x42, 0xb6, 0x85, 0xc5, 0xc6, 0x6d, 0x27, 0xa0, 0x8c, 0xb5,
-	0x5c, 0xef, 0x62, 0x63, 0x4a, 0x46, 0xc2, 0x87, 0x43, 0x91, 0xe0, 0x17, 0x7c, 0x11, 0x09, 0x07,
-	0x91, 0xa0, 0xb5, 0x18, 0xaa, 0xc6, 0x04, 0xb4, 0x09, 0xb3, 0x4d, 0x82, 0xeb, 0xb6, 0x04, 0x78,
-	0x5a, 0xae, 0x77, 0x46, 0x10, 0x2a, 0x02, 0xe4, 0xdf, 0x6a, 0x90, 0x3b, 0x23, 0x5e, 0xdd, 0xf5,
-	0x1a, 0x09, 0xac, 0xe3, 0x28, 0x79, 0x0a, 0xb9, 0x73, 0xb7, 0xc5, 0x49, 0x60, 0x07, 0x04, 0xd7,
-	0x7b, 0xf6, 0x39, 0x0d, 0x6c, 0xd7, 0x73, 0x5a, 0x1d, 0xe6, 0x52, 0x4f, 0x22, 0x3d, 0x63, 0xad,
-	0x87, 0x12, 0x96, 0x10, 0x38, 0xa2, 0xc1, 0x49, 0xc4, 0x46, 0x26, 0x2c, 0xfb, 0x01, 0xf5, 0x29,
-	0xc3, 0x2d, 0x05, 0x42, 0xe2, 0x8c, 0x97, 0x22, 0x96, 0xdc, 0xbc, 0x5c, 0x4b, 0x07, 0x36, 0x47,
-	0x2e, 0x45, 0x9d, 0xf9, 0x6b, 0x58, 0xf1, 0x43, 0xb6, 0x8d, 0x13, 0x7c, 0x19, 0x7d, 0x73, 0x85,
-	0x8f, 0xd2, 0x90, 0x49, 0xd8, 0xb2, 0x96, 0xfd, 0x61, 0xfb, 0xc6, 0x4b, 0x40, 0x07, 0x4d, 0xec,
-	0x7a, 0x15, 0x8e, 0x03, 0x9e, 0xac, 0xb0, 0x4c, 0x10, 0x48, 0x5d, 0x6d, 0x33, 0xfa, 0x44, 0x1f,
-	0xc2, 0x7c, 0x83, 0x78, 0x84, 0xb9, 0xcc, 0x16, 0x6d, 0x47, 0xed, 0x67, 0x4e, 0xd1, 0xaa, 0x6e,
-	0x9b, 0x18, 0x7f, 0xc8, 0xc0, 0xc2, 0x99, 0xdc, 0x1f, 0x49, 0xe6, 0x1b, 0x0e, 0x88, 0x17, 0x06,
-	0x81, 0x0a, 0x52, 0x08, 0x49, 0xe2, 0xd8, 0x85, 0x80, 0x80, 0xc7, 0xf6, 0x3a, 0xed, 0x1a, 0x09,
-	0x94, 0x55, 0x10, 0xa4, 0xb2, 0xa4, 0xa0, 0x8f, 0xe0, 0x76, 0x80, 0xbd, 0x3a, 0xa6, 0x76, 0x40,
-	0xba, 0x04, 0xb7, 0x64, 0xec, 0xcd, 0x5b, 0xf3, 0x21, 0xd1, 0x92, 0x34, 0x94, 0x87, 0xe5, 0x04,
-	0x38, 0x76, 0xcd, 0xe5, 0x6d, 0xcc, 0x2e, 0x54, 0xc4, 0xa1, 0x04, 0x6b, 0x3f, 0xe4, 0xa0, 0x3d,
-	0xb8, 0x93, 0x54, 0xc0, 0x8d, 0x46, 0x40, 0x1a, 0x98, 0x13, 0x9b, 0xb9, 0x8d, 0x8d, 0x29, 0x7d,
-	0x62, 0x6b, 0xd2, 0x5a, 0x4f, 0x08, 0x14, 0x23, 0x7e, 0xc5, 0x6d, 0xa0, 0xcf, 0x61, 0x36, 0x6e,
-	0xbc, 0x32, 0xb2, 0xe6, 0x0a, 0x39, 0x33, 0x6c, 0xac, 0x66, 0xd4, 0x9a, 0xcd, 0x6a, 0x24, 0x61,
-	0xf5, 0x85, 0x8d, 0x67, 0xb0, 0x18, 0xe3, 0xa3, 0x00, 0xdf, 0x86, 0xa5, 0xb4, 0x5c, 0x5e, 0xac,
-	0x5d, 0x4e, 0x10, 0xe3, 0x33, 0x58, 0x51, 0xea, 0xc1, 0x89, 0x57, 0x27, 0xef, 0x12, 0x20, 0x27,
-	0x31, 0xd4, 0x06, 0x31, 0x34, 0x76, 0x60, 0x75, 0x40, 0x51, 0x79, 0x5f, 0x81, 0x29, 0x57, 0x10,
-	0xa2, 0xb2, 0x24, 0x3f, 0x8c, 0x02, 0x2c, 0x89, 0xca, 0x4a, 0x84, 0xeb, 0x58, 0xf4, 0x1e, 0x80,
-	0x00, 0x83, 0xc8, 0x85, 0x46, 0xc5, 0x9b, 0x45, 0x62, 0xc6, 0x53, 0x58, 0x08, 0xc3, 0x2b, 0x56,
-	0x78, 0x08, 0xd9, 0x24, 0xc4, 0x89, 0xf3, 0x5f, 0x4c, 0xd0, 0xc5, 0xd6, 0x8c, 0x27, 0xb0, 0x1a,
-	0x97, 0xdb, 0x4b, 0x3b, 0xbb, 0xba, 0x63, 0x18, 0x26, 0xac, 0x0d, 0xea, 0x5d, 0xb9, 0x31, 0x1b,
-	0x36, 0x0f, 0x68, 0xbb, 0xed, 0x72, 0x4e, 0x48, 0x91, 0x31, 0xb7, 0xe1, 0xb5, 0x89, 0xc7, 0x93,
-	0xcd, 0x21, 0xac, 0x92, 0x32, 0xe6, 0x23, 0x1c, 0x25, 0x49, 0x66, 0xc9, 0x60, 0x03, 0xc8, 0x0c,
-	0x35, 0x00, 0x02, 0xeb, 0x2a, 0x97, 0x0f, 0x89, 0x4f, 0x99, 0xcb, 0xfb, 0x79, 0xfc, 0x35, 0x64,
-	0xa3, 0x3c, 0xae, 0x2b, 0x9e, 0xca, 0xe1, 0xfb, 0x69, 0x39, 0xac, 0x6c, 0x58, 0x8b, 0xfe, 0x65,
-	0x9b, 0xc6, 0xbf, 0x33, 0x23, 0x37, 0x12, 0xfb, 0x6a, 0x00, 0xe0, 0x98, 0xaa, 0xbc, 0x1c, 0xa7,
-	0x75, 0xd3, 0x2b, 0x0c, 0x8d, 0xe4, 0x25, 0x4c, 0xe7, 0xfe, 0xa1, 0xc1, 0xf2, 0x08, 0x19, 0x74,
-	0x17, 0x66, 0x9d, 0x88, 0x2c, 0xfd, 0x4f, 0x5a, 0x7d, 0x42, 0xbf, 0x19, 0x66, 0x46, 0x35, 0xc3,
-	0x89, 0xc4, 0xc0, 0x78, 0x1f, 0xe6, 0x5c, 0x66, 0xfb, 0x2a, 0x76, 0x65, 0x3e, 0xcf, 0x58, 0xe0,
-	0xb2, 0x28, 0x9a, 0x07, 0x02, 0x64, 0x6a, 0x70, 0xa4, 0xf8, 0x32, 0x1e, 0x29, 0x44, 0x9e, 0x2e,
-	0x14, 0x1e, 0x8c, 0x3b, 0x52, 0x44, 0xa3, 0xc4, 0x9f, 0x32, 0xb0, 0x9e, 0x32, 0x6e, 0x24, 0x8c,
-	0x6b, 0xff, 0x93, 0x71, 0xf4, 0x05, 0xdc, 0x21, 0xbc, 0xf9, 0x28, 0x8a, 0x07, 0xd5, 0x2d, 0x2e,
-	0x55, 0x42, 0x71, 0x4f, 0x78, 0xa4, 0xce, 0x5d, 0xb6, 0x0c, 0x55, 0x15, 0x3f, 0x85, 0xb5, 0x48,
-	0x2b, 0x6e, 0x4c, 0x76, 0x02, 0xbe, 0x15, 0xc5, 0x8d, 0xdb, 0x92, 0x68, 0x35, 0x32, 0x25, 0xe3,
-	0x89, 0x4d, 0xb5, 0xf2, 0xc9, 0x70, 0x4a, 0xee, 0xd3, 0xc3, 0x5e, 0xfe, 0x25, 0xdc, 0x95, 0x06,
-	0x84, 0xa0, 0xeb, 0xd9, 0x09, 0xb5, 0xb7, 0x1d, 0xd2, 0x21, 0x12, 0xea, 0x49, 0xeb, 0x4e, 0x24,
-	0x73, 0xe2, 0xf5, 0x47, 0xc1, 0x97, 0x42, 0xc0, 0x78, 0x09, 0xd9, 0x92, 0x58, 0x7b, 0x72, 0x7e,
-	0x79, 0x06, 0xb3, 0xe1, 0x86, 0x31, 0xc7, 0x12, 0xb4, 0xb9, 0x82, 0x9e, 0x16, 0xfc, 0xb1, 0xf2,
-	0x0c, 0x51, 0xbf, 0x8c, 0xf7, 0x19, 0x58, 0x92, 0x20, 0x54, 0x03, 0xd2, 0xaf, 0xa0, 0x47, 0x30,
-	0xc9, 0x03, 0x15, 0x66, 0x73, 0x85, 0x42, 0xda, 0x21, 0x0c, 0x29, 0x9a, 0xe2, 0xa3, 0x4c, 0xeb,
-	0xc4, 0x92, 0xfa, 0xb9, 0x3f, 0x6a, 0x30, 0x13, 0x91, 0xd0, 0x17, 0x30, 0x25, 0x4f, 0x43, 0xad,
-	0x32, 0xb5, 0xcd, 0xee, 0x27, 0xc6, 0xad, 0x50, 0x43, 0x84, 0x64, 0xbf, 0xa2, 0x47, 0x97, 0x9c,
-	0xb8, 0x94, 0xa3, 0x1d, 0x40, 0x3e, 0x0e, 0xb8, 0xeb, 0xb8, 0xbe, 0x9c, 0xd0, 0xbb, 0x94, 0x93,
-	0xe8, 0xe6, 0xb1, 0x94, 0xe4, 0xbc, 0x16, 0x0c, 0x91, 0x01, 0xea, 0x62, 0x23, 0xe5, 0xc2, 0xd3,
-	0x82, 0xf0, 0x4e, 0x23, 0x28, 0xc6, 0x29, 0xac, 0x88, 0x55, 0xc7, 0xf3, 0x44, 0x54, 0xcc, 0x36,
-	0x61, 0x56, 0x36, 0x85, 0xf3, 0x80, 0xb6, 0x55, 0x29, 0x9b, 0x11, 0x84, 0xa3, 0x80, 0xb6, 0xd1,
-	0x3a, 0xdc, 0x92, 0x4c, 0x4e, 0x55, 0x9c, 0x4d, 0x8b, 0xcf, 0x2a, 0xdd, 0xfe, 0x1c, 0x6e, 0xc7,
-	0xd1, 0x6a, 0xd1, 0x16, 0x41, 0x73, 0x70, 0xeb, 0x55, 0xf9, 0x9b, 0xf2, 0x8b, 0x37, 0xe5, 0xec,
-	0x07, 0x68, 0x1e, 0x66, 0x8a, 0xd5, 0x6a, 0xa9, 0x52, 0x2d, 0x59, 0x59, 0x4d, 0x7c, 0x9d, 0x59,
-	0x2f, 0xce, 0x5e, 0x54, 0x4a, 0x56, 0x36, 0xb3, 0xfd, 0x3b, 0x0d, 0x16, 0x07, 0x02, 0x1d, 0x21,
-	0x58, 0x50, 0xca, 0x76, 0xa5, 0x5a, 0xac, 0xbe, 0xaa, 0x64, 0x3f, 0x10, 0xb4, 0xb3, 0x52, 0xf9,
-	0xf0, 0xa4, 0x7c, 0x6c, 0x17, 0x0f, 0xaa, 0x27, 0xaf, 0x4b, 0x59, 0x0d, 0x01, 0x4c, 0xab, 0xdf,
-	0x19, 0xc1, 0x3f, 0x29, 0x9f, 0x54, 0x4f, 0x8a, 0xd5, 0xd2, 0xa1, 0x5d, 0xfa, 0xff, 0x93, 0x6a,
-	0x76, 0x02, 0x65, 0x61, 0xfe, 0xcd, 0x49, 0xf5, 0xf9, 0xa1, 0x55, 0x7c, 0x53, 0xdc, 0x3f, 0x2d,
-	0x65, 0x27, 0x85, 0x86, 0xe0, 0x95, 0x0e, 0xb3, 0x53, 0x42, 0x23, 0xfc, 0x6d, 0x57, 0x4e, 0x8b,
-	0x95, 0xe7, 0xa5, 0xc3, 0xec, 0x74, 0xe1, 0x37, 0xd3, 0x70, 0x3b, 0x3c, 0x9b, 0x4a, 0x78, 0xf1,
-	0x46, 0x3f, 0x81, 0xa5, 0x37, 0xd8, 0xe5, 0x47, 0x34, 0xe8, 0x8f, 0x3d, 0x68, 0x6d, 0xa8, 0x6f,
-	0x97, 0xc4, 0x7d, 0x3b, 0xb7, 0x9d, 0x5a, 0x2c, 0x87, 0x46, 0xa6, 0x5d, 0x0d, 0x9d, 0xc2, 0xed,
-	0x03, 0xec, 0x51, 0xcf, 0x75, 0x70, 0xeb, 0x39, 0xc1, 0xf5, 0x54, 0xb3, 0xe3, 0x84, 0x11, 0xb2,
-	0x60, 0xe9, 0x54, 0xce, 0xb2, 0x89, 0x71, 0xed, 0xe6, 0x16, 0x13, 0xca, 0xbb, 0x1a, 0xfa, 0x29,
-	0x2c, 0x0e, 0xf4, 0xa5, 0x54, 0x8b, 0xa9, 0xb7, 0xae, 0xb4, 0xc6, 0x76, 0x0a, 0x33, 0x51, 0xae,
-	0xa6, 0x1a, 0xdd, 0x4a, 0x33, 0x3a, 0x54, 0x22, 0xbe, 0x82, 0x99, 0x23, 0x1a, 0x5c, 0x5c, 0x69,
-	0xed, 0x6e, 0xda, 0xa6, 0x85, 0x26, 0xfa, 0x41, 0x83, 0xd9, 0x38, 0xd9, 0x53, 0x6d, 0x3c, 0x1c,
-	0xbb, 0x4e, 0x18, 0x2f, 0xde, 0x17, 0x77, 0x91, 0x79, 0x44, 0xb8, 0xd3, 0x24, 0x4c, 0x97, 0x99,
-	0xac, 0x8b, 0x8a, 0xa1, 0x33, 0xd7, 0x73, 0x88, 0xde, 0xc2, 0x8c, 0xeb, 0xe7, 0xae, 0x87, 0x5b,
-	0xee, 0x2f, 0x48, 0x3d, 0xe4, 0x9b, 0xbf, 0xfe, 0xdb, 0x8f, 0xbf, 0xcf, 0xac, 0xa1, 0x95, 0x7c,
-	0xf7, 0x51, 0xf4, 0x1c, 0x23, 0x19, 0x42, 0x0f, 0x5d, 0x40, 0x36, 0xf6, 0xb2, 0xdf, 0x13, 0x49,
-	0xcb, 0xd0, 0x27, 0x69, 0xeb, 0x19, 0x95, 0xdc, 0x37, 0x58, 0x7d, 0xe1, 0x5f, 0x1a, 0x2c, 0x86,
-	0xc1, 0x40, 0x82, 0x7e, 0x2e, 0x40, 0x48, 0x92, 0xd1, 0x3a, 0x4e, 0x0c, 0xe5, 0x3e, 0x4e, 0xf3,
-	0x38, 0x30, 0xf5, 0xbd, 0x83, 0xd5, 0x81, 0xdb, 0x6b, 0x91, 0xcb, 0xde, 0x63, 0x5e, 0x6d, 0x60,
-	0xf0, 0xc6, 0x9c, 0x1e, 0x87, 0x29, 0x97, 0xe3, 0xc2, 0x5f, 0x27, 0xe2, 0xe9, 0x3a, 0xde, 0x68,
-	0x0b, 0x6e, 0x5f, 0x1a, 0x7c, 0xd3, 0x61, 0x1e, 0x35, 0x58, 0xe7, 0x76, 0xc6, 0x94, 0x56, 0x7b,
-	0xff, 0x1e, 0x96, 0x47, 0xdc, 0xe4, 0x50, 0xe1, 0x9a, 0x8c, 0x1a, 0x71, 0x03, 0xcd, 0x3d, 0xbe,
-	0x91, 0x8e, 0xf2, 0xff, 0x33, 0x98, 0x57, 0x0b, 0x0b, 0x2b, 0xc9, 0x38, 0xe5, 0x26, 0xf7, 0xe0,
-	0x9a, 0x3d, 0xc6, 0xd6, 0x6b, 0x90, 0x3d, 0xa0, 0x6d, 0xbf, 0xc3, 0x49, 0x7c, 0x39, 0x18, 0xcf,
-	0x43, 0x6a, 0xb0, 0x0e, 0x5d, 0x32, 0x0a, 0xff, 0x99, 0x82, 0x6c, 0xbf, 0x89, 0xa8, 0x43, 0xfc,
-	0x3e, 0xae, 0xdc, 0xfd, 0x19, 0x23, 0x1d, 0xd4, 0xf4, 0xa7, 0xb5, 0x74, 0x50, 0xaf, 0x78, 0xcf,
-	0xda, 0xd5, 0x10, 0x85, 0x85, 0xcb, 0xb7, 0x0c, 0xb4, 0x73, 0xad, 0xa1, 0x4b, 0x61, 0x64, 0x8e,
-	0x2b, 0xae, 0x90, 0xfe, 0xe5, 0xe8, 0xa1, 0xfa, 0xf1, 0x0d, 0x26, 0xf8, 0xeb, 0x03, 0xe9, 0xaa,
-	0xfb, 0xc3, 0xdb, 0xe1, 0x56, 0x7e, 0xc3, 0x2d, 0xdf, 0xf4, 0xed, 0x0e, 0xfd, 0x4a, 0x83, 0x95,
-	0x51, 0x6f, 0xbf, 0xe8, 0xfa, 0x43, 0x1b, 0x7e, 0x7c, 0xce, 0x7d, 0x7a, 0x33, 0x25, 0xb5, 0x86,
-	0x0e, 0x64, 0x07, 0xdf, 0xfe, 0x50, 0xea, 0x46, 0x52, 0x5e, 0x18, 0x73, 0xbb, 0xe3, 0x2b, 0x84,
-	0x6e, 0xf7, 0xff, 0x32, 0xf1, 0xbe, 0xf8, 0xe7, 0x09, 0xf4, 0x77, 0x0d, 0xa6, 0xce, 0x82, 0x1e,
-	0x6b, 0xa3, 0xff, 0xfb, 0xba, 0xf2, 0xa2, 0xac, 0x5b, 0x67, 0x07, 0x7a, 0xf4, 0x5f, 0x03, 0xdd,
-	0x0f, 0x68, 0xd7, 0xad, 0x8b, 0xf6, 0xd2, 0xd3, 0xa5, 0x90, 0x69, 0x1c, 0xc0, 0x82, 0xfc, 0x85,
-	0xb9, 0xeb, 0xe8, 0xa7, 0xb8, 0xc6, 0xd0, 0x9d, 0x26, 0xe7, 0x3e, 0xdb, 0xcb, 0xe7, 0xfd, 0x88,
-	0xde, 0xc2, 0x35, 0x66, 0x3a, 0xb4, 0x9d, 0x5b, 0xe3, 0x04, 0xb7, 0xbf, 0x1a, 0xa2, 0x6f, 0xff,
-	0x1c, 0xee, 0x1f, 0x97, 0x5f, 0xe9, 0xc7, 0xc4, 0x23, 0x01, 0x6e, 0xe9, 0xe1, 0x73, 0xb0, 0x7e,
-	0xea, 0x3a, 0xc4, 0x63, 0x44, 0xef, 0x3e, 0x36, 0x77, 0xd1, 0xb3, 0xc8, 0x6a, 0xc3, 0xe5, 0xcd,
-	0x4e, 0x4d, 0xa8, 0x5d, 0x76, 0x10, 0x7e, 0x89, 0xfe, 0x56, 0xcb, 0xb7, 0xb1, 0xe8, 0x33, 0xf9,
-	0xd3, 0x93, 0x83, 0x52, 0xb9, 0x52, 0x32, 0xdb, 0xf5, 0xc2, 0xd4, 0xae, 0xb9, 0x6b, 0xee, 0xe6,
-	0x16, 0xb1, 0xef, 0x9a, 0x7e, 0xd0, 0x93, 0x9e, 0x3d, 0xc2, 0xb7, 0xb5, 0x4c, 0x21, 0x8b, 0x7d,
-	0xbf, 0xe5, 0x3a, 0x32, 0xdf, 0xf2, 0xdf, 0x32, 0xea, 0x15, 0xee, 0x24, 0x29, 0x8d, 0xc0, 0x77,
-	0x76, 0xbe, 0x23, 0xb5, 0x1d, 0x4e, 0xde, 0xf1, 0x14, 0xd6, 0x15, 0x5a, 0x82, 0xb5, 0x37, 0xe4,
-	0x62, 0x2f, 0xdd, 0x45, 0xf0, 0x44, 0xd4, 0xcf, 0x1e, 0x6b, 0xeb, 0xc7, 0x72, 0xa7, 0xe8, 0xe3,
-	0xf1, 0x76, 0x5e, 0x9b, 0x96, 0xb3, 0xc5, 0xe3, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x93, 0x9e,
-	0x49, 0xb6, 0xf9, 0x19, 0x00, 0x00,
+func (m *DetectedSlashingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectedSlashingsResponse.Unmarshal(m, b)
+}
+func (m *DetectedSlashingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectedSlashingsResponse.Marshal(b, m, deterministic)
+}
+func (m *DetectedSlashingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectedSlashingsResponse.Merge(m, src)
+}
+func (m *DetectedSlashingsResponse) XXX_Size() int {
+	return xxx_messageInfo_DetectedSlashingsResponse.Size(m)
+}
+func (m *DetectedSlashingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectedSlashingsResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_DetectedSlashingsResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *DetectedSlashingsResponse) GetSlashings() []*DetectedSlashingsResponse_DetectedSlashing {
+	if m != nil {
+		return m.Slashings
+	}
+	return nil
+}
 
-// BeaconServiceClient is the client API for BeaconService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconServiceClient interface {
+type DetectedSlashingsResponse_DetectedSlashing struct {
+	ValidatorIndex uint64          `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Offense        SlashingOffense `protobuf:"varint,2,opt,name=offense,proto3,enum=ethereum.beacon.rpc.v1.SlashingOffense" json:"offense,omitempty"`
+	// The slot of the offending block or attestation.
+	Slot                 uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DetectedSlashingsResponse_DetectedSlashing) Reset() {
+	*m = DetectedSlashingsResponse_DetectedSlashing{}
+}
+func (m *DetectedSlashingsResponse_DetectedSlashing) String() string {
+	return proto.CompactTextString(m)
+}
+func (*DetectedSlashingsResponse_DetectedSlashing) ProtoMessage() {}
+func (*DetectedSlashingsResponse_DetectedSlashing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{31, 0}
+}
+
+func (m *DetectedSlashingsResponse_DetectedSlashing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DetectedSlashingsResponse_DetectedSlashing.Unmarshal(m, b)
+}
+func (m *DetectedSlashingsResponse_DetectedSlashing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DetectedSlashingsResponse_DetectedSlashing.Marshal(b, m, deterministic)
+}
+func (m *DetectedSlashingsResponse_DetectedSlashing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DetectedSlashingsResponse_DetectedSlashing.Merge(m, src)
+}
+func (m *DetectedSlashingsResponse_DetectedSlashing) XXX_Size() int {
+	return xxx_messageInfo_DetectedSlashingsResponse_DetectedSlashing.Size(m)
+}
+func (m *DetectedSlashingsResponse_DetectedSlashing) XXX_DiscardUnknown() {
+	xxx_messageInfo_DetectedSlashingsResponse_DetectedSlashing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DetectedSlashingsResponse_DetectedSlashing proto.InternalMessageInfo
+
+func (m *DetectedSlashingsResponse_DetectedSlashing) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *DetectedSlashingsResponse_DetectedSlashing) GetOffense() SlashingOffense {
+	if m != nil {
+		return m.Offense
+	}
+	return SlashingOffense_UNKNOWN_OFFENSE
+}
+
+func (m *DetectedSlashingsResponse_DetectedSlashing) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type BeaconCommitteeRequest struct {
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// The position of the committee among the committees at the requested slot.
+	CommitteeIndex       uint64   `protobuf:"varint,2,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconCommitteeRequest) Reset()         { *m = BeaconCommitteeRequest{} }
+func (m *BeaconCommitteeRequest) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommitteeRequest) ProtoMessage()    {}
+func (*BeaconCommitteeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{32}
+}
+
+func (m *BeaconCommitteeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconCommitteeRequest.Unmarshal(m, b)
+}
+func (m *BeaconCommitteeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconCommitteeRequest.Marshal(b, m, deterministic)
+}
+func (m *BeaconCommitteeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommitteeRequest.Merge(m, src)
+}
+func (m *BeaconCommitteeRequest) XXX_Size() int {
+	return xxx_messageInfo_BeaconCommitteeRequest.Size(m)
+}
+func (m *BeaconCommitteeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommitteeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommitteeRequest proto.InternalMessageInfo
+
+func (m *BeaconCommitteeRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *BeaconCommitteeRequest) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+type BeaconCommitteeResponse struct {
+	Committee            []uint64 `protobuf:"varint,1,rep,packed,name=committee,proto3" json:"committee,omitempty"`
+	Shard                uint64   `protobuf:"varint,2,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BeaconCommitteeResponse) Reset()         { *m = BeaconCommitteeResponse{} }
+func (m *BeaconCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*BeaconCommitteeResponse) ProtoMessage()    {}
+func (*BeaconCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{33}
+}
+
+func (m *BeaconCommitteeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BeaconCommitteeResponse.Unmarshal(m, b)
+}
+func (m *BeaconCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BeaconCommitteeResponse.Marshal(b, m, deterministic)
+}
+func (m *BeaconCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BeaconCommitteeResponse.Merge(m, src)
+}
+func (m *BeaconCommitteeResponse) XXX_Size() int {
+	return xxx_messageInfo_BeaconCommitteeResponse.Size(m)
+}
+func (m *BeaconCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BeaconCommitteeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BeaconCommitteeResponse proto.InternalMessageInfo
+
+func (m *BeaconCommitteeResponse) GetCommittee() []uint64 {
+	if m != nil {
+		return m.Committee
+	}
+	return nil
+}
+
+func (m *BeaconCommitteeResponse) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+type BlockStreamRequest struct {
+	// Blocks with a slot lower than the start slot are not streamed.
+	StartSlot            uint64   `protobuf:"varint,1,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockStreamRequest) Reset()         { *m = BlockStreamRequest{} }
+func (m *BlockStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockStreamRequest) ProtoMessage()    {}
+func (*BlockStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{34}
+}
+
+func (m *BlockStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockStreamRequest.Unmarshal(m, b)
+}
+func (m *BlockStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockStreamRequest.Merge(m, src)
+}
+func (m *BlockStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockStreamRequest.Size(m)
+}
+func (m *BlockStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockStreamRequest proto.InternalMessageInfo
+
+func (m *BlockStreamRequest) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+type AggregateStreamRequest struct {
+	// Aggregates with fewer participants than the minimum are not streamed.
+	MinParticipants      uint64   `protobuf:"varint,1,opt,name=min_participants,json=minParticipants,proto3" json:"min_participants,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregateStreamRequest) Reset()         { *m = AggregateStreamRequest{} }
+func (m *AggregateStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregateStreamRequest) ProtoMessage()    {}
+func (*AggregateStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{35}
+}
+
+func (m *AggregateStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregateStreamRequest.Unmarshal(m, b)
+}
+func (m *AggregateStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregateStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *AggregateStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateStreamRequest.Merge(m, src)
+}
+func (m *AggregateStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_AggregateStreamRequest.Size(m)
+}
+func (m *AggregateStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateStreamRequest proto.InternalMessageInfo
+
+func (m *AggregateStreamRequest) GetMinParticipants() uint64 {
+	if m != nil {
+		return m.MinParticipants
+	}
+	return 0
+}
+
+type WithdrawalCredentialsRequest struct {
+	PublicKeys           [][]byte `protobuf:"bytes,1,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawalCredentialsRequest) Reset()         { *m = WithdrawalCredentialsRequest{} }
+func (m *WithdrawalCredentialsRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalCredentialsRequest) ProtoMessage()    {}
+func (*WithdrawalCredentialsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{36}
+}
+
+func (m *WithdrawalCredentialsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawalCredentialsRequest.Unmarshal(m, b)
+}
+func (m *WithdrawalCredentialsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawalCredentialsRequest.Marshal(b, m, deterministic)
+}
+func (m *WithdrawalCredentialsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalCredentialsRequest.Merge(m, src)
+}
+func (m *WithdrawalCredentialsRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawalCredentialsRequest.Size(m)
+}
+func (m *WithdrawalCredentialsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalCredentialsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalCredentialsRequest proto.InternalMessageInfo
+
+func (m *WithdrawalCredentialsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type WithdrawalCredentialsResponse struct {
+	// Credentials are index aligned with the requested public keys.
+	Credentials          []*WithdrawalCredentialsResponse_Credentials `protobuf:"bytes,1,rep,name=credentials,proto3" json:"credentials,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
+	XXX_unrecognized     []byte                                       `json:"-"`
+	XXX_sizecache        int32                                        `json:"-"`
+}
+
+func (m *WithdrawalCredentialsResponse) Reset()         { *m = WithdrawalCredentialsResponse{} }
+func (m *WithdrawalCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawalCredentialsResponse) ProtoMessage()    {}
+func (*WithdrawalCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{37}
+}
+
+func (m *WithdrawalCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawalCredentialsResponse.Unmarshal(m, b)
+}
+func (m *WithdrawalCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawalCredentialsResponse.Marshal(b, m, deterministic)
+}
+func (m *WithdrawalCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalCredentialsResponse.Merge(m, src)
+}
+func (m *WithdrawalCredentialsResponse) XXX_Size() int {
+	return xxx_messageInfo_WithdrawalCredentialsResponse.Size(m)
+}
+func (m *WithdrawalCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalCredentialsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalCredentialsResponse proto.InternalMessageInfo
+
+func (m *WithdrawalCredentialsResponse) GetCredentials() []*WithdrawalCredentialsResponse_Credentials {
+	if m != nil {
+		return m.Credentials
+	}
+	return nil
+}
+
+type WithdrawalCredentialsResponse_Credentials struct {
+	PublicKey                   []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	WithdrawalCredentialsHash32 []byte `protobuf:"bytes,2,opt,name=withdrawal_credentials_hash32,json=withdrawalCredentialsHash32,proto3" json:"withdrawal_credentials_hash32,omitempty"`
+	// Found is false if the public key is not in the validator registry.
+	Found                bool     `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawalCredentialsResponse_Credentials) Reset() {
+	*m = WithdrawalCredentialsResponse_Credentials{}
+}
+func (m *WithdrawalCredentialsResponse_Credentials) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WithdrawalCredentialsResponse_Credentials) ProtoMessage() {}
+func (*WithdrawalCredentialsResponse_Credentials) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{37, 0}
+}
+
+func (m *WithdrawalCredentialsResponse_Credentials) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawalCredentialsResponse_Credentials.Unmarshal(m, b)
+}
+func (m *WithdrawalCredentialsResponse_Credentials) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawalCredentialsResponse_Credentials.Marshal(b, m, deterministic)
+}
+func (m *WithdrawalCredentialsResponse_Credentials) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawalCredentialsResponse_Credentials.Merge(m, src)
+}
+func (m *WithdrawalCredentialsResponse_Credentials) XXX_Size() int {
+	return xxx_messageInfo_WithdrawalCredentialsResponse_Credentials.Size(m)
+}
+func (m *WithdrawalCredentialsResponse_Credentials) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawalCredentialsResponse_Credentials.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawalCredentialsResponse_Credentials proto.InternalMessageInfo
+
+func (m *WithdrawalCredentialsResponse_Credentials) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *WithdrawalCredentialsResponse_Credentials) GetWithdrawalCredentialsHash32() []byte {
+	if m != nil {
+		return m.WithdrawalCredentialsHash32
+	}
+	return nil
+}
+
+func (m *WithdrawalCredentialsResponse_Credentials) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+type ValidatorDutiesRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorDutiesRequest) Reset()         { *m = ValidatorDutiesRequest{} }
+func (m *ValidatorDutiesRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDutiesRequest) ProtoMessage()    {}
+func (*ValidatorDutiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{38}
+}
+
+func (m *ValidatorDutiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorDutiesRequest.Unmarshal(m, b)
+}
+func (m *ValidatorDutiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorDutiesRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorDutiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDutiesRequest.Merge(m, src)
+}
+func (m *ValidatorDutiesRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorDutiesRequest.Size(m)
+}
+func (m *ValidatorDutiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDutiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDutiesRequest proto.InternalMessageInfo
+
+func (m *ValidatorDutiesRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ValidatorDutiesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorDutiesResponse struct {
+	// Duties are index aligned with the requested public keys.
+	Duties               []*ValidatorDutiesResponse_Duty `protobuf:"bytes,1,rep,name=duties,proto3" json:"duties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ValidatorDutiesResponse) Reset()         { *m = ValidatorDutiesResponse{} }
+func (m *ValidatorDutiesResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDutiesResponse) ProtoMessage()    {}
+func (*ValidatorDutiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{39}
+}
+
+func (m *ValidatorDutiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorDutiesResponse.Unmarshal(m, b)
+}
+func (m *ValidatorDutiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorDutiesResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorDutiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDutiesResponse.Merge(m, src)
+}
+func (m *ValidatorDutiesResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorDutiesResponse.Size(m)
+}
+func (m *ValidatorDutiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDutiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDutiesResponse proto.InternalMessageInfo
+
+func (m *ValidatorDutiesResponse) GetDuties() []*ValidatorDutiesResponse_Duty {
+	if m != nil {
+		return m.Duties
+	}
+	return nil
+}
+
+type ValidatorDutiesResponse_Duty struct {
+	PublicKey       []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	AttestationSlot uint64 `protobuf:"varint,2,opt,name=attestation_slot,json=attestationSlot,proto3" json:"attestation_slot,omitempty"`
+	// The position of the attesting committee among the committees at the attestation slot.
+	CommitteeIndex uint64   `protobuf:"varint,3,opt,name=committee_index,json=committeeIndex,proto3" json:"committee_index,omitempty"`
+	Shard          uint64   `protobuf:"varint,4,opt,name=shard,proto3" json:"shard,omitempty"`
+	ProposalSlots  []uint64 `protobuf:"varint,5,rep,packed,name=proposal_slots,json=proposalSlots,proto3" json:"proposal_slots,omitempty"`
+	// Found is false if the validator is not assigned to any committee in the epoch.
+	Found                bool     `protobuf:"varint,6,opt,name=found,proto3" json:"found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorDutiesResponse_Duty) Reset()         { *m = ValidatorDutiesResponse_Duty{} }
+func (m *ValidatorDutiesResponse_Duty) String() string { return proto.CompactTextString(m) }
+func (*ValidatorDutiesResponse_Duty) ProtoMessage()    {}
+func (*ValidatorDutiesResponse_Duty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{39, 0}
+}
+
+func (m *ValidatorDutiesResponse_Duty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorDutiesResponse_Duty.Unmarshal(m, b)
+}
+func (m *ValidatorDutiesResponse_Duty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorDutiesResponse_Duty.Marshal(b, m, deterministic)
+}
+func (m *ValidatorDutiesResponse_Duty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorDutiesResponse_Duty.Merge(m, src)
+}
+func (m *ValidatorDutiesResponse_Duty) XXX_Size() int {
+	return xxx_messageInfo_ValidatorDutiesResponse_Duty.Size(m)
+}
+func (m *ValidatorDutiesResponse_Duty) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorDutiesResponse_Duty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorDutiesResponse_Duty proto.InternalMessageInfo
+
+func (m *ValidatorDutiesResponse_Duty) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorDutiesResponse_Duty) GetAttestationSlot() uint64 {
+	if m != nil {
+		return m.AttestationSlot
+	}
+	return 0
+}
+
+func (m *ValidatorDutiesResponse_Duty) GetCommitteeIndex() uint64 {
+	if m != nil {
+		return m.CommitteeIndex
+	}
+	return 0
+}
+
+func (m *ValidatorDutiesResponse_Duty) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *ValidatorDutiesResponse_Duty) GetProposalSlots() []uint64 {
+	if m != nil {
+		return m.ProposalSlots
+	}
+	return nil
+}
+
+func (m *ValidatorDutiesResponse_Duty) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+type SyncStatusResponse struct {
+	Syncing  bool   `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	HeadSlot uint64 `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	// The highest slot known among peers, or the head slot if it is not known.
+	HighestSlot uint64 `protobuf:"varint,3,opt,name=highest_slot,json=highestSlot,proto3" json:"highest_slot,omitempty"`
+	// Whether the highest slot was reported by peers.
+	HighestSlotKnown     bool     `protobuf:"varint,4,opt,name=highest_slot_known,json=highestSlotKnown,proto3" json:"highest_slot_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStatusResponse) Reset()         { *m = SyncStatusResponse{} }
+func (m *SyncStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncStatusResponse) ProtoMessage()    {}
+func (*SyncStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{40}
+}
+
+func (m *SyncStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStatusResponse.Unmarshal(m, b)
+}
+func (m *SyncStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *SyncStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStatusResponse.Merge(m, src)
+}
+func (m *SyncStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncStatusResponse.Size(m)
+}
+func (m *SyncStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStatusResponse proto.InternalMessageInfo
+
+func (m *SyncStatusResponse) GetSyncing() bool {
+	if m != nil {
+		return m.Syncing
+	}
+	return false
+}
+
+func (m *SyncStatusResponse) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *SyncStatusResponse) GetHighestSlot() uint64 {
+	if m != nil {
+		return m.HighestSlot
+	}
+	return 0
+}
+
+func (m *SyncStatusResponse) GetHighestSlotKnown() bool {
+	if m != nil {
+		return m.HighestSlotKnown
+	}
+	return false
+}
+
+type EpochAttestationStatsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochAttestationStatsRequest) Reset()         { *m = EpochAttestationStatsRequest{} }
+func (m *EpochAttestationStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*EpochAttestationStatsRequest) ProtoMessage()    {}
+func (*EpochAttestationStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{41}
+}
+
+func (m *EpochAttestationStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochAttestationStatsRequest.Unmarshal(m, b)
+}
+func (m *EpochAttestationStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochAttestationStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *EpochAttestationStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochAttestationStatsRequest.Merge(m, src)
+}
+func (m *EpochAttestationStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_EpochAttestationStatsRequest.Size(m)
+}
+func (m *EpochAttestationStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochAttestationStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochAttestationStatsRequest proto.InternalMessageInfo
+
+func (m *EpochAttestationStatsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type EpochAttestationStatsResponse struct {
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// The number of aggregate attestations included in the epoch's blocks.
+	AttestationCount uint64 `protobuf:"varint,2,opt,name=attestation_count,json=attestationCount,proto3" json:"attestation_count,omitempty"`
+	// The average number of aggregation bits set per included attestation.
+	AverageAggregationBits float32 `protobuf:"fixed32,3,opt,name=average_aggregation_bits,json=averageAggregationBits,proto3" json:"average_aggregation_bits,omitempty"`
+	// The number of distinct attestation data roots among the included attestations.
+	DistinctDataRoots    uint64   `protobuf:"varint,4,opt,name=distinct_data_roots,json=distinctDataRoots,proto3" json:"distinct_data_roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochAttestationStatsResponse) Reset()         { *m = EpochAttestationStatsResponse{} }
+func (m *EpochAttestationStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*EpochAttestationStatsResponse) ProtoMessage()    {}
+func (*EpochAttestationStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{42}
+}
+
+func (m *EpochAttestationStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochAttestationStatsResponse.Unmarshal(m, b)
+}
+func (m *EpochAttestationStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochAttestationStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *EpochAttestationStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochAttestationStatsResponse.Merge(m, src)
+}
+func (m *EpochAttestationStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_EpochAttestationStatsResponse.Size(m)
+}
+func (m *EpochAttestationStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochAttestationStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochAttestationStatsResponse proto.InternalMessageInfo
+
+func (m *EpochAttestationStatsResponse) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *EpochAttestationStatsResponse) GetAttestationCount() uint64 {
+	if m != nil {
+		return m.AttestationCount
+	}
+	return 0
+}
+
+func (m *EpochAttestationStatsResponse) GetAverageAggregationBits() float32 {
+	if m != nil {
+		return m.AverageAggregationBits
+	}
+	return 0
+}
+
+func (m *EpochAttestationStatsResponse) GetDistinctDataRoots() uint64 {
+	if m != nil {
+		return m.DistinctDataRoots
+	}
+	return 0
+}
+
+type Eth1DataVotesResponse struct {
+	Eth1DataVotes        []*v1.Eth1DataVote `protobuf:"bytes,1,rep,name=eth1_data_votes,json=eth1DataVotes,proto3" json:"eth1_data_votes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *Eth1DataVotesResponse) Reset()         { *m = Eth1DataVotesResponse{} }
+func (m *Eth1DataVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1DataVotesResponse) ProtoMessage()    {}
+func (*Eth1DataVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{43}
+}
+
+func (m *Eth1DataVotesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1DataVotesResponse.Unmarshal(m, b)
+}
+func (m *Eth1DataVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1DataVotesResponse.Marshal(b, m, deterministic)
+}
+func (m *Eth1DataVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1DataVotesResponse.Merge(m, src)
+}
+func (m *Eth1DataVotesResponse) XXX_Size() int {
+	return xxx_messageInfo_Eth1DataVotesResponse.Size(m)
+}
+func (m *Eth1DataVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1DataVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1DataVotesResponse proto.InternalMessageInfo
+
+func (m *Eth1DataVotesResponse) GetEth1DataVotes() []*v1.Eth1DataVote {
+	if m != nil {
+		return m.Eth1DataVotes
+	}
+	return nil
+}
+
+type BlocksBySlotRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlocksBySlotRequest) Reset()         { *m = BlocksBySlotRequest{} }
+func (m *BlocksBySlotRequest) String() string { return proto.CompactTextString(m) }
+func (*BlocksBySlotRequest) ProtoMessage()    {}
+func (*BlocksBySlotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{44}
+}
+
+func (m *BlocksBySlotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksBySlotRequest.Unmarshal(m, b)
+}
+func (m *BlocksBySlotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksBySlotRequest.Marshal(b, m, deterministic)
+}
+func (m *BlocksBySlotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksBySlotRequest.Merge(m, src)
+}
+func (m *BlocksBySlotRequest) XXX_Size() int {
+	return xxx_messageInfo_BlocksBySlotRequest.Size(m)
+}
+func (m *BlocksBySlotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksBySlotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksBySlotRequest proto.InternalMessageInfo
+
+func (m *BlocksBySlotRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type BlocksBySlotResponse struct {
+	Blocks               []*BlocksBySlotResponse_SlotBlock `protobuf:"bytes,1,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
+	XXX_unrecognized     []byte                            `json:"-"`
+	XXX_sizecache        int32                             `json:"-"`
+}
+
+func (m *BlocksBySlotResponse) Reset()         { *m = BlocksBySlotResponse{} }
+func (m *BlocksBySlotResponse) String() string { return proto.CompactTextString(m) }
+func (*BlocksBySlotResponse) ProtoMessage()    {}
+func (*BlocksBySlotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{45}
+}
+
+func (m *BlocksBySlotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksBySlotResponse.Unmarshal(m, b)
+}
+func (m *BlocksBySlotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksBySlotResponse.Marshal(b, m, deterministic)
+}
+func (m *BlocksBySlotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksBySlotResponse.Merge(m, src)
+}
+func (m *BlocksBySlotResponse) XXX_Size() int {
+	return xxx_messageInfo_BlocksBySlotResponse.Size(m)
+}
+func (m *BlocksBySlotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksBySlotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksBySlotResponse proto.InternalMessageInfo
+
+func (m *BlocksBySlotResponse) GetBlocks() []*BlocksBySlotResponse_SlotBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type BlocksBySlotResponse_SlotBlock struct {
+	Block                *v1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot            []byte          `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Canonical            bool            `protobuf:"varint,3,opt,name=canonical,proto3" json:"canonical,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BlocksBySlotResponse_SlotBlock) Reset()         { *m = BlocksBySlotResponse_SlotBlock{} }
+func (m *BlocksBySlotResponse_SlotBlock) String() string { return proto.CompactTextString(m) }
+func (*BlocksBySlotResponse_SlotBlock) ProtoMessage()    {}
+func (*BlocksBySlotResponse_SlotBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{45, 0}
+}
+
+func (m *BlocksBySlotResponse_SlotBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksBySlotResponse_SlotBlock.Unmarshal(m, b)
+}
+func (m *BlocksBySlotResponse_SlotBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksBySlotResponse_SlotBlock.Marshal(b, m, deterministic)
+}
+func (m *BlocksBySlotResponse_SlotBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksBySlotResponse_SlotBlock.Merge(m, src)
+}
+func (m *BlocksBySlotResponse_SlotBlock) XXX_Size() int {
+	return xxx_messageInfo_BlocksBySlotResponse_SlotBlock.Size(m)
+}
+func (m *BlocksBySlotResponse_SlotBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksBySlotResponse_SlotBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksBySlotResponse_SlotBlock proto.InternalMessageInfo
+
+func (m *BlocksBySlotResponse_SlotBlock) GetBlock() *v1.BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *BlocksBySlotResponse_SlotBlock) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *BlocksBySlotResponse_SlotBlock) GetCanonical() bool {
+	if m != nil {
+		return m.Canonical
+	}
+	return false
+}
+
+type SlotTick struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	Epoch                uint64   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotTick) Reset()         { *m = SlotTick{} }
+func (m *SlotTick) String() string { return proto.CompactTextString(m) }
+func (*SlotTick) ProtoMessage()    {}
+func (*SlotTick) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{46}
+}
+
+func (m *SlotTick) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlotTick.Unmarshal(m, b)
+}
+func (m *SlotTick) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlotTick.Marshal(b, m, deterministic)
+}
+func (m *SlotTick) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotTick.Merge(m, src)
+}
+func (m *SlotTick) XXX_Size() int {
+	return xxx_messageInfo_SlotTick.Size(m)
+}
+func (m *SlotTick) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotTick.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotTick proto.InternalMessageInfo
+
+func (m *SlotTick) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *SlotTick) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type BlockByRootRequest struct {
+	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockByRootRequest) Reset()         { *m = BlockByRootRequest{} }
+func (m *BlockByRootRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockByRootRequest) ProtoMessage()    {}
+func (*BlockByRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{47}
+}
+
+func (m *BlockByRootRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockByRootRequest.Unmarshal(m, b)
+}
+func (m *BlockByRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockByRootRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockByRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockByRootRequest.Merge(m, src)
+}
+func (m *BlockByRootRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockByRootRequest.Size(m)
+}
+func (m *BlockByRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockByRootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockByRootRequest proto.InternalMessageInfo
+
+func (m *BlockByRootRequest) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+type BlockOperationCountsResponse struct {
+	Deposits             uint64   `protobuf:"varint,1,opt,name=deposits,proto3" json:"deposits,omitempty"`
+	Attestations         uint64   `protobuf:"varint,2,opt,name=attestations,proto3" json:"attestations,omitempty"`
+	ProposerSlashings    uint64   `protobuf:"varint,3,opt,name=proposer_slashings,json=proposerSlashings,proto3" json:"proposer_slashings,omitempty"`
+	AttesterSlashings    uint64   `protobuf:"varint,4,opt,name=attester_slashings,json=attesterSlashings,proto3" json:"attester_slashings,omitempty"`
+	VoluntaryExits       uint64   `protobuf:"varint,5,opt,name=voluntary_exits,json=voluntaryExits,proto3" json:"voluntary_exits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockOperationCountsResponse) Reset()         { *m = BlockOperationCountsResponse{} }
+func (m *BlockOperationCountsResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockOperationCountsResponse) ProtoMessage()    {}
+func (*BlockOperationCountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{48}
+}
+
+func (m *BlockOperationCountsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockOperationCountsResponse.Unmarshal(m, b)
+}
+func (m *BlockOperationCountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockOperationCountsResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockOperationCountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockOperationCountsResponse.Merge(m, src)
+}
+func (m *BlockOperationCountsResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockOperationCountsResponse.Size(m)
+}
+func (m *BlockOperationCountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockOperationCountsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockOperationCountsResponse proto.InternalMessageInfo
+
+func (m *BlockOperationCountsResponse) GetDeposits() uint64 {
+	if m != nil {
+		return m.Deposits
+	}
+	return 0
+}
+
+func (m *BlockOperationCountsResponse) GetAttestations() uint64 {
+	if m != nil {
+		return m.Attestations
+	}
+	return 0
+}
+
+func (m *BlockOperationCountsResponse) GetProposerSlashings() uint64 {
+	if m != nil {
+		return m.ProposerSlashings
+	}
+	return 0
+}
+
+func (m *BlockOperationCountsResponse) GetAttesterSlashings() uint64 {
+	if m != nil {
+		return m.AttesterSlashings
+	}
+	return 0
+}
+
+func (m *BlockOperationCountsResponse) GetVoluntaryExits() uint64 {
+	if m != nil {
+		return m.VoluntaryExits
+	}
+	return 0
+}
+
+type BlockAttestationsResponse struct {
+	// The attestations in the order they appear in the block body.
+	Attestations         []*BlockAttestationsResponse_IncludedAttestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                         `json:"-"`
+	XXX_unrecognized     []byte                                           `json:"-"`
+	XXX_sizecache        int32                                            `json:"-"`
+}
+
+func (m *BlockAttestationsResponse) Reset()         { *m = BlockAttestationsResponse{} }
+func (m *BlockAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BlockAttestationsResponse) ProtoMessage()    {}
+func (*BlockAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{49}
+}
+
+func (m *BlockAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockAttestationsResponse.Unmarshal(m, b)
+}
+func (m *BlockAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockAttestationsResponse.Marshal(b, m, deterministic)
+}
+func (m *BlockAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAttestationsResponse.Merge(m, src)
+}
+func (m *BlockAttestationsResponse) XXX_Size() int {
+	return xxx_messageInfo_BlockAttestationsResponse.Size(m)
+}
+func (m *BlockAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAttestationsResponse proto.InternalMessageInfo
+
+func (m *BlockAttestationsResponse) GetAttestations() []*BlockAttestationsResponse_IncludedAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type BlockAttestationsResponse_IncludedAttestation struct {
+	Attestation *v1.Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	// The number of validators whose signature is part of the aggregate.
+	AggregationBitCount  uint64   `protobuf:"varint,2,opt,name=aggregation_bit_count,json=aggregationBitCount,proto3" json:"aggregation_bit_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockAttestationsResponse_IncludedAttestation) Reset() {
+	*m = BlockAttestationsResponse_IncludedAttestation{}
+}
+func (m *BlockAttestationsResponse_IncludedAttestation) String() string {
+	return proto.CompactTextString(m)
+}
+func (*BlockAttestationsResponse_IncludedAttestation) ProtoMessage() {}
+func (*BlockAttestationsResponse_IncludedAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{49, 0}
+}
+
+func (m *BlockAttestationsResponse_IncludedAttestation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockAttestationsResponse_IncludedAttestation.Unmarshal(m, b)
+}
+func (m *BlockAttestationsResponse_IncludedAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockAttestationsResponse_IncludedAttestation.Marshal(b, m, deterministic)
+}
+func (m *BlockAttestationsResponse_IncludedAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockAttestationsResponse_IncludedAttestation.Merge(m, src)
+}
+func (m *BlockAttestationsResponse_IncludedAttestation) XXX_Size() int {
+	return xxx_messageInfo_BlockAttestationsResponse_IncludedAttestation.Size(m)
+}
+func (m *BlockAttestationsResponse_IncludedAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockAttestationsResponse_IncludedAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockAttestationsResponse_IncludedAttestation proto.InternalMessageInfo
+
+func (m *BlockAttestationsResponse_IncludedAttestation) GetAttestation() *v1.Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *BlockAttestationsResponse_IncludedAttestation) GetAggregationBitCount() uint64 {
+	if m != nil {
+		return m.AggregationBitCount
+	}
+	return 0
+}
+
+type ProposerRewardResponse struct {
+	ProposerIndex uint64 `protobuf:"varint,1,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	// The reward for including the attestations of the block, credited at the next epoch processing, in Gwei.
+	AttestationInclusionReward uint64 `protobuf:"varint,2,opt,name=attestation_inclusion_reward,json=attestationInclusionReward,proto3" json:"attestation_inclusion_reward,omitempty"`
+	// The whistleblower reward for the proposer and attester slashings of the block, in Gwei.
+	SlashingReward       uint64   `protobuf:"varint,3,opt,name=slashing_reward,json=slashingReward,proto3" json:"slashing_reward,omitempty"`
+	TotalReward          uint64   `protobuf:"varint,4,opt,name=total_reward,json=totalReward,proto3" json:"total_reward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposerRewardResponse) Reset()         { *m = ProposerRewardResponse{} }
+func (m *ProposerRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposerRewardResponse) ProtoMessage()    {}
+func (*ProposerRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{50}
+}
+
+func (m *ProposerRewardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposerRewardResponse.Unmarshal(m, b)
+}
+func (m *ProposerRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposerRewardResponse.Marshal(b, m, deterministic)
+}
+func (m *ProposerRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerRewardResponse.Merge(m, src)
+}
+func (m *ProposerRewardResponse) XXX_Size() int {
+	return xxx_messageInfo_ProposerRewardResponse.Size(m)
+}
+func (m *ProposerRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerRewardResponse proto.InternalMessageInfo
+
+func (m *ProposerRewardResponse) GetProposerIndex() uint64 {
+	if m != nil {
+		return m.ProposerIndex
+	}
+	return 0
+}
+
+func (m *ProposerRewardResponse) GetAttestationInclusionReward() uint64 {
+	if m != nil {
+		return m.AttestationInclusionReward
+	}
+	return 0
+}
+
+func (m *ProposerRewardResponse) GetSlashingReward() uint64 {
+	if m != nil {
+		return m.SlashingReward
+	}
+	return 0
+}
+
+func (m *ProposerRewardResponse) GetTotalReward() uint64 {
+	if m != nil {
+		return m.TotalReward
+	}
+	return 0
+}
+
+type ActiveBalanceRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveBalanceRequest) Reset()         { *m = ActiveBalanceRequest{} }
+func (m *ActiveBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ActiveBalanceRequest) ProtoMessage()    {}
+func (*ActiveBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{51}
+}
+
+func (m *ActiveBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveBalanceRequest.Unmarshal(m, b)
+}
+func (m *ActiveBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *ActiveBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveBalanceRequest.Merge(m, src)
+}
+func (m *ActiveBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ActiveBalanceRequest.Size(m)
+}
+func (m *ActiveBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveBalanceRequest proto.InternalMessageInfo
+
+func (m *ActiveBalanceRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type ActiveBalanceResponse struct {
+	// The sum of the effective balances of the active validators, in Gwei.
+	TotalActiveBalance   uint64   `protobuf:"varint,1,opt,name=total_active_balance,json=totalActiveBalance,proto3" json:"total_active_balance,omitempty"`
+	ActiveValidatorCount uint64   `protobuf:"varint,2,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveBalanceResponse) Reset()         { *m = ActiveBalanceResponse{} }
+func (m *ActiveBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ActiveBalanceResponse) ProtoMessage()    {}
+func (*ActiveBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{52}
+}
+
+func (m *ActiveBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveBalanceResponse.Unmarshal(m, b)
+}
+func (m *ActiveBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveBalanceResponse.Marshal(b, m, deterministic)
+}
+func (m *ActiveBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveBalanceResponse.Merge(m, src)
+}
+func (m *ActiveBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_ActiveBalanceResponse.Size(m)
+}
+func (m *ActiveBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveBalanceResponse proto.InternalMessageInfo
+
+func (m *ActiveBalanceResponse) GetTotalActiveBalance() uint64 {
+	if m != nil {
+		return m.TotalActiveBalance
+	}
+	return 0
+}
+
+func (m *ActiveBalanceResponse) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+type ActiveValidatorsRequest struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The maximum number of indices to return, a default is used when unset.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of a previous response, empty for the first page.
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveValidatorsRequest) Reset()         { *m = ActiveValidatorsRequest{} }
+func (m *ActiveValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ActiveValidatorsRequest) ProtoMessage()    {}
+func (*ActiveValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{53}
+}
+
+func (m *ActiveValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveValidatorsRequest.Unmarshal(m, b)
+}
+func (m *ActiveValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveValidatorsRequest.Marshal(b, m, deterministic)
+}
+func (m *ActiveValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveValidatorsRequest.Merge(m, src)
+}
+func (m *ActiveValidatorsRequest) XXX_Size() int {
+	return xxx_messageInfo_ActiveValidatorsRequest.Size(m)
+}
+func (m *ActiveValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveValidatorsRequest proto.InternalMessageInfo
+
+func (m *ActiveValidatorsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ActiveValidatorsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ActiveValidatorsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ActiveValidatorsResponse struct {
+	ValidatorIndices []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	// The token to request the following page with, empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total number of validators active in the epoch.
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ActiveValidatorsResponse) Reset()         { *m = ActiveValidatorsResponse{} }
+func (m *ActiveValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*ActiveValidatorsResponse) ProtoMessage()    {}
+func (*ActiveValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{54}
+}
+
+func (m *ActiveValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActiveValidatorsResponse.Unmarshal(m, b)
+}
+func (m *ActiveValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActiveValidatorsResponse.Marshal(b, m, deterministic)
+}
+func (m *ActiveValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActiveValidatorsResponse.Merge(m, src)
+}
+func (m *ActiveValidatorsResponse) XXX_Size() int {
+	return xxx_messageInfo_ActiveValidatorsResponse.Size(m)
+}
+func (m *ActiveValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActiveValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActiveValidatorsResponse proto.InternalMessageInfo
+
+func (m *ActiveValidatorsResponse) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *ActiveValidatorsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ActiveValidatorsResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type EpochShufflingRequest struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The maximum number of indices to return, a default is used when unset.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of a previous response, empty for the first page.
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochShufflingRequest) Reset()         { *m = EpochShufflingRequest{} }
+func (m *EpochShufflingRequest) String() string { return proto.CompactTextString(m) }
+func (*EpochShufflingRequest) ProtoMessage()    {}
+func (*EpochShufflingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{55}
+}
+
+func (m *EpochShufflingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochShufflingRequest.Unmarshal(m, b)
+}
+func (m *EpochShufflingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochShufflingRequest.Marshal(b, m, deterministic)
+}
+func (m *EpochShufflingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochShufflingRequest.Merge(m, src)
+}
+func (m *EpochShufflingRequest) XXX_Size() int {
+	return xxx_messageInfo_EpochShufflingRequest.Size(m)
+}
+func (m *EpochShufflingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochShufflingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochShufflingRequest proto.InternalMessageInfo
+
+func (m *EpochShufflingRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochShufflingRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *EpochShufflingRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type EpochShufflingResponse struct {
+	// The shuffled validator indices, ordered by slot and then by committee within the slot.
+	ValidatorIndices []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	// The token to request the following page with, empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total number of shuffled validator indices in the epoch.
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EpochShufflingResponse) Reset()         { *m = EpochShufflingResponse{} }
+func (m *EpochShufflingResponse) String() string { return proto.CompactTextString(m) }
+func (*EpochShufflingResponse) ProtoMessage()    {}
+func (*EpochShufflingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{56}
+}
+
+func (m *EpochShufflingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EpochShufflingResponse.Unmarshal(m, b)
+}
+func (m *EpochShufflingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EpochShufflingResponse.Marshal(b, m, deterministic)
+}
+func (m *EpochShufflingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochShufflingResponse.Merge(m, src)
+}
+func (m *EpochShufflingResponse) XXX_Size() int {
+	return xxx_messageInfo_EpochShufflingResponse.Size(m)
+}
+func (m *EpochShufflingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochShufflingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochShufflingResponse proto.InternalMessageInfo
+
+func (m *EpochShufflingResponse) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *EpochShufflingResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *EpochShufflingResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type MissedAttestersRequest struct {
+	// The maximum number of indices to return, a default is used when unset.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of a previous response, empty for the first page.
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MissedAttestersRequest) Reset()         { *m = MissedAttestersRequest{} }
+func (m *MissedAttestersRequest) String() string { return proto.CompactTextString(m) }
+func (*MissedAttestersRequest) ProtoMessage()    {}
+func (*MissedAttestersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{57}
+}
+
+func (m *MissedAttestersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MissedAttestersRequest.Unmarshal(m, b)
+}
+func (m *MissedAttestersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MissedAttestersRequest.Marshal(b, m, deterministic)
+}
+func (m *MissedAttestersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedAttestersRequest.Merge(m, src)
+}
+func (m *MissedAttestersRequest) XXX_Size() int {
+	return xxx_messageInfo_MissedAttestersRequest.Size(m)
+}
+func (m *MissedAttestersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedAttestersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedAttestersRequest proto.InternalMessageInfo
+
+func (m *MissedAttestersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *MissedAttestersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type MissedAttestersResponse struct {
+	// The indices of the validators which missed their attestation, in increasing order.
+	ValidatorIndices []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	// The token to request the following page with, empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total number of validators which missed their attestation in the epoch.
+	TotalSize uint64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The epoch the attestations were checked for, which is the previous epoch of the head state.
+	Epoch                uint64   `protobuf:"varint,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MissedAttestersResponse) Reset()         { *m = MissedAttestersResponse{} }
+func (m *MissedAttestersResponse) String() string { return proto.CompactTextString(m) }
+func (*MissedAttestersResponse) ProtoMessage()    {}
+func (*MissedAttestersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{58}
+}
+
+func (m *MissedAttestersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MissedAttestersResponse.Unmarshal(m, b)
+}
+func (m *MissedAttestersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MissedAttestersResponse.Marshal(b, m, deterministic)
+}
+func (m *MissedAttestersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MissedAttestersResponse.Merge(m, src)
+}
+func (m *MissedAttestersResponse) XXX_Size() int {
+	return xxx_messageInfo_MissedAttestersResponse.Size(m)
+}
+func (m *MissedAttestersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MissedAttestersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MissedAttestersResponse proto.InternalMessageInfo
+
+func (m *MissedAttestersResponse) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *MissedAttestersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *MissedAttestersResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *MissedAttestersResponse) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+type SkippedSlotsRequest struct {
+	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
+	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SkippedSlotsRequest) Reset()         { *m = SkippedSlotsRequest{} }
+func (m *SkippedSlotsRequest) String() string { return proto.CompactTextString(m) }
+func (*SkippedSlotsRequest) ProtoMessage()    {}
+func (*SkippedSlotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{59}
+}
+
+func (m *SkippedSlotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SkippedSlotsRequest.Unmarshal(m, b)
+}
+func (m *SkippedSlotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SkippedSlotsRequest.Marshal(b, m, deterministic)
+}
+func (m *SkippedSlotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedSlotsRequest.Merge(m, src)
+}
+func (m *SkippedSlotsRequest) XXX_Size() int {
+	return xxx_messageInfo_SkippedSlotsRequest.Size(m)
+}
+func (m *SkippedSlotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedSlotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedSlotsRequest proto.InternalMessageInfo
+
+func (m *SkippedSlotsRequest) GetSlotFrom() uint64 {
+	if m != nil {
+		return m.SlotFrom
+	}
+	return 0
+}
+
+func (m *SkippedSlotsRequest) GetSlotTo() uint64 {
+	if m != nil {
+		return m.SlotTo
+	}
+	return 0
+}
+
+type SkippedSlotsResponse struct {
+	Slots                []uint64 `protobuf:"varint,1,rep,packed,name=slots,proto3" json:"slots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SkippedSlotsResponse) Reset()         { *m = SkippedSlotsResponse{} }
+func (m *SkippedSlotsResponse) String() string { return proto.CompactTextString(m) }
+func (*SkippedSlotsResponse) ProtoMessage()    {}
+func (*SkippedSlotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{60}
+}
+
+func (m *SkippedSlotsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SkippedSlotsResponse.Unmarshal(m, b)
+}
+func (m *SkippedSlotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SkippedSlotsResponse.Marshal(b, m, deterministic)
+}
+func (m *SkippedSlotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkippedSlotsResponse.Merge(m, src)
+}
+func (m *SkippedSlotsResponse) XXX_Size() int {
+	return xxx_messageInfo_SkippedSlotsResponse.Size(m)
+}
+func (m *SkippedSlotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkippedSlotsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkippedSlotsResponse proto.InternalMessageInfo
+
+func (m *SkippedSlotsResponse) GetSlots() []uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return nil
+}
+
+type HistoricalBlockRootsRequest struct {
+	SlotFrom             uint64   `protobuf:"varint,1,opt,name=slot_from,json=slotFrom,proto3" json:"slot_from,omitempty"`
+	SlotTo               uint64   `protobuf:"varint,2,opt,name=slot_to,json=slotTo,proto3" json:"slot_to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoricalBlockRootsRequest) Reset()         { *m = HistoricalBlockRootsRequest{} }
+func (m *HistoricalBlockRootsRequest) String() string { return proto.CompactTextString(m) }
+func (*HistoricalBlockRootsRequest) ProtoMessage()    {}
+func (*HistoricalBlockRootsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{61}
+}
+
+func (m *HistoricalBlockRootsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoricalBlockRootsRequest.Unmarshal(m, b)
+}
+func (m *HistoricalBlockRootsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoricalBlockRootsRequest.Marshal(b, m, deterministic)
+}
+func (m *HistoricalBlockRootsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalBlockRootsRequest.Merge(m, src)
+}
+func (m *HistoricalBlockRootsRequest) XXX_Size() int {
+	return xxx_messageInfo_HistoricalBlockRootsRequest.Size(m)
+}
+func (m *HistoricalBlockRootsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalBlockRootsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalBlockRootsRequest proto.InternalMessageInfo
+
+func (m *HistoricalBlockRootsRequest) GetSlotFrom() uint64 {
+	if m != nil {
+		return m.SlotFrom
+	}
+	return 0
+}
+
+func (m *HistoricalBlockRootsRequest) GetSlotTo() uint64 {
+	if m != nil {
+		return m.SlotTo
+	}
+	return 0
+}
+
+type HistoricalBlockRootsResponse struct {
+	BlockRoots           []*HistoricalBlockRootsResponse_SlotBlockRoot `protobuf:"bytes,1,rep,name=block_roots,json=blockRoots,proto3" json:"block_roots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
+}
+
+func (m *HistoricalBlockRootsResponse) Reset()         { *m = HistoricalBlockRootsResponse{} }
+func (m *HistoricalBlockRootsResponse) String() string { return proto.CompactTextString(m) }
+func (*HistoricalBlockRootsResponse) ProtoMessage()    {}
+func (*HistoricalBlockRootsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{62}
+}
+
+func (m *HistoricalBlockRootsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoricalBlockRootsResponse.Unmarshal(m, b)
+}
+func (m *HistoricalBlockRootsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoricalBlockRootsResponse.Marshal(b, m, deterministic)
+}
+func (m *HistoricalBlockRootsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalBlockRootsResponse.Merge(m, src)
+}
+func (m *HistoricalBlockRootsResponse) XXX_Size() int {
+	return xxx_messageInfo_HistoricalBlockRootsResponse.Size(m)
+}
+func (m *HistoricalBlockRootsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalBlockRootsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalBlockRootsResponse proto.InternalMessageInfo
+
+func (m *HistoricalBlockRootsResponse) GetBlockRoots() []*HistoricalBlockRootsResponse_SlotBlockRoot {
+	if m != nil {
+		return m.BlockRoots
+	}
+	return nil
+}
+
+type HistoricalBlockRootsResponse_SlotBlockRoot struct {
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// The root of the latest block at or before the slot, which is the root of an earlier block if the slot was skipped.
+	BlockRoot            []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) Reset() {
+	*m = HistoricalBlockRootsResponse_SlotBlockRoot{}
+}
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) String() string {
+	return proto.CompactTextString(m)
+}
+func (*HistoricalBlockRootsResponse_SlotBlockRoot) ProtoMessage() {}
+func (*HistoricalBlockRootsResponse_SlotBlockRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{62, 0}
+}
+
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HistoricalBlockRootsResponse_SlotBlockRoot.Unmarshal(m, b)
+}
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HistoricalBlockRootsResponse_SlotBlockRoot.Marshal(b, m, deterministic)
+}
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalBlockRootsResponse_SlotBlockRoot.Merge(m, src)
+}
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) XXX_Size() int {
+	return xxx_messageInfo_HistoricalBlockRootsResponse_SlotBlockRoot.Size(m)
+}
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalBlockRootsResponse_SlotBlockRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalBlockRootsResponse_SlotBlockRoot proto.InternalMessageInfo
+
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *HistoricalBlockRootsResponse_SlotBlockRoot) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+type SlotCoverageRequest struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotCoverageRequest) Reset()         { *m = SlotCoverageRequest{} }
+func (m *SlotCoverageRequest) String() string { return proto.CompactTextString(m) }
+func (*SlotCoverageRequest) ProtoMessage()    {}
+func (*SlotCoverageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{63}
+}
+
+func (m *SlotCoverageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlotCoverageRequest.Unmarshal(m, b)
+}
+func (m *SlotCoverageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlotCoverageRequest.Marshal(b, m, deterministic)
+}
+func (m *SlotCoverageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotCoverageRequest.Merge(m, src)
+}
+func (m *SlotCoverageRequest) XXX_Size() int {
+	return xxx_messageInfo_SlotCoverageRequest.Size(m)
+}
+func (m *SlotCoverageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotCoverageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotCoverageRequest proto.InternalMessageInfo
+
+func (m *SlotCoverageRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type SlotCoverageResponse struct {
+	Committees           []*SlotCoverageResponse_CommitteeCoverage `protobuf:"bytes,1,rep,name=committees,proto3" json:"committees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
+}
+
+func (m *SlotCoverageResponse) Reset()         { *m = SlotCoverageResponse{} }
+func (m *SlotCoverageResponse) String() string { return proto.CompactTextString(m) }
+func (*SlotCoverageResponse) ProtoMessage()    {}
+func (*SlotCoverageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{64}
+}
+
+func (m *SlotCoverageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlotCoverageResponse.Unmarshal(m, b)
+}
+func (m *SlotCoverageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlotCoverageResponse.Marshal(b, m, deterministic)
+}
+func (m *SlotCoverageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotCoverageResponse.Merge(m, src)
+}
+func (m *SlotCoverageResponse) XXX_Size() int {
+	return xxx_messageInfo_SlotCoverageResponse.Size(m)
+}
+func (m *SlotCoverageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotCoverageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotCoverageResponse proto.InternalMessageInfo
+
+func (m *SlotCoverageResponse) GetCommittees() []*SlotCoverageResponse_CommitteeCoverage {
+	if m != nil {
+		return m.Committees
+	}
+	return nil
+}
+
+type SlotCoverageResponse_CommitteeCoverage struct {
+	Shard         uint64 `protobuf:"varint,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	CommitteeSize uint64 `protobuf:"varint,2,opt,name=committee_size,json=committeeSize,proto3" json:"committee_size,omitempty"`
+	// The number of committee members with an attestation included in a canonical block.
+	IncludedMembers      uint64   `protobuf:"varint,3,opt,name=included_members,json=includedMembers,proto3" json:"included_members,omitempty"`
+	Coverage             float32  `protobuf:"fixed32,4,opt,name=coverage,proto3" json:"coverage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SlotCoverageResponse_CommitteeCoverage) Reset() {
+	*m = SlotCoverageResponse_CommitteeCoverage{}
+}
+func (m *SlotCoverageResponse_CommitteeCoverage) String() string { return proto.CompactTextString(m) }
+func (*SlotCoverageResponse_CommitteeCoverage) ProtoMessage()    {}
+func (*SlotCoverageResponse_CommitteeCoverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{64, 0}
+}
+
+func (m *SlotCoverageResponse_CommitteeCoverage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SlotCoverageResponse_CommitteeCoverage.Unmarshal(m, b)
+}
+func (m *SlotCoverageResponse_CommitteeCoverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SlotCoverageResponse_CommitteeCoverage.Marshal(b, m, deterministic)
+}
+func (m *SlotCoverageResponse_CommitteeCoverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlotCoverageResponse_CommitteeCoverage.Merge(m, src)
+}
+func (m *SlotCoverageResponse_CommitteeCoverage) XXX_Size() int {
+	return xxx_messageInfo_SlotCoverageResponse_CommitteeCoverage.Size(m)
+}
+func (m *SlotCoverageResponse_CommitteeCoverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlotCoverageResponse_CommitteeCoverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlotCoverageResponse_CommitteeCoverage proto.InternalMessageInfo
+
+func (m *SlotCoverageResponse_CommitteeCoverage) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *SlotCoverageResponse_CommitteeCoverage) GetCommitteeSize() uint64 {
+	if m != nil {
+		return m.CommitteeSize
+	}
+	return 0
+}
+
+func (m *SlotCoverageResponse_CommitteeCoverage) GetIncludedMembers() uint64 {
+	if m != nil {
+		return m.IncludedMembers
+	}
+	return 0
+}
+
+func (m *SlotCoverageResponse_CommitteeCoverage) GetCoverage() float32 {
+	if m != nil {
+		return m.Coverage
+	}
+	return 0
+}
+
+type Eth1VotingPeriodResponse struct {
+	// The number of the voting period the head state's votes are counted in, starting from 0 at genesis.
+	CurrentPeriod uint64 `protobuf:"varint,1,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
+	// The slot whose epoch processing tallies the votes of the period and resets them.
+	PeriodEndSlot uint64 `protobuf:"varint,2,opt,name=period_end_slot,json=periodEndSlot,proto3" json:"period_end_slot,omitempty"`
+	// The estimated unix time of the period end slot.
+	PeriodEndTime        uint64   `protobuf:"varint,3,opt,name=period_end_time,json=periodEndTime,proto3" json:"period_end_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1VotingPeriodResponse) Reset()         { *m = Eth1VotingPeriodResponse{} }
+func (m *Eth1VotingPeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1VotingPeriodResponse) ProtoMessage()    {}
+func (*Eth1VotingPeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{65}
+}
+
+func (m *Eth1VotingPeriodResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1VotingPeriodResponse.Unmarshal(m, b)
+}
+func (m *Eth1VotingPeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1VotingPeriodResponse.Marshal(b, m, deterministic)
+}
+func (m *Eth1VotingPeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1VotingPeriodResponse.Merge(m, src)
+}
+func (m *Eth1VotingPeriodResponse) XXX_Size() int {
+	return xxx_messageInfo_Eth1VotingPeriodResponse.Size(m)
+}
+func (m *Eth1VotingPeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1VotingPeriodResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1VotingPeriodResponse proto.InternalMessageInfo
+
+func (m *Eth1VotingPeriodResponse) GetCurrentPeriod() uint64 {
+	if m != nil {
+		return m.CurrentPeriod
+	}
+	return 0
+}
+
+func (m *Eth1VotingPeriodResponse) GetPeriodEndSlot() uint64 {
+	if m != nil {
+		return m.PeriodEndSlot
+	}
+	return 0
+}
+
+func (m *Eth1VotingPeriodResponse) GetPeriodEndTime() uint64 {
+	if m != nil {
+		return m.PeriodEndTime
+	}
+	return 0
+}
+
+type Eth1VoteCandidatesResponse struct {
+	// The candidates ordered by increasing height.
+	Candidates []*Eth1VoteCandidatesResponse_Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// The number of the voting period of the head state, starting from 0 at genesis.
+	VotingPeriod uint64 `protobuf:"varint,2,opt,name=voting_period,json=votingPeriod,proto3" json:"voting_period,omitempty"`
+	// Candidates must be higher than this height, which is the height of the block of the head state's latest eth1 data.
+	MinHeight uint64 `protobuf:"varint,3,opt,name=min_height,json=minHeight,proto3" json:"min_height,omitempty"`
+	// Candidates must be at most at this height, which is the follow distance behind the eth1 head.
+	MaxHeight            uint64   `protobuf:"varint,4,opt,name=max_height,json=maxHeight,proto3" json:"max_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1VoteCandidatesResponse) Reset()         { *m = Eth1VoteCandidatesResponse{} }
+func (m *Eth1VoteCandidatesResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1VoteCandidatesResponse) ProtoMessage()    {}
+func (*Eth1VoteCandidatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{66}
+}
+
+func (m *Eth1VoteCandidatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1VoteCandidatesResponse.Unmarshal(m, b)
+}
+func (m *Eth1VoteCandidatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1VoteCandidatesResponse.Marshal(b, m, deterministic)
+}
+func (m *Eth1VoteCandidatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1VoteCandidatesResponse.Merge(m, src)
+}
+func (m *Eth1VoteCandidatesResponse) XXX_Size() int {
+	return xxx_messageInfo_Eth1VoteCandidatesResponse.Size(m)
+}
+func (m *Eth1VoteCandidatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1VoteCandidatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1VoteCandidatesResponse proto.InternalMessageInfo
+
+func (m *Eth1VoteCandidatesResponse) GetCandidates() []*Eth1VoteCandidatesResponse_Candidate {
+	if m != nil {
+		return m.Candidates
+	}
+	return nil
+}
+
+func (m *Eth1VoteCandidatesResponse) GetVotingPeriod() uint64 {
+	if m != nil {
+		return m.VotingPeriod
+	}
+	return 0
+}
+
+func (m *Eth1VoteCandidatesResponse) GetMinHeight() uint64 {
+	if m != nil {
+		return m.MinHeight
+	}
+	return 0
+}
+
+func (m *Eth1VoteCandidatesResponse) GetMaxHeight() uint64 {
+	if m != nil {
+		return m.MaxHeight
+	}
+	return 0
+}
+
+type Eth1VoteCandidatesResponse_Candidate struct {
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The height of the block in the eth1 chain.
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// The number of votes for the block in the current voting period.
+	VoteCount uint64 `protobuf:"varint,3,opt,name=vote_count,json=voteCount,proto3" json:"vote_count,omitempty"`
+	// Whether the block is the ancestor at the follow distance from the eth1 head, which is voted
+	// for when no vote of the period is a valid candidate.
+	FollowDistanceAncestor bool     `protobuf:"varint,4,opt,name=follow_distance_ancestor,json=followDistanceAncestor,proto3" json:"follow_distance_ancestor,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *Eth1VoteCandidatesResponse_Candidate) Reset()         { *m = Eth1VoteCandidatesResponse_Candidate{} }
+func (m *Eth1VoteCandidatesResponse_Candidate) String() string { return proto.CompactTextString(m) }
+func (*Eth1VoteCandidatesResponse_Candidate) ProtoMessage()    {}
+func (*Eth1VoteCandidatesResponse_Candidate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{66, 0}
+}
+
+func (m *Eth1VoteCandidatesResponse_Candidate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1VoteCandidatesResponse_Candidate.Unmarshal(m, b)
+}
+func (m *Eth1VoteCandidatesResponse_Candidate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1VoteCandidatesResponse_Candidate.Marshal(b, m, deterministic)
+}
+func (m *Eth1VoteCandidatesResponse_Candidate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1VoteCandidatesResponse_Candidate.Merge(m, src)
+}
+func (m *Eth1VoteCandidatesResponse_Candidate) XXX_Size() int {
+	return xxx_messageInfo_Eth1VoteCandidatesResponse_Candidate.Size(m)
+}
+func (m *Eth1VoteCandidatesResponse_Candidate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1VoteCandidatesResponse_Candidate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1VoteCandidatesResponse_Candidate proto.InternalMessageInfo
+
+func (m *Eth1VoteCandidatesResponse_Candidate) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *Eth1VoteCandidatesResponse_Candidate) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Eth1VoteCandidatesResponse_Candidate) GetVoteCount() uint64 {
+	if m != nil {
+		return m.VoteCount
+	}
+	return 0
+}
+
+func (m *Eth1VoteCandidatesResponse_Candidate) GetFollowDistanceAncestor() bool {
+	if m != nil {
+		return m.FollowDistanceAncestor
+	}
+	return false
+}
+
+type Eth1FollowStatusResponse struct {
+	LatestBlockNumber uint64 `protobuf:"varint,1,opt,name=latest_block_number,json=latestBlockNumber,proto3" json:"latest_block_number,omitempty"`
+	FollowDistance    uint64 `protobuf:"varint,2,opt,name=follow_distance,json=followDistance,proto3" json:"follow_distance,omitempty"`
+	// The latest block number minus the follow distance, deposits up to which are ready for inclusion.
+	SafeBlockNumber      uint64   `protobuf:"varint,3,opt,name=safe_block_number,json=safeBlockNumber,proto3" json:"safe_block_number,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1FollowStatusResponse) Reset()         { *m = Eth1FollowStatusResponse{} }
+func (m *Eth1FollowStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1FollowStatusResponse) ProtoMessage()    {}
+func (*Eth1FollowStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{67}
+}
+
+func (m *Eth1FollowStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1FollowStatusResponse.Unmarshal(m, b)
+}
+func (m *Eth1FollowStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1FollowStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *Eth1FollowStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1FollowStatusResponse.Merge(m, src)
+}
+func (m *Eth1FollowStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_Eth1FollowStatusResponse.Size(m)
+}
+func (m *Eth1FollowStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1FollowStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1FollowStatusResponse proto.InternalMessageInfo
+
+func (m *Eth1FollowStatusResponse) GetLatestBlockNumber() uint64 {
+	if m != nil {
+		return m.LatestBlockNumber
+	}
+	return 0
+}
+
+func (m *Eth1FollowStatusResponse) GetFollowDistance() uint64 {
+	if m != nil {
+		return m.FollowDistance
+	}
+	return 0
+}
+
+func (m *Eth1FollowStatusResponse) GetSafeBlockNumber() uint64 {
+	if m != nil {
+		return m.SafeBlockNumber
+	}
+	return 0
+}
+
+type GenesisDepositRootResponse struct {
+	DepositRoot          []byte   `protobuf:"bytes,1,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenesisDepositRootResponse) Reset()         { *m = GenesisDepositRootResponse{} }
+func (m *GenesisDepositRootResponse) String() string { return proto.CompactTextString(m) }
+func (*GenesisDepositRootResponse) ProtoMessage()    {}
+func (*GenesisDepositRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{68}
+}
+
+func (m *GenesisDepositRootResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenesisDepositRootResponse.Unmarshal(m, b)
+}
+func (m *GenesisDepositRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenesisDepositRootResponse.Marshal(b, m, deterministic)
+}
+func (m *GenesisDepositRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisDepositRootResponse.Merge(m, src)
+}
+func (m *GenesisDepositRootResponse) XXX_Size() int {
+	return xxx_messageInfo_GenesisDepositRootResponse.Size(m)
+}
+func (m *GenesisDepositRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisDepositRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisDepositRootResponse proto.InternalMessageInfo
+
+func (m *GenesisDepositRootResponse) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
+type GenesisValidatorsRequest struct {
+	// The maximum number of validators to return, a default is used when unset.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of a previous response, empty for the first page.
+	PageToken            string   `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenesisValidatorsRequest) Reset()         { *m = GenesisValidatorsRequest{} }
+func (m *GenesisValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*GenesisValidatorsRequest) ProtoMessage()    {}
+func (*GenesisValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{69}
+}
+
+func (m *GenesisValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenesisValidatorsRequest.Unmarshal(m, b)
+}
+func (m *GenesisValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenesisValidatorsRequest.Marshal(b, m, deterministic)
+}
+func (m *GenesisValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisValidatorsRequest.Merge(m, src)
+}
+func (m *GenesisValidatorsRequest) XXX_Size() int {
+	return xxx_messageInfo_GenesisValidatorsRequest.Size(m)
+}
+func (m *GenesisValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisValidatorsRequest proto.InternalMessageInfo
+
+func (m *GenesisValidatorsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *GenesisValidatorsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type GenesisValidatorsResponse struct {
+	// The validators of the genesis state, ordered by validator index.
+	Validators []*v1.Validator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators,omitempty"`
+	// The token to request the following page with, empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total number of validators in the genesis state.
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GenesisValidatorsResponse) Reset()         { *m = GenesisValidatorsResponse{} }
+func (m *GenesisValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*GenesisValidatorsResponse) ProtoMessage()    {}
+func (*GenesisValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{70}
+}
+
+func (m *GenesisValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenesisValidatorsResponse.Unmarshal(m, b)
+}
+func (m *GenesisValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenesisValidatorsResponse.Marshal(b, m, deterministic)
+}
+func (m *GenesisValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisValidatorsResponse.Merge(m, src)
+}
+func (m *GenesisValidatorsResponse) XXX_Size() int {
+	return xxx_messageInfo_GenesisValidatorsResponse.Size(m)
+}
+func (m *GenesisValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisValidatorsResponse proto.InternalMessageInfo
+
+func (m *GenesisValidatorsResponse) GetValidators() []*v1.Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *GenesisValidatorsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *GenesisValidatorsResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type PendingDepositCountResponse struct {
+	Count                uint64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingDepositCountResponse) Reset()         { *m = PendingDepositCountResponse{} }
+func (m *PendingDepositCountResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingDepositCountResponse) ProtoMessage()    {}
+func (*PendingDepositCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{71}
+}
+
+func (m *PendingDepositCountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingDepositCountResponse.Unmarshal(m, b)
+}
+func (m *PendingDepositCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingDepositCountResponse.Marshal(b, m, deterministic)
+}
+func (m *PendingDepositCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingDepositCountResponse.Merge(m, src)
+}
+func (m *PendingDepositCountResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingDepositCountResponse.Size(m)
+}
+func (m *PendingDepositCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingDepositCountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingDepositCountResponse proto.InternalMessageInfo
+
+func (m *PendingDepositCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type UpcomingActivationsResponse struct {
+	// The validator indices in activation order, empty if no validator is activated.
+	ValidatorIndices []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	// The epoch the validators become active at.
+	ActivationEpoch      uint64   `protobuf:"varint,2,opt,name=activation_epoch,json=activationEpoch,proto3" json:"activation_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpcomingActivationsResponse) Reset()         { *m = UpcomingActivationsResponse{} }
+func (m *UpcomingActivationsResponse) String() string { return proto.CompactTextString(m) }
+func (*UpcomingActivationsResponse) ProtoMessage()    {}
+func (*UpcomingActivationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{72}
+}
+
+func (m *UpcomingActivationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpcomingActivationsResponse.Unmarshal(m, b)
+}
+func (m *UpcomingActivationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpcomingActivationsResponse.Marshal(b, m, deterministic)
+}
+func (m *UpcomingActivationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpcomingActivationsResponse.Merge(m, src)
+}
+func (m *UpcomingActivationsResponse) XXX_Size() int {
+	return xxx_messageInfo_UpcomingActivationsResponse.Size(m)
+}
+func (m *UpcomingActivationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpcomingActivationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpcomingActivationsResponse proto.InternalMessageInfo
+
+func (m *UpcomingActivationsResponse) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *UpcomingActivationsResponse) GetActivationEpoch() uint64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+type LastFinalizedSlotResponse struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LastFinalizedSlotResponse) Reset()         { *m = LastFinalizedSlotResponse{} }
+func (m *LastFinalizedSlotResponse) String() string { return proto.CompactTextString(m) }
+func (*LastFinalizedSlotResponse) ProtoMessage()    {}
+func (*LastFinalizedSlotResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{73}
+}
+
+func (m *LastFinalizedSlotResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LastFinalizedSlotResponse.Unmarshal(m, b)
+}
+func (m *LastFinalizedSlotResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LastFinalizedSlotResponse.Marshal(b, m, deterministic)
+}
+func (m *LastFinalizedSlotResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastFinalizedSlotResponse.Merge(m, src)
+}
+func (m *LastFinalizedSlotResponse) XXX_Size() int {
+	return xxx_messageInfo_LastFinalizedSlotResponse.Size(m)
+}
+func (m *LastFinalizedSlotResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastFinalizedSlotResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastFinalizedSlotResponse proto.InternalMessageInfo
+
+func (m *LastFinalizedSlotResponse) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type FinalityDistanceResponse struct {
+	// The number of slots between the start of the finalized epoch and the head slot.
+	Slots uint64 `protobuf:"varint,1,opt,name=slots,proto3" json:"slots,omitempty"`
+	// The number of epochs between the finalized epoch and the current epoch of the head state.
+	Epochs         uint64 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	FinalizedEpoch uint64 `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	// Set when no epoch after genesis has been finalized yet, in which case the distances are counted from genesis.
+	NeverFinalized       bool     `protobuf:"varint,4,opt,name=never_finalized,json=neverFinalized,proto3" json:"never_finalized,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinalityDistanceResponse) Reset()         { *m = FinalityDistanceResponse{} }
+func (m *FinalityDistanceResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalityDistanceResponse) ProtoMessage()    {}
+func (*FinalityDistanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{74}
+}
+
+func (m *FinalityDistanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinalityDistanceResponse.Unmarshal(m, b)
+}
+func (m *FinalityDistanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinalityDistanceResponse.Marshal(b, m, deterministic)
+}
+func (m *FinalityDistanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityDistanceResponse.Merge(m, src)
+}
+func (m *FinalityDistanceResponse) XXX_Size() int {
+	return xxx_messageInfo_FinalityDistanceResponse.Size(m)
+}
+func (m *FinalityDistanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityDistanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityDistanceResponse proto.InternalMessageInfo
+
+func (m *FinalityDistanceResponse) GetSlots() uint64 {
+	if m != nil {
+		return m.Slots
+	}
+	return 0
+}
+
+func (m *FinalityDistanceResponse) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
+}
+
+func (m *FinalityDistanceResponse) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *FinalityDistanceResponse) GetNeverFinalized() bool {
+	if m != nil {
+		return m.NeverFinalized
+	}
+	return false
+}
+
+type StateSchemaInfoResponse struct {
+	// The fork version at the current epoch of the head state.
+	ForkVersion            uint64 `protobuf:"varint,1,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty"`
+	Slot                   uint64 `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	ValidatorRegistryCount uint64 `protobuf:"varint,3,opt,name=validator_registry_count,json=validatorRegistryCount,proto3" json:"validator_registry_count,omitempty"`
+	ValidatorBalancesCount uint64 `protobuf:"varint,4,opt,name=validator_balances_count,json=validatorBalancesCount,proto3" json:"validator_balances_count,omitempty"`
+	LatestRandaoMixesCount uint64 `protobuf:"varint,5,opt,name=latest_randao_mixes_count,json=latestRandaoMixesCount,proto3" json:"latest_randao_mixes_count,omitempty"`
+	LatestCrosslinksCount  uint64 `protobuf:"varint,6,opt,name=latest_crosslinks_count,json=latestCrosslinksCount,proto3" json:"latest_crosslinks_count,omitempty"`
+	LatestBlockRootsCount  uint64 `protobuf:"varint,7,opt,name=latest_block_roots_count,json=latestBlockRootsCount,proto3" json:"latest_block_roots_count,omitempty"`
+	// The number of historical roots, batching the block roots of past periods.
+	HistoricalRootsCount       uint64   `protobuf:"varint,8,opt,name=historical_roots_count,json=historicalRootsCount,proto3" json:"historical_roots_count,omitempty"`
+	LatestSlashedBalancesCount uint64   `protobuf:"varint,9,opt,name=latest_slashed_balances_count,json=latestSlashedBalancesCount,proto3" json:"latest_slashed_balances_count,omitempty"`
+	LatestAttestationsCount    uint64   `protobuf:"varint,10,opt,name=latest_attestations_count,json=latestAttestationsCount,proto3" json:"latest_attestations_count,omitempty"`
+	LatestIndexRootsCount      uint64   `protobuf:"varint,11,opt,name=latest_index_roots_count,json=latestIndexRootsCount,proto3" json:"latest_index_roots_count,omitempty"`
+	Eth1DataVotesCount         uint64   `protobuf:"varint,12,opt,name=eth1_data_votes_count,json=eth1DataVotesCount,proto3" json:"eth1_data_votes_count,omitempty"`
+	XXX_NoUnkeyedLiteral       struct{} `json:"-"`
+	XXX_unrecognized           []byte   `json:"-"`
+	XXX_sizecache              int32    `json:"-"`
+}
+
+func (m *StateSchemaInfoResponse) Reset()         { *m = StateSchemaInfoResponse{} }
+func (m *StateSchemaInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*StateSchemaInfoResponse) ProtoMessage()    {}
+func (*StateSchemaInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{75}
+}
+
+func (m *StateSchemaInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateSchemaInfoResponse.Unmarshal(m, b)
+}
+func (m *StateSchemaInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateSchemaInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *StateSchemaInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSchemaInfoResponse.Merge(m, src)
+}
+func (m *StateSchemaInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_StateSchemaInfoResponse.Size(m)
+}
+func (m *StateSchemaInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSchemaInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSchemaInfoResponse proto.InternalMessageInfo
+
+func (m *StateSchemaInfoResponse) GetForkVersion() uint64 {
+	if m != nil {
+		return m.ForkVersion
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetValidatorRegistryCount() uint64 {
+	if m != nil {
+		return m.ValidatorRegistryCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetValidatorBalancesCount() uint64 {
+	if m != nil {
+		return m.ValidatorBalancesCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetLatestRandaoMixesCount() uint64 {
+	if m != nil {
+		return m.LatestRandaoMixesCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetLatestCrosslinksCount() uint64 {
+	if m != nil {
+		return m.LatestCrosslinksCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetLatestBlockRootsCount() uint64 {
+	if m != nil {
+		return m.LatestBlockRootsCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetHistoricalRootsCount() uint64 {
+	if m != nil {
+		return m.HistoricalRootsCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetLatestSlashedBalancesCount() uint64 {
+	if m != nil {
+		return m.LatestSlashedBalancesCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetLatestAttestationsCount() uint64 {
+	if m != nil {
+		return m.LatestAttestationsCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetLatestIndexRootsCount() uint64 {
+	if m != nil {
+		return m.LatestIndexRootsCount
+	}
+	return 0
+}
+
+func (m *StateSchemaInfoResponse) GetEth1DataVotesCount() uint64 {
+	if m != nil {
+		return m.Eth1DataVotesCount
+	}
+	return 0
+}
+
+type ProposedBlockRequest struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposedBlockRequest) Reset()         { *m = ProposedBlockRequest{} }
+func (m *ProposedBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*ProposedBlockRequest) ProtoMessage()    {}
+func (*ProposedBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{76}
+}
+
+func (m *ProposedBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposedBlockRequest.Unmarshal(m, b)
+}
+func (m *ProposedBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposedBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *ProposedBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposedBlockRequest.Merge(m, src)
+}
+func (m *ProposedBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_ProposedBlockRequest.Size(m)
+}
+func (m *ProposedBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposedBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposedBlockRequest proto.InternalMessageInfo
+
+func (m *ProposedBlockRequest) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ProposedBlockRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type ProposedBlockResponse struct {
+	Block     *v1.BeaconBlock `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	BlockRoot []byte          `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	// Found is false if there is no canonical block at the slot or it was proposed by another validator.
+	Found                bool     `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProposedBlockResponse) Reset()         { *m = ProposedBlockResponse{} }
+func (m *ProposedBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*ProposedBlockResponse) ProtoMessage()    {}
+func (*ProposedBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{77}
+}
+
+func (m *ProposedBlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProposedBlockResponse.Unmarshal(m, b)
+}
+func (m *ProposedBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProposedBlockResponse.Marshal(b, m, deterministic)
+}
+func (m *ProposedBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposedBlockResponse.Merge(m, src)
+}
+func (m *ProposedBlockResponse) XXX_Size() int {
+	return xxx_messageInfo_ProposedBlockResponse.Size(m)
+}
+func (m *ProposedBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposedBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposedBlockResponse proto.InternalMessageInfo
+
+func (m *ProposedBlockResponse) GetBlock() *v1.BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *ProposedBlockResponse) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *ProposedBlockResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+type CrosslinksResponse struct {
+	Crosslinks           []*CrosslinksResponse_ShardCrosslink `protobuf:"bytes,1,rep,name=crosslinks,proto3" json:"crosslinks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *CrosslinksResponse) Reset()         { *m = CrosslinksResponse{} }
+func (m *CrosslinksResponse) String() string { return proto.CompactTextString(m) }
+func (*CrosslinksResponse) ProtoMessage()    {}
+func (*CrosslinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{78}
+}
+
+func (m *CrosslinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrosslinksResponse.Unmarshal(m, b)
+}
+func (m *CrosslinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrosslinksResponse.Marshal(b, m, deterministic)
+}
+func (m *CrosslinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrosslinksResponse.Merge(m, src)
+}
+func (m *CrosslinksResponse) XXX_Size() int {
+	return xxx_messageInfo_CrosslinksResponse.Size(m)
+}
+func (m *CrosslinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrosslinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrosslinksResponse proto.InternalMessageInfo
+
+func (m *CrosslinksResponse) GetCrosslinks() []*CrosslinksResponse_ShardCrosslink {
+	if m != nil {
+		return m.Crosslinks
+	}
+	return nil
+}
+
+type CrosslinksResponse_ShardCrosslink struct {
+	Shard uint64 `protobuf:"varint,1,opt,name=shard,proto3" json:"shard,omitempty"`
+	// The latest crosslink is empty if the head state has no crosslink for the shard yet.
+	LatestCrosslink      *v1.Crosslink `protobuf:"bytes,2,opt,name=latest_crosslink,json=latestCrosslink,proto3" json:"latest_crosslink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *CrosslinksResponse_ShardCrosslink) Reset()         { *m = CrosslinksResponse_ShardCrosslink{} }
+func (m *CrosslinksResponse_ShardCrosslink) String() string { return proto.CompactTextString(m) }
+func (*CrosslinksResponse_ShardCrosslink) ProtoMessage()    {}
+func (*CrosslinksResponse_ShardCrosslink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{78, 0}
+}
+
+func (m *CrosslinksResponse_ShardCrosslink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrosslinksResponse_ShardCrosslink.Unmarshal(m, b)
+}
+func (m *CrosslinksResponse_ShardCrosslink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrosslinksResponse_ShardCrosslink.Marshal(b, m, deterministic)
+}
+func (m *CrosslinksResponse_ShardCrosslink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrosslinksResponse_ShardCrosslink.Merge(m, src)
+}
+func (m *CrosslinksResponse_ShardCrosslink) XXX_Size() int {
+	return xxx_messageInfo_CrosslinksResponse_ShardCrosslink.Size(m)
+}
+func (m *CrosslinksResponse_ShardCrosslink) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrosslinksResponse_ShardCrosslink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrosslinksResponse_ShardCrosslink proto.InternalMessageInfo
+
+func (m *CrosslinksResponse_ShardCrosslink) GetShard() uint64 {
+	if m != nil {
+		return m.Shard
+	}
+	return 0
+}
+
+func (m *CrosslinksResponse_ShardCrosslink) GetLatestCrosslink() *v1.Crosslink {
+	if m != nil {
+		return m.LatestCrosslink
+	}
+	return nil
+}
+
+type ChurnLimitResponse struct {
+	// The maximum balance in Gwei activated, and separately exited, at a validator registry update.
+	BalanceChurnLimit    uint64 `protobuf:"varint,1,opt,name=balance_churn_limit,json=balanceChurnLimit,proto3" json:"balance_churn_limit,omitempty"`
+	ActiveValidatorCount uint64 `protobuf:"varint,2,opt,name=active_validator_count,json=activeValidatorCount,proto3" json:"active_validator_count,omitempty"`
+	// The total balance in Gwei of the active validators the churn limit is computed from.
+	TotalActiveBalance   uint64   `protobuf:"varint,3,opt,name=total_active_balance,json=totalActiveBalance,proto3" json:"total_active_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChurnLimitResponse) Reset()         { *m = ChurnLimitResponse{} }
+func (m *ChurnLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*ChurnLimitResponse) ProtoMessage()    {}
+func (*ChurnLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{79}
+}
+
+func (m *ChurnLimitResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChurnLimitResponse.Unmarshal(m, b)
+}
+func (m *ChurnLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChurnLimitResponse.Marshal(b, m, deterministic)
+}
+func (m *ChurnLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChurnLimitResponse.Merge(m, src)
+}
+func (m *ChurnLimitResponse) XXX_Size() int {
+	return xxx_messageInfo_ChurnLimitResponse.Size(m)
+}
+func (m *ChurnLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChurnLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChurnLimitResponse proto.InternalMessageInfo
+
+func (m *ChurnLimitResponse) GetBalanceChurnLimit() uint64 {
+	if m != nil {
+		return m.BalanceChurnLimit
+	}
+	return 0
+}
+
+func (m *ChurnLimitResponse) GetActiveValidatorCount() uint64 {
+	if m != nil {
+		return m.ActiveValidatorCount
+	}
+	return 0
+}
+
+func (m *ChurnLimitResponse) GetTotalActiveBalance() uint64 {
+	if m != nil {
+		return m.TotalActiveBalance
+	}
+	return 0
+}
+
+type AttestingBalancesResponse struct {
+	// The total balance in Gwei of the validators whose current epoch attestations vote for the epoch boundary block.
+	CurrentAttestingBalance uint64 `protobuf:"varint,1,opt,name=current_attesting_balance,json=currentAttestingBalance,proto3" json:"current_attesting_balance,omitempty"`
+	// The total balance in Gwei of the validators with an attestation for the previous epoch.
+	PreviousAttestingBalance uint64 `protobuf:"varint,2,opt,name=previous_attesting_balance,json=previousAttestingBalance,proto3" json:"previous_attesting_balance,omitempty"`
+	// The total balance in Gwei of the validators active in each epoch, which the attesting balances are compared against.
+	CurrentTotalBalance  uint64   `protobuf:"varint,3,opt,name=current_total_balance,json=currentTotalBalance,proto3" json:"current_total_balance,omitempty"`
+	PreviousTotalBalance uint64   `protobuf:"varint,4,opt,name=previous_total_balance,json=previousTotalBalance,proto3" json:"previous_total_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestingBalancesResponse) Reset()         { *m = AttestingBalancesResponse{} }
+func (m *AttestingBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestingBalancesResponse) ProtoMessage()    {}
+func (*AttestingBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{80}
+}
+
+func (m *AttestingBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestingBalancesResponse.Unmarshal(m, b)
+}
+func (m *AttestingBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestingBalancesResponse.Marshal(b, m, deterministic)
+}
+func (m *AttestingBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestingBalancesResponse.Merge(m, src)
+}
+func (m *AttestingBalancesResponse) XXX_Size() int {
+	return xxx_messageInfo_AttestingBalancesResponse.Size(m)
+}
+func (m *AttestingBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestingBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestingBalancesResponse proto.InternalMessageInfo
+
+func (m *AttestingBalancesResponse) GetCurrentAttestingBalance() uint64 {
+	if m != nil {
+		return m.CurrentAttestingBalance
+	}
+	return 0
+}
+
+func (m *AttestingBalancesResponse) GetPreviousAttestingBalance() uint64 {
+	if m != nil {
+		return m.PreviousAttestingBalance
+	}
+	return 0
+}
+
+func (m *AttestingBalancesResponse) GetCurrentTotalBalance() uint64 {
+	if m != nil {
+		return m.CurrentTotalBalance
+	}
+	return 0
+}
+
+func (m *AttestingBalancesResponse) GetPreviousTotalBalance() uint64 {
+	if m != nil {
+		return m.PreviousTotalBalance
+	}
+	return 0
+}
+
+type TotalDepositedResponse struct {
+	// The sum in Gwei of the amounts of every deposit observed by the node, including pending deposits.
+	TotalAmount uint64 `protobuf:"varint,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// The sum in Gwei of the amounts of the deposits not yet included in a beacon block.
+	PendingAmount        uint64   `protobuf:"varint,2,opt,name=pending_amount,json=pendingAmount,proto3" json:"pending_amount,omitempty"`
+	DepositCount         uint64   `protobuf:"varint,3,opt,name=deposit_count,json=depositCount,proto3" json:"deposit_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TotalDepositedResponse) Reset()         { *m = TotalDepositedResponse{} }
+func (m *TotalDepositedResponse) String() string { return proto.CompactTextString(m) }
+func (*TotalDepositedResponse) ProtoMessage()    {}
+func (*TotalDepositedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{81}
+}
+
+func (m *TotalDepositedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TotalDepositedResponse.Unmarshal(m, b)
+}
+func (m *TotalDepositedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TotalDepositedResponse.Marshal(b, m, deterministic)
+}
+func (m *TotalDepositedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TotalDepositedResponse.Merge(m, src)
+}
+func (m *TotalDepositedResponse) XXX_Size() int {
+	return xxx_messageInfo_TotalDepositedResponse.Size(m)
+}
+func (m *TotalDepositedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TotalDepositedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TotalDepositedResponse proto.InternalMessageInfo
+
+func (m *TotalDepositedResponse) GetTotalAmount() uint64 {
+	if m != nil {
+		return m.TotalAmount
+	}
+	return 0
+}
+
+func (m *TotalDepositedResponse) GetPendingAmount() uint64 {
+	if m != nil {
+		return m.PendingAmount
+	}
+	return 0
+}
+
+func (m *TotalDepositedResponse) GetDepositCount() uint64 {
+	if m != nil {
+		return m.DepositCount
+	}
+	return 0
+}
+
+type DepositStatusRequest struct {
+	MerkleTreeIndex      uint64   `protobuf:"varint,1,opt,name=merkle_tree_index,json=merkleTreeIndex,proto3" json:"merkle_tree_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DepositStatusRequest) Reset()         { *m = DepositStatusRequest{} }
+func (m *DepositStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*DepositStatusRequest) ProtoMessage()    {}
+func (*DepositStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{82}
+}
+
+func (m *DepositStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositStatusRequest.Unmarshal(m, b)
+}
+func (m *DepositStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *DepositStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositStatusRequest.Merge(m, src)
+}
+func (m *DepositStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_DepositStatusRequest.Size(m)
+}
+func (m *DepositStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositStatusRequest proto.InternalMessageInfo
+
+func (m *DepositStatusRequest) GetMerkleTreeIndex() uint64 {
+	if m != nil {
+		return m.MerkleTreeIndex
+	}
+	return 0
+}
+
+type DepositStatusResponse struct {
+	Status               DepositStatusResponse_Status `protobuf:"varint,1,opt,name=status,proto3,enum=ethereum.beacon.rpc.v1.DepositStatusResponse_Status" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *DepositStatusResponse) Reset()         { *m = DepositStatusResponse{} }
+func (m *DepositStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*DepositStatusResponse) ProtoMessage()    {}
+func (*DepositStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{83}
+}
+
+func (m *DepositStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DepositStatusResponse.Unmarshal(m, b)
+}
+func (m *DepositStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DepositStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *DepositStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositStatusResponse.Merge(m, src)
+}
+func (m *DepositStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_DepositStatusResponse.Size(m)
+}
+func (m *DepositStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositStatusResponse proto.InternalMessageInfo
+
+func (m *DepositStatusResponse) GetStatus() DepositStatusResponse_Status {
+	if m != nil {
+		return m.Status
+	}
+	return DepositStatusResponse_UNKNOWN
+}
+
+type JustifiedHistoryRequest struct {
+	StartEpoch uint64 `protobuf:"varint,1,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	// The last epoch of the range, inclusive.
+	EndEpoch             uint64   `protobuf:"varint,2,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JustifiedHistoryRequest) Reset()         { *m = JustifiedHistoryRequest{} }
+func (m *JustifiedHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*JustifiedHistoryRequest) ProtoMessage()    {}
+func (*JustifiedHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{84}
+}
+
+func (m *JustifiedHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JustifiedHistoryRequest.Unmarshal(m, b)
+}
+func (m *JustifiedHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JustifiedHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *JustifiedHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JustifiedHistoryRequest.Merge(m, src)
+}
+func (m *JustifiedHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_JustifiedHistoryRequest.Size(m)
+}
+func (m *JustifiedHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JustifiedHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JustifiedHistoryRequest proto.InternalMessageInfo
+
+func (m *JustifiedHistoryRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *JustifiedHistoryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+type JustifiedHistoryResponse struct {
+	// The checkpoints of the epochs in the range which have a saved state, in ascending epoch order.
+	Checkpoints          []*JustifiedHistoryResponse_EpochCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
+	XXX_unrecognized     []byte                                      `json:"-"`
+	XXX_sizecache        int32                                       `json:"-"`
+}
+
+func (m *JustifiedHistoryResponse) Reset()         { *m = JustifiedHistoryResponse{} }
+func (m *JustifiedHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*JustifiedHistoryResponse) ProtoMessage()    {}
+func (*JustifiedHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{85}
+}
+
+func (m *JustifiedHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JustifiedHistoryResponse.Unmarshal(m, b)
+}
+func (m *JustifiedHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JustifiedHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *JustifiedHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JustifiedHistoryResponse.Merge(m, src)
+}
+func (m *JustifiedHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_JustifiedHistoryResponse.Size(m)
+}
+func (m *JustifiedHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_JustifiedHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JustifiedHistoryResponse proto.InternalMessageInfo
+
+func (m *JustifiedHistoryResponse) GetCheckpoints() []*JustifiedHistoryResponse_EpochCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+type JustifiedHistoryResponse_EpochCheckpoint struct {
+	// The epoch of the state the checkpoint was read from.
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	JustifiedEpoch       uint64   `protobuf:"varint,2,opt,name=justified_epoch,json=justifiedEpoch,proto3" json:"justified_epoch,omitempty"`
+	JustifiedRoot        []byte   `protobuf:"bytes,3,opt,name=justified_root,json=justifiedRoot,proto3" json:"justified_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JustifiedHistoryResponse_EpochCheckpoint) Reset() {
+	*m = JustifiedHistoryResponse_EpochCheckpoint{}
+}
+func (m *JustifiedHistoryResponse_EpochCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*JustifiedHistoryResponse_EpochCheckpoint) ProtoMessage()    {}
+func (*JustifiedHistoryResponse_EpochCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{85, 0}
+}
+
+func (m *JustifiedHistoryResponse_EpochCheckpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JustifiedHistoryResponse_EpochCheckpoint.Unmarshal(m, b)
+}
+func (m *JustifiedHistoryResponse_EpochCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JustifiedHistoryResponse_EpochCheckpoint.Marshal(b, m, deterministic)
+}
+func (m *JustifiedHistoryResponse_EpochCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JustifiedHistoryResponse_EpochCheckpoint.Merge(m, src)
+}
+func (m *JustifiedHistoryResponse_EpochCheckpoint) XXX_Size() int {
+	return xxx_messageInfo_JustifiedHistoryResponse_EpochCheckpoint.Size(m)
+}
+func (m *JustifiedHistoryResponse_EpochCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_JustifiedHistoryResponse_EpochCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JustifiedHistoryResponse_EpochCheckpoint proto.InternalMessageInfo
+
+func (m *JustifiedHistoryResponse_EpochCheckpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *JustifiedHistoryResponse_EpochCheckpoint) GetJustifiedEpoch() uint64 {
+	if m != nil {
+		return m.JustifiedEpoch
+	}
+	return 0
+}
+
+func (m *JustifiedHistoryResponse_EpochCheckpoint) GetJustifiedRoot() []byte {
+	if m != nil {
+		return m.JustifiedRoot
+	}
+	return nil
+}
+
+type ForkChoiceStoreResponse struct {
+	JustifiedCheckpoint *ForkChoiceStoreResponse_Checkpoint `protobuf:"bytes,1,opt,name=justified_checkpoint,json=justifiedCheckpoint,proto3" json:"justified_checkpoint,omitempty"`
+	FinalizedCheckpoint *ForkChoiceStoreResponse_Checkpoint `protobuf:"bytes,2,opt,name=finalized_checkpoint,json=finalizedCheckpoint,proto3" json:"finalized_checkpoint,omitempty"`
+	// The justified block and every saved block after it, which fork choice weighs to select the head.
+	Blocks               []*ForkChoiceStoreResponse_TrackedBlock `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                `json:"-"`
+	XXX_unrecognized     []byte                                  `json:"-"`
+	XXX_sizecache        int32                                   `json:"-"`
+}
+
+func (m *ForkChoiceStoreResponse) Reset()         { *m = ForkChoiceStoreResponse{} }
+func (m *ForkChoiceStoreResponse) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceStoreResponse) ProtoMessage()    {}
+func (*ForkChoiceStoreResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{86}
+}
+
+func (m *ForkChoiceStoreResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForkChoiceStoreResponse.Unmarshal(m, b)
+}
+func (m *ForkChoiceStoreResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForkChoiceStoreResponse.Marshal(b, m, deterministic)
+}
+func (m *ForkChoiceStoreResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceStoreResponse.Merge(m, src)
+}
+func (m *ForkChoiceStoreResponse) XXX_Size() int {
+	return xxx_messageInfo_ForkChoiceStoreResponse.Size(m)
+}
+func (m *ForkChoiceStoreResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceStoreResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceStoreResponse proto.InternalMessageInfo
+
+func (m *ForkChoiceStoreResponse) GetJustifiedCheckpoint() *ForkChoiceStoreResponse_Checkpoint {
+	if m != nil {
+		return m.JustifiedCheckpoint
+	}
+	return nil
+}
+
+func (m *ForkChoiceStoreResponse) GetFinalizedCheckpoint() *ForkChoiceStoreResponse_Checkpoint {
+	if m != nil {
+		return m.FinalizedCheckpoint
+	}
+	return nil
+}
+
+func (m *ForkChoiceStoreResponse) GetBlocks() []*ForkChoiceStoreResponse_TrackedBlock {
+	if m != nil {
+		return m.Blocks
+	}
+	return nil
+}
+
+type ForkChoiceStoreResponse_Checkpoint struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,3,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceStoreResponse_Checkpoint) Reset()         { *m = ForkChoiceStoreResponse_Checkpoint{} }
+func (m *ForkChoiceStoreResponse_Checkpoint) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceStoreResponse_Checkpoint) ProtoMessage()    {}
+func (*ForkChoiceStoreResponse_Checkpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{86, 0}
+}
+
+func (m *ForkChoiceStoreResponse_Checkpoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForkChoiceStoreResponse_Checkpoint.Unmarshal(m, b)
+}
+func (m *ForkChoiceStoreResponse_Checkpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForkChoiceStoreResponse_Checkpoint.Marshal(b, m, deterministic)
+}
+func (m *ForkChoiceStoreResponse_Checkpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceStoreResponse_Checkpoint.Merge(m, src)
+}
+func (m *ForkChoiceStoreResponse_Checkpoint) XXX_Size() int {
+	return xxx_messageInfo_ForkChoiceStoreResponse_Checkpoint.Size(m)
+}
+func (m *ForkChoiceStoreResponse_Checkpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceStoreResponse_Checkpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceStoreResponse_Checkpoint proto.InternalMessageInfo
+
+func (m *ForkChoiceStoreResponse_Checkpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ForkChoiceStoreResponse_Checkpoint) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ForkChoiceStoreResponse_Checkpoint) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+type ForkChoiceStoreResponse_TrackedBlock struct {
+	BlockRoot  []byte `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	ParentRoot []byte `protobuf:"bytes,2,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	Slot       uint64 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	// The effective balance of the validators whose latest attestation target descends from the block.
+	Weight               uint64   `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForkChoiceStoreResponse_TrackedBlock) Reset()         { *m = ForkChoiceStoreResponse_TrackedBlock{} }
+func (m *ForkChoiceStoreResponse_TrackedBlock) String() string { return proto.CompactTextString(m) }
+func (*ForkChoiceStoreResponse_TrackedBlock) ProtoMessage()    {}
+func (*ForkChoiceStoreResponse_TrackedBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{86, 1}
+}
+
+func (m *ForkChoiceStoreResponse_TrackedBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForkChoiceStoreResponse_TrackedBlock.Unmarshal(m, b)
+}
+func (m *ForkChoiceStoreResponse_TrackedBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForkChoiceStoreResponse_TrackedBlock.Marshal(b, m, deterministic)
+}
+func (m *ForkChoiceStoreResponse_TrackedBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForkChoiceStoreResponse_TrackedBlock.Merge(m, src)
+}
+func (m *ForkChoiceStoreResponse_TrackedBlock) XXX_Size() int {
+	return xxx_messageInfo_ForkChoiceStoreResponse_TrackedBlock.Size(m)
+}
+func (m *ForkChoiceStoreResponse_TrackedBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForkChoiceStoreResponse_TrackedBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForkChoiceStoreResponse_TrackedBlock proto.InternalMessageInfo
+
+func (m *ForkChoiceStoreResponse_TrackedBlock) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceStoreResponse_TrackedBlock) GetParentRoot() []byte {
+	if m != nil {
+		return m.ParentRoot
+	}
+	return nil
+}
+
+func (m *ForkChoiceStoreResponse_TrackedBlock) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *ForkChoiceStoreResponse_TrackedBlock) GetWeight() uint64 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type AttestationTargetCacheResponse struct {
+	// The latest attestation target of each active validator of the justified state, keyed by validator index.
+	// Validators which have not attested yet are missing.
+	Targets              map[uint64]*v1.AttestationTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *AttestationTargetCacheResponse) Reset()         { *m = AttestationTargetCacheResponse{} }
+func (m *AttestationTargetCacheResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationTargetCacheResponse) ProtoMessage()    {}
+func (*AttestationTargetCacheResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{87}
+}
+
+func (m *AttestationTargetCacheResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationTargetCacheResponse.Unmarshal(m, b)
+}
+func (m *AttestationTargetCacheResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationTargetCacheResponse.Marshal(b, m, deterministic)
+}
+func (m *AttestationTargetCacheResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationTargetCacheResponse.Merge(m, src)
+}
+func (m *AttestationTargetCacheResponse) XXX_Size() int {
+	return xxx_messageInfo_AttestationTargetCacheResponse.Size(m)
+}
+func (m *AttestationTargetCacheResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationTargetCacheResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationTargetCacheResponse proto.InternalMessageInfo
+
+func (m *AttestationTargetCacheResponse) GetTargets() map[uint64]*v1.AttestationTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type ValidatorBalanceDeltaRequest struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	StartSlot            uint64   `protobuf:"varint,2,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot              uint64   `protobuf:"varint,3,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorBalanceDeltaRequest) Reset()         { *m = ValidatorBalanceDeltaRequest{} }
+func (m *ValidatorBalanceDeltaRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalanceDeltaRequest) ProtoMessage()    {}
+func (*ValidatorBalanceDeltaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{88}
+}
+
+func (m *ValidatorBalanceDeltaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorBalanceDeltaRequest.Unmarshal(m, b)
+}
+func (m *ValidatorBalanceDeltaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorBalanceDeltaRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorBalanceDeltaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalanceDeltaRequest.Merge(m, src)
+}
+func (m *ValidatorBalanceDeltaRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorBalanceDeltaRequest.Size(m)
+}
+func (m *ValidatorBalanceDeltaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalanceDeltaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalanceDeltaRequest proto.InternalMessageInfo
+
+func (m *ValidatorBalanceDeltaRequest) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltaRequest) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltaRequest) GetEndSlot() uint64 {
+	if m != nil {
+		return m.EndSlot
+	}
+	return 0
+}
+
+type ValidatorBalanceDeltaResponse struct {
+	// The start balance is 0 if the validator was not in the registry at the start slot.
+	StartBalance         uint64   `protobuf:"varint,1,opt,name=start_balance,json=startBalance,proto3" json:"start_balance,omitempty"`
+	EndBalance           uint64   `protobuf:"varint,2,opt,name=end_balance,json=endBalance,proto3" json:"end_balance,omitempty"`
+	Delta                int64    `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorBalanceDeltaResponse) Reset()         { *m = ValidatorBalanceDeltaResponse{} }
+func (m *ValidatorBalanceDeltaResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalanceDeltaResponse) ProtoMessage()    {}
+func (*ValidatorBalanceDeltaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{89}
+}
+
+func (m *ValidatorBalanceDeltaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorBalanceDeltaResponse.Unmarshal(m, b)
+}
+func (m *ValidatorBalanceDeltaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorBalanceDeltaResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorBalanceDeltaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalanceDeltaResponse.Merge(m, src)
+}
+func (m *ValidatorBalanceDeltaResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorBalanceDeltaResponse.Size(m)
+}
+func (m *ValidatorBalanceDeltaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalanceDeltaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalanceDeltaResponse proto.InternalMessageInfo
+
+func (m *ValidatorBalanceDeltaResponse) GetStartBalance() uint64 {
+	if m != nil {
+		return m.StartBalance
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltaResponse) GetEndBalance() uint64 {
+	if m != nil {
+		return m.EndBalance
+	}
+	return 0
+}
+
+func (m *ValidatorBalanceDeltaResponse) GetDelta() int64 {
+	if m != nil {
+		return m.Delta
+	}
+	return 0
+}
+
+type ValidatorAttestationsRequest struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	StartEpoch           uint64   `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorAttestationsRequest) Reset()         { *m = ValidatorAttestationsRequest{} }
+func (m *ValidatorAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestationsRequest) ProtoMessage()    {}
+func (*ValidatorAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{90}
+}
+
+func (m *ValidatorAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAttestationsRequest.Unmarshal(m, b)
+}
+func (m *ValidatorAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAttestationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestationsRequest.Merge(m, src)
+}
+func (m *ValidatorAttestationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAttestationsRequest.Size(m)
+}
+func (m *ValidatorAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestationsRequest proto.InternalMessageInfo
+
+func (m *ValidatorAttestationsRequest) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorAttestationsRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorAttestationsRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+type ValidatorAttestationsResponse struct {
+	Attestations         []*v1.Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValidatorAttestationsResponse) Reset()         { *m = ValidatorAttestationsResponse{} }
+func (m *ValidatorAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestationsResponse) ProtoMessage()    {}
+func (*ValidatorAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{91}
+}
+
+func (m *ValidatorAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAttestationsResponse.Unmarshal(m, b)
+}
+func (m *ValidatorAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAttestationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestationsResponse.Merge(m, src)
+}
+func (m *ValidatorAttestationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAttestationsResponse.Size(m)
+}
+func (m *ValidatorAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestationsResponse proto.InternalMessageInfo
+
+func (m *ValidatorAttestationsResponse) GetAttestations() []*v1.Attestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+type WithdrawableValidatorsRequest struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// The maximum number of indices to return, a default is used when unset.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token of a previous response, empty for the first page.
+	PageToken            string   `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawableValidatorsRequest) Reset()         { *m = WithdrawableValidatorsRequest{} }
+func (m *WithdrawableValidatorsRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawableValidatorsRequest) ProtoMessage()    {}
+func (*WithdrawableValidatorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{92}
+}
+
+func (m *WithdrawableValidatorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawableValidatorsRequest.Unmarshal(m, b)
+}
+func (m *WithdrawableValidatorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawableValidatorsRequest.Marshal(b, m, deterministic)
+}
+func (m *WithdrawableValidatorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawableValidatorsRequest.Merge(m, src)
+}
+func (m *WithdrawableValidatorsRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawableValidatorsRequest.Size(m)
+}
+func (m *WithdrawableValidatorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawableValidatorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawableValidatorsRequest proto.InternalMessageInfo
+
+func (m *WithdrawableValidatorsRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *WithdrawableValidatorsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *WithdrawableValidatorsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type WithdrawableValidatorsResponse struct {
+	ValidatorIndices []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	// The token to request the following page with, empty if this is the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// The total number of validators withdrawable at the epoch.
+	TotalSize            uint64   `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawableValidatorsResponse) Reset()         { *m = WithdrawableValidatorsResponse{} }
+func (m *WithdrawableValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*WithdrawableValidatorsResponse) ProtoMessage()    {}
+func (*WithdrawableValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{93}
+}
+
+func (m *WithdrawableValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawableValidatorsResponse.Unmarshal(m, b)
+}
+func (m *WithdrawableValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawableValidatorsResponse.Marshal(b, m, deterministic)
+}
+func (m *WithdrawableValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawableValidatorsResponse.Merge(m, src)
+}
+func (m *WithdrawableValidatorsResponse) XXX_Size() int {
+	return xxx_messageInfo_WithdrawableValidatorsResponse.Size(m)
+}
+func (m *WithdrawableValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawableValidatorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawableValidatorsResponse proto.InternalMessageInfo
+
+func (m *WithdrawableValidatorsResponse) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *WithdrawableValidatorsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *WithdrawableValidatorsResponse) GetTotalSize() uint64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+type AggregatePublicKeyRequest struct {
+	ValidatorIndices     []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregatePublicKeyRequest) Reset()         { *m = AggregatePublicKeyRequest{} }
+func (m *AggregatePublicKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*AggregatePublicKeyRequest) ProtoMessage()    {}
+func (*AggregatePublicKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{94}
+}
+
+func (m *AggregatePublicKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregatePublicKeyRequest.Unmarshal(m, b)
+}
+func (m *AggregatePublicKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregatePublicKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *AggregatePublicKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatePublicKeyRequest.Merge(m, src)
+}
+func (m *AggregatePublicKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_AggregatePublicKeyRequest.Size(m)
+}
+func (m *AggregatePublicKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatePublicKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatePublicKeyRequest proto.InternalMessageInfo
+
+func (m *AggregatePublicKeyRequest) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+type AggregatePublicKeyResponse struct {
+	// The serialized BLS public key aggregated from the public keys of the requested validators.
+	AggregatePubkey      []byte   `protobuf:"bytes,1,opt,name=aggregate_pubkey,json=aggregatePubkey,proto3" json:"aggregate_pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AggregatePublicKeyResponse) Reset()         { *m = AggregatePublicKeyResponse{} }
+func (m *AggregatePublicKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*AggregatePublicKeyResponse) ProtoMessage()    {}
+func (*AggregatePublicKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{95}
+}
+
+func (m *AggregatePublicKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AggregatePublicKeyResponse.Unmarshal(m, b)
+}
+func (m *AggregatePublicKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AggregatePublicKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *AggregatePublicKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregatePublicKeyResponse.Merge(m, src)
+}
+func (m *AggregatePublicKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_AggregatePublicKeyResponse.Size(m)
+}
+func (m *AggregatePublicKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregatePublicKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregatePublicKeyResponse proto.InternalMessageInfo
+
+func (m *AggregatePublicKeyResponse) GetAggregatePubkey() []byte {
+	if m != nil {
+		return m.AggregatePubkey
+	}
+	return nil
+}
+
+type ValidatorAttestedRequest struct {
+	ValidatorIndex       uint64   `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorAttestedRequest) Reset()         { *m = ValidatorAttestedRequest{} }
+func (m *ValidatorAttestedRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestedRequest) ProtoMessage()    {}
+func (*ValidatorAttestedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{96}
+}
+
+func (m *ValidatorAttestedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAttestedRequest.Unmarshal(m, b)
+}
+func (m *ValidatorAttestedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAttestedRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAttestedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestedRequest.Merge(m, src)
+}
+func (m *ValidatorAttestedRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAttestedRequest.Size(m)
+}
+func (m *ValidatorAttestedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestedRequest proto.InternalMessageInfo
+
+func (m *ValidatorAttestedRequest) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorAttestedRequest) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+type ValidatorAttestedResponse struct {
+	// Whether the validator's bit is set in a canonical attestation for the slot.
+	Attested bool `protobuf:"varint,1,opt,name=attested,proto3" json:"attested,omitempty"`
+	// The root of the first canonical block which included such an attestation, empty if none did.
+	BlockRoot []byte `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	// The slot of the including block.
+	InclusionSlot        uint64   `protobuf:"varint,3,opt,name=inclusion_slot,json=inclusionSlot,proto3" json:"inclusion_slot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorAttestedResponse) Reset()         { *m = ValidatorAttestedResponse{} }
+func (m *ValidatorAttestedResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorAttestedResponse) ProtoMessage()    {}
+func (*ValidatorAttestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{97}
+}
+
+func (m *ValidatorAttestedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorAttestedResponse.Unmarshal(m, b)
+}
+func (m *ValidatorAttestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorAttestedResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorAttestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorAttestedResponse.Merge(m, src)
+}
+func (m *ValidatorAttestedResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorAttestedResponse.Size(m)
+}
+func (m *ValidatorAttestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorAttestedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorAttestedResponse proto.InternalMessageInfo
+
+func (m *ValidatorAttestedResponse) GetAttested() bool {
+	if m != nil {
+		return m.Attested
+	}
+	return false
+}
+
+func (m *ValidatorAttestedResponse) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *ValidatorAttestedResponse) GetInclusionSlot() uint64 {
+	if m != nil {
+		return m.InclusionSlot
+	}
+	return 0
+}
+
+type ValidatePubkeyRequest struct {
+	// The 48 byte compressed BLS public key.
+	Pubkey               []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePubkeyRequest) Reset()         { *m = ValidatePubkeyRequest{} }
+func (m *ValidatePubkeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatePubkeyRequest) ProtoMessage()    {}
+func (*ValidatePubkeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{98}
+}
+
+func (m *ValidatePubkeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePubkeyRequest.Unmarshal(m, b)
+}
+func (m *ValidatePubkeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePubkeyRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatePubkeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePubkeyRequest.Merge(m, src)
+}
+func (m *ValidatePubkeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatePubkeyRequest.Size(m)
+}
+func (m *ValidatePubkeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePubkeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePubkeyRequest proto.InternalMessageInfo
+
+func (m *ValidatePubkeyRequest) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type ValidatePubkeyResponse struct {
+	// The compressed serialization of the parsed public key, which is the form stored in the validator registry.
+	Pubkey               []byte   `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatePubkeyResponse) Reset()         { *m = ValidatePubkeyResponse{} }
+func (m *ValidatePubkeyResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatePubkeyResponse) ProtoMessage()    {}
+func (*ValidatePubkeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{99}
+}
+
+func (m *ValidatePubkeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatePubkeyResponse.Unmarshal(m, b)
+}
+func (m *ValidatePubkeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatePubkeyResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatePubkeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatePubkeyResponse.Merge(m, src)
+}
+func (m *ValidatePubkeyResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatePubkeyResponse.Size(m)
+}
+func (m *ValidatePubkeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatePubkeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatePubkeyResponse proto.InternalMessageInfo
+
+func (m *ValidatePubkeyResponse) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+type ValidatorBalancesRequest struct {
+	ValidatorIndices     []uint64 `protobuf:"varint,1,rep,packed,name=validator_indices,json=validatorIndices,proto3" json:"validator_indices,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorBalancesRequest) Reset()         { *m = ValidatorBalancesRequest{} }
+func (m *ValidatorBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalancesRequest) ProtoMessage()    {}
+func (*ValidatorBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{100}
+}
+
+func (m *ValidatorBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorBalancesRequest.Unmarshal(m, b)
+}
+func (m *ValidatorBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorBalancesRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalancesRequest.Merge(m, src)
+}
+func (m *ValidatorBalancesRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorBalancesRequest.Size(m)
+}
+func (m *ValidatorBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalancesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalancesRequest proto.InternalMessageInfo
+
+func (m *ValidatorBalancesRequest) GetValidatorIndices() []uint64 {
+	if m != nil {
+		return m.ValidatorIndices
+	}
+	return nil
+}
+
+func (m *ValidatorBalancesRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
+	}
+	return nil
+}
+
+type ValidatorBalancesResponse struct {
+	// The balances of the requested validator indices followed by the ones of the requested public keys,
+	// in the order of the request.
+	Balances             []*ValidatorBalancesResponse_Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                             `json:"-"`
+	XXX_unrecognized     []byte                               `json:"-"`
+	XXX_sizecache        int32                                `json:"-"`
+}
+
+func (m *ValidatorBalancesResponse) Reset()         { *m = ValidatorBalancesResponse{} }
+func (m *ValidatorBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalancesResponse) ProtoMessage()    {}
+func (*ValidatorBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{101}
+}
+
+func (m *ValidatorBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorBalancesResponse.Unmarshal(m, b)
+}
+func (m *ValidatorBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorBalancesResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalancesResponse.Merge(m, src)
+}
+func (m *ValidatorBalancesResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorBalancesResponse.Size(m)
+}
+func (m *ValidatorBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalancesResponse proto.InternalMessageInfo
+
+func (m *ValidatorBalancesResponse) GetBalances() []*ValidatorBalancesResponse_Balance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+type ValidatorBalancesResponse_Balance struct {
+	ValidatorIndex uint64 `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	PublicKey      []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// Whether the validator is in the registry of the head state, the balances are zero otherwise.
+	Found                bool     `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty"`
+	Balance              uint64   `protobuf:"varint,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	EffectiveBalance     uint64   `protobuf:"varint,5,opt,name=effective_balance,json=effectiveBalance,proto3" json:"effective_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorBalancesResponse_Balance) Reset()         { *m = ValidatorBalancesResponse_Balance{} }
+func (m *ValidatorBalancesResponse_Balance) String() string { return proto.CompactTextString(m) }
+func (*ValidatorBalancesResponse_Balance) ProtoMessage()    {}
+func (*ValidatorBalancesResponse_Balance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{101, 0}
+}
+
+func (m *ValidatorBalancesResponse_Balance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorBalancesResponse_Balance.Unmarshal(m, b)
+}
+func (m *ValidatorBalancesResponse_Balance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorBalancesResponse_Balance.Marshal(b, m, deterministic)
+}
+func (m *ValidatorBalancesResponse_Balance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorBalancesResponse_Balance.Merge(m, src)
+}
+func (m *ValidatorBalancesResponse_Balance) XXX_Size() int {
+	return xxx_messageInfo_ValidatorBalancesResponse_Balance.Size(m)
+}
+func (m *ValidatorBalancesResponse_Balance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorBalancesResponse_Balance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorBalancesResponse_Balance proto.InternalMessageInfo
+
+func (m *ValidatorBalancesResponse_Balance) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorBalancesResponse_Balance) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *ValidatorBalancesResponse_Balance) GetFound() bool {
+	if m != nil {
+		return m.Found
+	}
+	return false
+}
+
+func (m *ValidatorBalancesResponse_Balance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *ValidatorBalancesResponse_Balance) GetEffectiveBalance() uint64 {
+	if m != nil {
+		return m.EffectiveBalance
+	}
+	return 0
+}
+
+type ValidatorPerformanceSummaryRequest struct {
+	ValidatorIndex uint64 `protobuf:"varint,1,opt,name=validator_index,json=validatorIndex,proto3" json:"validator_index,omitempty"`
+	// The range of epochs to summarize, inclusive on both ends. The end epoch must be before the current epoch.
+	StartEpoch           uint64   `protobuf:"varint,2,opt,name=start_epoch,json=startEpoch,proto3" json:"start_epoch,omitempty"`
+	EndEpoch             uint64   `protobuf:"varint,3,opt,name=end_epoch,json=endEpoch,proto3" json:"end_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorPerformanceSummaryRequest) Reset()         { *m = ValidatorPerformanceSummaryRequest{} }
+func (m *ValidatorPerformanceSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceSummaryRequest) ProtoMessage()    {}
+func (*ValidatorPerformanceSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{102}
+}
+
+func (m *ValidatorPerformanceSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorPerformanceSummaryRequest.Unmarshal(m, b)
+}
+func (m *ValidatorPerformanceSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorPerformanceSummaryRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidatorPerformanceSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceSummaryRequest.Merge(m, src)
+}
+func (m *ValidatorPerformanceSummaryRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidatorPerformanceSummaryRequest.Size(m)
+}
+func (m *ValidatorPerformanceSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceSummaryRequest proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceSummaryRequest) GetValidatorIndex() uint64 {
+	if m != nil {
+		return m.ValidatorIndex
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummaryRequest) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummaryRequest) GetEndEpoch() uint64 {
+	if m != nil {
+		return m.EndEpoch
+	}
+	return 0
+}
+
+type ValidatorPerformanceSummaryResponse struct {
+	// The number of epochs in the range in which the validator was active.
+	EpochsActive uint64 `protobuf:"varint,1,opt,name=epochs_active,json=epochsActive,proto3" json:"epochs_active,omitempty"`
+	// The number of attestation duties whose attestation was included in a canonical block.
+	AttestationsIncluded uint64 `protobuf:"varint,2,opt,name=attestations_included,json=attestationsIncluded,proto3" json:"attestations_included,omitempty"`
+	// The number of attestation duties without an included attestation and of proposal duties without a canonical block.
+	MissedDuties uint64 `protobuf:"varint,3,opt,name=missed_duties,json=missedDuties,proto3" json:"missed_duties,omitempty"`
+	// The number of canonical blocks proposed by the validator.
+	Proposals uint64 `protobuf:"varint,4,opt,name=proposals,proto3" json:"proposals,omitempty"`
+	// The balance change between the start of the start epoch and the start of the epoch after the end epoch, in Gwei.
+	BalanceChange        int64    `protobuf:"varint,5,opt,name=balance_change,json=balanceChange,proto3" json:"balance_change,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorPerformanceSummaryResponse) Reset()         { *m = ValidatorPerformanceSummaryResponse{} }
+func (m *ValidatorPerformanceSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPerformanceSummaryResponse) ProtoMessage()    {}
+func (*ValidatorPerformanceSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{103}
+}
+
+func (m *ValidatorPerformanceSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidatorPerformanceSummaryResponse.Unmarshal(m, b)
+}
+func (m *ValidatorPerformanceSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidatorPerformanceSummaryResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidatorPerformanceSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPerformanceSummaryResponse.Merge(m, src)
+}
+func (m *ValidatorPerformanceSummaryResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidatorPerformanceSummaryResponse.Size(m)
+}
+func (m *ValidatorPerformanceSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPerformanceSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPerformanceSummaryResponse proto.InternalMessageInfo
+
+func (m *ValidatorPerformanceSummaryResponse) GetEpochsActive() uint64 {
+	if m != nil {
+		return m.EpochsActive
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummaryResponse) GetAttestationsIncluded() uint64 {
+	if m != nil {
+		return m.AttestationsIncluded
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummaryResponse) GetMissedDuties() uint64 {
+	if m != nil {
+		return m.MissedDuties
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummaryResponse) GetProposals() uint64 {
+	if m != nil {
+		return m.Proposals
+	}
+	return 0
+}
+
+func (m *ValidatorPerformanceSummaryResponse) GetBalanceChange() int64 {
+	if m != nil {
+		return m.BalanceChange
+	}
+	return 0
+}
+
+type AttestationDataRootResponse struct {
+	// The root used to key the attestation data.
+	DataRoot []byte `protobuf:"bytes,1,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// The root of the attestation data with a zero custody bit, which attesters sign.
+	SigningRoot          []byte   `protobuf:"bytes,2,opt,name=signing_root,json=signingRoot,proto3" json:"signing_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AttestationDataRootResponse) Reset()         { *m = AttestationDataRootResponse{} }
+func (m *AttestationDataRootResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationDataRootResponse) ProtoMessage()    {}
+func (*AttestationDataRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{104}
+}
+
+func (m *AttestationDataRootResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AttestationDataRootResponse.Unmarshal(m, b)
+}
+func (m *AttestationDataRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AttestationDataRootResponse.Marshal(b, m, deterministic)
+}
+func (m *AttestationDataRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationDataRootResponse.Merge(m, src)
+}
+func (m *AttestationDataRootResponse) XXX_Size() int {
+	return xxx_messageInfo_AttestationDataRootResponse.Size(m)
+}
+func (m *AttestationDataRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationDataRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationDataRootResponse proto.InternalMessageInfo
+
+func (m *AttestationDataRootResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *AttestationDataRootResponse) GetSigningRoot() []byte {
+	if m != nil {
+		return m.SigningRoot
+	}
+	return nil
+}
+
+type ValidateAttestationRequest struct {
+	Attestation          *v1.Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ValidateAttestationRequest) Reset()         { *m = ValidateAttestationRequest{} }
+func (m *ValidateAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateAttestationRequest) ProtoMessage()    {}
+func (*ValidateAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{105}
+}
+
+func (m *ValidateAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAttestationRequest.Unmarshal(m, b)
+}
+func (m *ValidateAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAttestationRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAttestationRequest.Merge(m, src)
+}
+func (m *ValidateAttestationRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateAttestationRequest.Size(m)
+}
+func (m *ValidateAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAttestationRequest proto.InternalMessageInfo
+
+func (m *ValidateAttestationRequest) GetAttestation() *v1.Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+type ValidateAttestationResponse struct {
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The reason the attestation would be rejected, empty if it is valid.
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateAttestationResponse) Reset()         { *m = ValidateAttestationResponse{} }
+func (m *ValidateAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateAttestationResponse) ProtoMessage()    {}
+func (*ValidateAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{106}
+}
+
+func (m *ValidateAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateAttestationResponse.Unmarshal(m, b)
+}
+func (m *ValidateAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateAttestationResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateAttestationResponse.Merge(m, src)
+}
+func (m *ValidateAttestationResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateAttestationResponse.Size(m)
+}
+func (m *ValidateAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateAttestationResponse proto.InternalMessageInfo
+
+func (m *ValidateAttestationResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateAttestationResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.SlashingOffense", SlashingOffense_name, SlashingOffense_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.BlockTreeResponse_FinalizationStatus", BlockTreeResponse_FinalizationStatus_name, BlockTreeResponse_FinalizationStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.DepositStatusResponse_Status", DepositStatusResponse_Status_name, DepositStatusResponse_Status_value)
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
+	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
+	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
+	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
+	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
+	proto.RegisterType((*AttestationDataRequest)(nil), "ethereum.beacon.rpc.v1.AttestationDataRequest")
+	proto.RegisterType((*AttestationDataResponse)(nil), "ethereum.beacon.rpc.v1.AttestationDataResponse")
+	proto.RegisterType((*PendingAttestationsRequest)(nil), "ethereum.beacon.rpc.v1.PendingAttestationsRequest")
+	proto.RegisterType((*PendingAttestationsResponse)(nil), "ethereum.beacon.rpc.v1.PendingAttestationsResponse")
+	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
+	proto.RegisterType((*ProposeRequest)(nil), "ethereum.beacon.rpc.v1.ProposeRequest")
+	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
+	proto.RegisterType((*ProposerIndexRequest)(nil), "ethereum.beacon.rpc.v1.ProposerIndexRequest")
+	proto.RegisterType((*ProposerIndexResponse)(nil), "ethereum.beacon.rpc.v1.ProposerIndexResponse")
+	proto.RegisterType((*CurrentProposerResponse)(nil), "ethereum.beacon.rpc.v1.CurrentProposerResponse")
+	proto.RegisterType((*StateRootResponse)(nil), "ethereum.beacon.rpc.v1.StateRootResponse")
+	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
+	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
+	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
+	proto.RegisterType((*CommitteeAssignmentsRequest)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentsRequest")
+	proto.RegisterType((*PendingDepositsRequest)(nil), "ethereum.beacon.rpc.v1.PendingDepositsRequest")
+	proto.RegisterType((*PendingDepositsResponse)(nil), "ethereum.beacon.rpc.v1.PendingDepositsResponse")
+	proto.RegisterType((*DepositReadiness)(nil), "ethereum.beacon.rpc.v1.DepositReadiness")
+	proto.RegisterType((*CommitteeAssignmentResponse)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentResponse")
+	proto.RegisterType((*CommitteeAssignmentResponse_CommitteeAssignment)(nil), "ethereum.beacon.rpc.v1.CommitteeAssignmentResponse.CommitteeAssignment")
+	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
+	proto.RegisterType((*Eth1DataResponse)(nil), "ethereum.beacon.rpc.v1.Eth1DataResponse")
+	proto.RegisterType((*BlockTreeRequest)(nil), "ethereum.beacon.rpc.v1.BlockTreeRequest")
+	proto.RegisterType((*ValidatorIndexRange)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRange")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
+	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*DetectedSlashingsResponse)(nil), "ethereum.beacon.rpc.v1.DetectedSlashingsResponse")
+	proto.RegisterType((*DetectedSlashingsResponse_DetectedSlashing)(nil), "ethereum.beacon.rpc.v1.DetectedSlashingsResponse.DetectedSlashing")
+	proto.RegisterType((*BeaconCommitteeRequest)(nil), "ethereum.beacon.rpc.v1.BeaconCommitteeRequest")
+	proto.RegisterType((*BeaconCommitteeResponse)(nil), "ethereum.beacon.rpc.v1.BeaconCommitteeResponse")
+	proto.RegisterType((*BlockStreamRequest)(nil), "ethereum.beacon.rpc.v1.BlockStreamRequest")
+	proto.RegisterType((*AggregateStreamRequest)(nil), "ethereum.beacon.rpc.v1.AggregateStreamRequest")
+	proto.RegisterType((*WithdrawalCredentialsRequest)(nil), "ethereum.beacon.rpc.v1.WithdrawalCredentialsRequest")
+	proto.RegisterType((*WithdrawalCredentialsResponse)(nil), "ethereum.beacon.rpc.v1.WithdrawalCredentialsResponse")
+	proto.RegisterType((*WithdrawalCredentialsResponse_Credentials)(nil), "ethereum.beacon.rpc.v1.WithdrawalCredentialsResponse.Credentials")
+	proto.RegisterType((*ValidatorDutiesRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorDutiesRequest")
+	proto.RegisterType((*ValidatorDutiesResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorDutiesResponse")
+	proto.RegisterType((*ValidatorDutiesResponse_Duty)(nil), "ethereum.beacon.rpc.v1.ValidatorDutiesResponse.Duty")
+	proto.RegisterType((*SyncStatusResponse)(nil), "ethereum.beacon.rpc.v1.SyncStatusResponse")
+	proto.RegisterType((*EpochAttestationStatsRequest)(nil), "ethereum.beacon.rpc.v1.EpochAttestationStatsRequest")
+	proto.RegisterType((*EpochAttestationStatsResponse)(nil), "ethereum.beacon.rpc.v1.EpochAttestationStatsResponse")
+	proto.RegisterType((*Eth1DataVotesResponse)(nil), "ethereum.beacon.rpc.v1.Eth1DataVotesResponse")
+	proto.RegisterType((*BlocksBySlotRequest)(nil), "ethereum.beacon.rpc.v1.BlocksBySlotRequest")
+	proto.RegisterType((*BlocksBySlotResponse)(nil), "ethereum.beacon.rpc.v1.BlocksBySlotResponse")
+	proto.RegisterType((*BlocksBySlotResponse_SlotBlock)(nil), "ethereum.beacon.rpc.v1.BlocksBySlotResponse.SlotBlock")
+	proto.RegisterType((*SlotTick)(nil), "ethereum.beacon.rpc.v1.SlotTick")
+	proto.RegisterType((*BlockByRootRequest)(nil), "ethereum.beacon.rpc.v1.BlockByRootRequest")
+	proto.RegisterType((*BlockOperationCountsResponse)(nil), "ethereum.beacon.rpc.v1.BlockOperationCountsResponse")
+	proto.RegisterType((*BlockAttestationsResponse)(nil), "ethereum.beacon.rpc.v1.BlockAttestationsResponse")
+	proto.RegisterType((*BlockAttestationsResponse_IncludedAttestation)(nil), "ethereum.beacon.rpc.v1.BlockAttestationsResponse.IncludedAttestation")
+	proto.RegisterType((*ProposerRewardResponse)(nil), "ethereum.beacon.rpc.v1.ProposerRewardResponse")
+	proto.RegisterType((*ActiveBalanceRequest)(nil), "ethereum.beacon.rpc.v1.ActiveBalanceRequest")
+	proto.RegisterType((*ActiveBalanceResponse)(nil), "ethereum.beacon.rpc.v1.ActiveBalanceResponse")
+	proto.RegisterType((*ActiveValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ActiveValidatorsRequest")
+	proto.RegisterType((*ActiveValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ActiveValidatorsResponse")
+	proto.RegisterType((*EpochShufflingRequest)(nil), "ethereum.beacon.rpc.v1.EpochShufflingRequest")
+	proto.RegisterType((*EpochShufflingResponse)(nil), "ethereum.beacon.rpc.v1.EpochShufflingResponse")
+	proto.RegisterType((*MissedAttestersRequest)(nil), "ethereum.beacon.rpc.v1.MissedAttestersRequest")
+	proto.RegisterType((*MissedAttestersResponse)(nil), "ethereum.beacon.rpc.v1.MissedAttestersResponse")
+	proto.RegisterType((*SkippedSlotsRequest)(nil), "ethereum.beacon.rpc.v1.SkippedSlotsRequest")
+	proto.RegisterType((*SkippedSlotsResponse)(nil), "ethereum.beacon.rpc.v1.SkippedSlotsResponse")
+	proto.RegisterType((*HistoricalBlockRootsRequest)(nil), "ethereum.beacon.rpc.v1.HistoricalBlockRootsRequest")
+	proto.RegisterType((*HistoricalBlockRootsResponse)(nil), "ethereum.beacon.rpc.v1.HistoricalBlockRootsResponse")
+	proto.RegisterType((*HistoricalBlockRootsResponse_SlotBlockRoot)(nil), "ethereum.beacon.rpc.v1.HistoricalBlockRootsResponse.SlotBlockRoot")
+	proto.RegisterType((*SlotCoverageRequest)(nil), "ethereum.beacon.rpc.v1.SlotCoverageRequest")
+	proto.RegisterType((*SlotCoverageResponse)(nil), "ethereum.beacon.rpc.v1.SlotCoverageResponse")
+	proto.RegisterType((*SlotCoverageResponse_CommitteeCoverage)(nil), "ethereum.beacon.rpc.v1.SlotCoverageResponse.CommitteeCoverage")
+	proto.RegisterType((*Eth1VotingPeriodResponse)(nil), "ethereum.beacon.rpc.v1.Eth1VotingPeriodResponse")
+	proto.RegisterType((*Eth1VoteCandidatesResponse)(nil), "ethereum.beacon.rpc.v1.Eth1VoteCandidatesResponse")
+	proto.RegisterType((*Eth1VoteCandidatesResponse_Candidate)(nil), "ethereum.beacon.rpc.v1.Eth1VoteCandidatesResponse.Candidate")
+	proto.RegisterType((*Eth1FollowStatusResponse)(nil), "ethereum.beacon.rpc.v1.Eth1FollowStatusResponse")
+	proto.RegisterType((*GenesisDepositRootResponse)(nil), "ethereum.beacon.rpc.v1.GenesisDepositRootResponse")
+	proto.RegisterType((*GenesisValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.GenesisValidatorsRequest")
+	proto.RegisterType((*GenesisValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.GenesisValidatorsResponse")
+	proto.RegisterType((*PendingDepositCountResponse)(nil), "ethereum.beacon.rpc.v1.PendingDepositCountResponse")
+	proto.RegisterType((*UpcomingActivationsResponse)(nil), "ethereum.beacon.rpc.v1.UpcomingActivationsResponse")
+	proto.RegisterType((*LastFinalizedSlotResponse)(nil), "ethereum.beacon.rpc.v1.LastFinalizedSlotResponse")
+	proto.RegisterType((*FinalityDistanceResponse)(nil), "ethereum.beacon.rpc.v1.FinalityDistanceResponse")
+	proto.RegisterType((*StateSchemaInfoResponse)(nil), "ethereum.beacon.rpc.v1.StateSchemaInfoResponse")
+	proto.RegisterType((*ProposedBlockRequest)(nil), "ethereum.beacon.rpc.v1.ProposedBlockRequest")
+	proto.RegisterType((*ProposedBlockResponse)(nil), "ethereum.beacon.rpc.v1.ProposedBlockResponse")
+	proto.RegisterType((*CrosslinksResponse)(nil), "ethereum.beacon.rpc.v1.CrosslinksResponse")
+	proto.RegisterType((*CrosslinksResponse_ShardCrosslink)(nil), "ethereum.beacon.rpc.v1.CrosslinksResponse.ShardCrosslink")
+	proto.RegisterType((*ChurnLimitResponse)(nil), "ethereum.beacon.rpc.v1.ChurnLimitResponse")
+	proto.RegisterType((*AttestingBalancesResponse)(nil), "ethereum.beacon.rpc.v1.AttestingBalancesResponse")
+	proto.RegisterType((*TotalDepositedResponse)(nil), "ethereum.beacon.rpc.v1.TotalDepositedResponse")
+	proto.RegisterType((*DepositStatusRequest)(nil), "ethereum.beacon.rpc.v1.DepositStatusRequest")
+	proto.RegisterType((*DepositStatusResponse)(nil), "ethereum.beacon.rpc.v1.DepositStatusResponse")
+	proto.RegisterType((*JustifiedHistoryRequest)(nil), "ethereum.beacon.rpc.v1.JustifiedHistoryRequest")
+	proto.RegisterType((*JustifiedHistoryResponse)(nil), "ethereum.beacon.rpc.v1.JustifiedHistoryResponse")
+	proto.RegisterType((*JustifiedHistoryResponse_EpochCheckpoint)(nil), "ethereum.beacon.rpc.v1.JustifiedHistoryResponse.EpochCheckpoint")
+	proto.RegisterType((*ForkChoiceStoreResponse)(nil), "ethereum.beacon.rpc.v1.ForkChoiceStoreResponse")
+	proto.RegisterType((*ForkChoiceStoreResponse_Checkpoint)(nil), "ethereum.beacon.rpc.v1.ForkChoiceStoreResponse.Checkpoint")
+	proto.RegisterType((*ForkChoiceStoreResponse_TrackedBlock)(nil), "ethereum.beacon.rpc.v1.ForkChoiceStoreResponse.TrackedBlock")
+	proto.RegisterType((*AttestationTargetCacheResponse)(nil), "ethereum.beacon.rpc.v1.AttestationTargetCacheResponse")
+	proto.RegisterMapType((map[uint64]*v1.AttestationTarget)(nil), "ethereum.beacon.rpc.v1.AttestationTargetCacheResponse.TargetsEntry")
+	proto.RegisterType((*ValidatorBalanceDeltaRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorBalanceDeltaRequest")
+	proto.RegisterType((*ValidatorBalanceDeltaResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorBalanceDeltaResponse")
+	proto.RegisterType((*ValidatorAttestationsRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestationsRequest")
+	proto.RegisterType((*ValidatorAttestationsResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestationsResponse")
+	proto.RegisterType((*WithdrawableValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.WithdrawableValidatorsRequest")
+	proto.RegisterType((*WithdrawableValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.WithdrawableValidatorsResponse")
+	proto.RegisterType((*AggregatePublicKeyRequest)(nil), "ethereum.beacon.rpc.v1.AggregatePublicKeyRequest")
+	proto.RegisterType((*AggregatePublicKeyResponse)(nil), "ethereum.beacon.rpc.v1.AggregatePublicKeyResponse")
+	proto.RegisterType((*ValidatorAttestedRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestedRequest")
+	proto.RegisterType((*ValidatorAttestedResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorAttestedResponse")
+	proto.RegisterType((*ValidatePubkeyRequest)(nil), "ethereum.beacon.rpc.v1.ValidatePubkeyRequest")
+	proto.RegisterType((*ValidatePubkeyResponse)(nil), "ethereum.beacon.rpc.v1.ValidatePubkeyResponse")
+	proto.RegisterType((*ValidatorBalancesRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorBalancesRequest")
+	proto.RegisterType((*ValidatorBalancesResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorBalancesResponse")
+	proto.RegisterType((*ValidatorBalancesResponse_Balance)(nil), "ethereum.beacon.rpc.v1.ValidatorBalancesResponse.Balance")
+	proto.RegisterType((*ValidatorPerformanceSummaryRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceSummaryRequest")
+	proto.RegisterType((*ValidatorPerformanceSummaryResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceSummaryResponse")
+	proto.RegisterType((*AttestationDataRootResponse)(nil), "ethereum.beacon.rpc.v1.AttestationDataRootResponse")
+	proto.RegisterType((*ValidateAttestationRequest)(nil), "ethereum.beacon.rpc.v1.ValidateAttestationRequest")
+	proto.RegisterType((*ValidateAttestationResponse)(nil), "ethereum.beacon.rpc.v1.ValidateAttestationResponse")
+}
+
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
+
+var fileDescriptor_9eb4e94b85965285 = []byte{
+	// 6275 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5c, 0x49, 0x6c, 0x24, 0xd7,
+	0x79, 0x56, 0x35, 0x97, 0x21, 0x7f, 0x2e, 0xdd, 0x2c, 0x92, 0x4d, 0xb2, 0x38, 0x63, 0x51, 0x25,
+	0xcb, 0xb3, 0x37, 0x39, 0x9c, 0xd1, 0x58, 0x1a, 0x59, 0x91, 0x9a, 0x64, 0x73, 0x86, 0x12, 0x45,
+	0xd2, 0xd5, 0x3d, 0x33, 0xb6, 0x60, 0xab, 0x5c, 0xec, 0x7e, 0xec, 0x2e, 0xb3, 0xbb, 0xaa, 0x55,
+	0x55, 0xcd, 0x21, 0x65, 0xc4, 0x8e, 0xb3, 0x22, 0x70, 0x12, 0xd8, 0x4a, 0x90, 0xdd, 0x71, 0x00,
+	0x23, 0xb7, 0x24, 0x40, 0x72, 0x48, 0x90, 0x43, 0xce, 0xb9, 0x24, 0xa7, 0x1c, 0x82, 0xc0, 0x40,
+	0x0e, 0x81, 0x9d, 0x20, 0x40, 0xee, 0x39, 0xe4, 0x90, 0xe0, 0xad, 0xf5, 0xaa, 0xba, 0xaa, 0x97,
+	0x91, 0x97, 0xcb, 0x0c, 0xfb, 0x7f, 0xff, 0xff, 0xbf, 0xfd, 0xfd, 0xcb, 0xfb, 0x5e, 0x81, 0xde,
+	0xf6, 0xdc, 0xc0, 0x5d, 0x3f, 0x46, 0x56, 0xd5, 0x75, 0xd6, 0xbd, 0x76, 0x75, 0xfd, 0xec, 0xce,
+	0xba, 0x8f, 0xbc, 0x33, 0xbb, 0x8a, 0xfc, 0x02, 0x29, 0x54, 0xf3, 0x28, 0x68, 0x20, 0x0f, 0x75,
+	0x5a, 0x05, 0xca, 0x56, 0xf0, 0xda, 0xd5, 0xc2, 0xd9, 0x1d, 0x6d, 0xb5, 0xee, 0xba, 0xf5, 0x26,
+	0x5a, 0x27, 0x5c, 0xc7, 0x9d, 0x93, 0x75, 0xd4, 0x6a, 0x07, 0x17, 0x54, 0x48, 0x7b, 0x31, 0x5e,
+	0x18, 0xd8, 0x2d, 0xe4, 0x07, 0x56, 0xab, 0xcd, 0x19, 0x22, 0x35, 0xb7, 0x37, 0xdb, 0xb8, 0xe6,
+	0xe0, 0xa2, 0xcd, 0xab, 0xd5, 0x2e, 0x33, 0x0d, 0x56, 0xdb, 0x5e, 0xb7, 0x1c, 0xc7, 0x0d, 0xac,
+	0xc0, 0x76, 0x1d, 0x5e, 0x7a, 0x8b, 0xfc, 0x57, 0xbd, 0x5d, 0x47, 0xce, 0x6d, 0xff, 0x99, 0x55,
+	0xaf, 0x23, 0x6f, 0xdd, 0x6d, 0x13, 0x8e, 0x6e, 0x6e, 0xfd, 0x08, 0x56, 0x9f, 0x58, 0x4d, 0xbb,
+	0x66, 0x05, 0xae, 0x77, 0x84, 0xbc, 0x13, 0xd7, 0x6b, 0x59, 0x4e, 0x15, 0x19, 0xe8, 0xc3, 0x0e,
+	0xf2, 0x03, 0x55, 0x85, 0x51, 0xbf, 0xe9, 0x06, 0xcb, 0xca, 0x9a, 0x72, 0x6d, 0xd4, 0x20, 0x7f,
+	0xab, 0x57, 0x00, 0xda, 0x9d, 0xe3, 0xa6, 0x5d, 0x35, 0x4f, 0xd1, 0xc5, 0x72, 0x66, 0x4d, 0xb9,
+	0x36, 0x6d, 0x4c, 0x52, 0xca, 0xbb, 0xe8, 0x42, 0xff, 0xa1, 0x02, 0x97, 0x93, 0x55, 0xfa, 0x6d,
+	0xd7, 0xf1, 0x91, 0xba, 0x0c, 0x97, 0x8e, 0xad, 0x26, 0x26, 0x31, 0xb5, 0xfc, 0xa7, 0x7a, 0x1d,
+	0x72, 0x81, 0x1b, 0x58, 0x4d, 0xf3, 0x8c, 0xcb, 0xfb, 0x44, 0xff, 0xa8, 0x91, 0x25, 0x74, 0xa1,
+	0xd6, 0x57, 0xef, 0xc3, 0x12, 0x65, 0xb5, 0xaa, 0x81, 0x7d, 0x86, 0x64, 0x89, 0x11, 0x22, 0xb1,
+	0x48, 0x8a, 0x8b, 0xa4, 0x54, 0x92, 0x7b, 0x08, 0x6b, 0xd6, 0x19, 0xf2, 0xac, 0x3a, 0xea, 0x92,
+	0x34, 0x79, 0xab, 0x46, 0xd7, 0x94, 0x6b, 0x19, 0xe3, 0x0a, 0xe3, 0x8b, 0xa9, 0xd8, 0xa2, 0x4c,
+	0xfa, 0x9b, 0xa0, 0x09, 0x1a, 0x61, 0x21, 0xc3, 0xca, 0xc7, 0xed, 0x45, 0x98, 0x0a, 0xc7, 0xc8,
+	0x5f, 0x56, 0xd6, 0x46, 0xae, 0x4d, 0x1b, 0x20, 0x06, 0xc9, 0xd7, 0xbf, 0x97, 0x81, 0xd5, 0x44,
+	0x79, 0x36, 0x48, 0xf7, 0x61, 0xd1, 0xa2, 0x54, 0x54, 0x33, 0xbb, 0x54, 0x6d, 0x65, 0x96, 0x15,
+	0x63, 0x5e, 0x30, 0x1c, 0x09, 0xbd, 0xea, 0x13, 0x98, 0xf0, 0x03, 0x2b, 0xe8, 0xf8, 0x08, 0x0f,
+	0xdd, 0xc8, 0xb5, 0xa9, 0xcd, 0x07, 0x85, 0xe4, 0x55, 0x5a, 0xe8, 0x51, 0x7d, 0xa1, 0x4c, 0x74,
+	0x18, 0x42, 0x97, 0xd6, 0x86, 0x71, 0x4a, 0x8b, 0x4d, 0xbf, 0x12, 0x9b, 0x7e, 0xf5, 0x21, 0x8c,
+	0x53, 0x21, 0x32, 0x73, 0x53, 0x9b, 0xeb, 0x7d, 0xab, 0x67, 0x75, 0xb1, 0xaa, 0x0d, 0x26, 0xae,
+	0x3f, 0x80, 0xa5, 0xd2, 0xb9, 0x1d, 0xa0, 0x5a, 0x38, 0x7b, 0x03, 0x8f, 0xee, 0x1b, 0xb0, 0xdc,
+	0x2d, 0xcb, 0x46, 0xb6, 0xaf, 0xf0, 0x16, 0xe4, 0x8b, 0x41, 0x80, 0x7c, 0xba, 0x51, 0x76, 0xac,
+	0xc0, 0xe2, 0xf5, 0x2e, 0xc0, 0x98, 0xdf, 0xb0, 0xbc, 0x1a, 0x5b, 0xb7, 0xf4, 0x87, 0xd8, 0x23,
+	0x99, 0x70, 0x8f, 0xe8, 0xff, 0x9e, 0x81, 0xa5, 0x2e, 0x25, 0xac, 0x01, 0x9f, 0x85, 0x65, 0x3a,
+	0x12, 0xe6, 0x71, 0xd3, 0xad, 0x9e, 0x9a, 0x9e, 0xeb, 0x06, 0x66, 0xc3, 0xf2, 0x1b, 0x77, 0x37,
+	0xd9, 0x70, 0x2e, 0xd2, 0xf2, 0x2d, 0x5c, 0x6c, 0xb8, 0x6e, 0xf0, 0x88, 0x14, 0xaa, 0x6f, 0x80,
+	0x86, 0xda, 0x6e, 0xb5, 0x61, 0x1e, 0xbb, 0x1d, 0xa7, 0x66, 0x79, 0x17, 0x11, 0x51, 0xba, 0x11,
+	0x97, 0x08, 0xc7, 0x16, 0x63, 0x90, 0x84, 0xaf, 0x42, 0xf6, 0xab, 0x1d, 0x3f, 0xb0, 0x4f, 0x6c,
+	0x54, 0x33, 0x09, 0x13, 0xdb, 0x28, 0xb3, 0x82, 0x5c, 0xc2, 0x54, 0xf5, 0x4d, 0x58, 0x0d, 0x19,
+	0xbb, 0x5b, 0x38, 0x4a, 0xaa, 0x59, 0x16, 0x2c, 0xf1, 0x46, 0xee, 0x43, 0xae, 0x69, 0xe1, 0x8e,
+	0x9b, 0x55, 0xcf, 0xf5, 0xfd, 0xa6, 0xed, 0x9c, 0x2e, 0x8f, 0x91, 0x95, 0xf0, 0x52, 0xd7, 0x4a,
+	0x68, 0x6f, 0xb6, 0xf1, 0x4a, 0xd8, 0xe6, 0x8c, 0x46, 0x96, 0x8a, 0x0a, 0x82, 0xba, 0x0a, 0x93,
+	0x0d, 0x64, 0xd5, 0x4c, 0x32, 0xc0, 0xe3, 0xa4, 0xbd, 0x13, 0x98, 0x50, 0xc6, 0x83, 0xfc, 0xeb,
+	0x0a, 0x68, 0x47, 0xc8, 0xa9, 0xd9, 0x4e, 0x5d, 0x1a, 0x6b, 0xb1, 0x4a, 0xde, 0x00, 0xed, 0xc4,
+	0x6e, 0x06, 0xc8, 0x33, 0x3d, 0x64, 0xd5, 0x2e, 0xcc, 0x13, 0xd7, 0x33, 0x6d, 0xa7, 0xda, 0xec,
+	0xf8, 0xb6, 0xeb, 0x90, 0x91, 0x9e, 0x30, 0x96, 0x28, 0x87, 0x81, 0x19, 0x76, 0x5d, 0x6f, 0x8f,
+	0x17, 0xab, 0x05, 0x98, 0x6f, 0x7b, 0x6e, 0xdb, 0xf5, 0xad, 0x26, 0x1b, 0x04, 0x69, 0x8e, 0xe7,
+	0x78, 0x11, 0xe9, 0x3c, 0x69, 0x4b, 0x07, 0x56, 0x13, 0x9b, 0xc2, 0xe6, 0xfc, 0x09, 0x2c, 0xb4,
+	0x69, 0xb1, 0x69, 0x49, 0xe5, 0x64, 0xf5, 0x4d, 0x6d, 0xbe, 0x9c, 0x36, 0x32, 0x92, 0x2e, 0x63,
+	0xbe, 0xdd, 0xad, 0x5f, 0xff, 0x3c, 0xa8, 0xdb, 0x0d, 0xcb, 0x76, 0xca, 0x81, 0xe5, 0x05, 0xf2,
+	0x09, 0xeb, 0x63, 0x02, 0xaa, 0xb1, 0x6e, 0xf2, 0x9f, 0xea, 0x4b, 0x30, 0x5d, 0x47, 0x0e, 0xf2,
+	0x6d, 0xdf, 0xc4, 0x66, 0x87, 0xf5, 0x67, 0x8a, 0xd1, 0x2a, 0x76, 0x0b, 0xe9, 0x7f, 0x92, 0x81,
+	0xd9, 0x23, 0xd2, 0x3f, 0x24, 0xef, 0x37, 0xcb, 0x43, 0x0e, 0x5d, 0x04, 0x6c, 0x91, 0x02, 0x25,
+	0xe1, 0x69, 0xc7, 0x0c, 0x78, 0x78, 0x4c, 0xa7, 0xd3, 0x3a, 0x46, 0x1e, 0xd3, 0x0a, 0x98, 0x74,
+	0x40, 0x28, 0xea, 0xcb, 0x30, 0xe3, 0x59, 0x4e, 0xcd, 0x72, 0x4d, 0x0f, 0x9d, 0x21, 0xab, 0x49,
+	0xd6, 0xde, 0xb4, 0x31, 0x4d, 0x89, 0x06, 0xa1, 0xa9, 0xeb, 0x30, 0x2f, 0x0d, 0x8e, 0x79, 0x6c,
+	0x07, 0x2d, 0xcb, 0x3f, 0x65, 0x2b, 0x4e, 0x95, 0x8a, 0xb6, 0x68, 0x89, 0xfa, 0x00, 0x56, 0x64,
+	0x01, 0xab, 0x5e, 0xf7, 0x50, 0xdd, 0x0a, 0x90, 0xe9, 0xdb, 0xf5, 0xe5, 0xb1, 0xb5, 0x91, 0x6b,
+	0xa3, 0xc6, 0x92, 0xc4, 0x50, 0xe4, 0xe5, 0x65, 0xbb, 0xae, 0xbe, 0x06, 0x93, 0xc2, 0xf0, 0x92,
+	0x95, 0x35, 0xb5, 0xa9, 0x15, 0xa8, 0x61, 0x2d, 0x70, 0xd3, 0x5c, 0xa8, 0x70, 0x0e, 0x23, 0x64,
+	0xd6, 0xdf, 0x84, 0xac, 0x18, 0x1f, 0x36, 0xe0, 0x37, 0x60, 0x2e, 0x6d, 0x2f, 0x67, 0x8f, 0xa3,
+	0x1b, 0x44, 0xff, 0x2c, 0x2c, 0x30, 0x71, 0x6f, 0xcf, 0xa9, 0xa1, 0x73, 0x69, 0x90, 0xe5, 0x31,
+	0x54, 0xe2, 0x63, 0xa8, 0xdf, 0x86, 0xc5, 0x98, 0x20, 0xab, 0x7d, 0x01, 0xc6, 0x6c, 0x4c, 0xe0,
+	0xc7, 0x12, 0xf9, 0xa1, 0x3b, 0xb0, 0xb4, 0xdd, 0xf1, 0xf0, 0x14, 0x71, 0x29, 0x21, 0x90, 0x64,
+	0xd5, 0xaf, 0x42, 0x36, 0xb4, 0x84, 0x54, 0x1d, 0x9d, 0xc6, 0x59, 0x41, 0x26, 0xb5, 0xaa, 0x79,
+	0x18, 0x6f, 0x77, 0x8e, 0xf1, 0xd9, 0x4f, 0xe7, 0x90, 0xfd, 0xd2, 0x37, 0x61, 0x0e, 0x9f, 0xe4,
+	0x08, 0x77, 0x55, 0xd4, 0x74, 0x05, 0x00, 0x0f, 0x3e, 0x22, 0x03, 0xc3, 0x8d, 0x85, 0xcf, 0xd9,
+	0xf4, 0x37, 0x60, 0x96, 0x2e, 0x67, 0x21, 0x70, 0x1d, 0x72, 0xf2, 0x94, 0x4a, 0xeb, 0x2d, 0x2b,
+	0xd1, 0xf1, 0x50, 0xea, 0xf7, 0x61, 0xf1, 0x49, 0xa4, 0x69, 0x7c, 0x24, 0x7b, 0x5b, 0x28, 0xbd,
+	0x00, 0xf9, 0xb8, 0x5c, 0xcf, 0x81, 0x34, 0x61, 0x75, 0xdb, 0x6d, 0xb5, 0xec, 0x20, 0x40, 0xa8,
+	0xe8, 0xfb, 0x76, 0xdd, 0x69, 0x21, 0x27, 0x90, 0x8d, 0x11, 0x3d, 0x95, 0xc9, 0x1e, 0xe3, 0xf3,
+	0x46, 0x48, 0x64, 0x57, 0xc6, 0x0d, 0x4e, 0x26, 0xc1, 0x5a, 0xe5, 0xd9, 0xd9, 0xb1, 0x83, 0xda,
+	0xae, 0x6f, 0x87, 0xba, 0x5f, 0x82, 0xe9, 0x96, 0x75, 0x6e, 0xd6, 0x18, 0x99, 0x29, 0x9f, 0x6a,
+	0x59, 0xe7, 0x9c, 0x53, 0xff, 0x0b, 0x05, 0x96, 0xba, 0xa4, 0x59, 0x7f, 0xde, 0x81, 0x1c, 0x3f,
+	0x75, 0x24, 0x15, 0xf8, 0xc4, 0x79, 0x31, 0xed, 0xc4, 0x61, 0x3a, 0x8c, 0x6c, 0x3b, 0xaa, 0x53,
+	0xdd, 0x85, 0x49, 0x7c, 0x8c, 0xda, 0x0e, 0xf2, 0xb9, 0x67, 0x71, 0x2d, 0xcd, 0xb4, 0x73, 0x25,
+	0x9c, 0xdf, 0x08, 0x45, 0xf5, 0x8f, 0x15, 0xc8, 0xc5, 0xcb, 0xf1, 0xfe, 0x69, 0x21, 0xef, 0xb4,
+	0x89, 0xcc, 0xc0, 0x43, 0xc8, 0x94, 0x27, 0x21, 0x4b, 0x0b, 0x2a, 0x1e, 0x42, 0x74, 0xfd, 0xdd,
+	0x80, 0x39, 0x14, 0x34, 0xee, 0xb0, 0x53, 0x39, 0x72, 0xe2, 0x64, 0x71, 0x01, 0x39, 0x93, 0xd9,
+	0xb1, 0xf3, 0x19, 0xc8, 0x4a, 0xbc, 0xe4, 0xc4, 0xa3, 0x46, 0x6f, 0x46, 0x70, 0x92, 0x33, 0xef,
+	0x3f, 0x33, 0x89, 0x73, 0x2c, 0x06, 0xb2, 0x0e, 0x60, 0x09, 0x2a, 0x1b, 0xc2, 0x87, 0x69, 0xbd,
+	0xef, 0xa1, 0x28, 0xb1, 0x4c, 0x52, 0xad, 0xfd, 0x9b, 0x02, 0xf3, 0x09, 0x3c, 0xea, 0x65, 0x98,
+	0xac, 0x72, 0x32, 0xa9, 0x7f, 0xd4, 0x08, 0x09, 0xa1, 0x5f, 0x92, 0x49, 0xf2, 0x4b, 0x46, 0xa4,
+	0x5d, 0xfe, 0x22, 0x4c, 0xd9, 0xbe, 0xd9, 0x66, 0x07, 0x02, 0x39, 0x5a, 0x27, 0x0c, 0xb0, 0x7d,
+	0x7e, 0x44, 0xc4, 0xf6, 0xce, 0x58, 0xdc, 0xbb, 0x7b, 0x4b, 0x78, 0x77, 0xf8, 0xc8, 0x9c, 0xdd,
+	0xbc, 0x3a, 0xa8, 0x77, 0xc7, 0xbd, 0xba, 0xbf, 0xcd, 0xc0, 0x52, 0x8a, 0xe7, 0x27, 0x29, 0x57,
+	0x9e, 0x4b, 0xb9, 0xfa, 0x3a, 0xac, 0x90, 0xe9, 0x66, 0x8b, 0x3d, 0x69, 0x89, 0xe0, 0x90, 0xed,
+	0x0e, 0x5b, 0x7f, 0xf2, 0x4a, 0xb9, 0x07, 0x79, 0x2e, 0x25, 0x7c, 0x04, 0x53, 0x1a, 0xbe, 0x05,
+	0x56, 0x2a, 0x3c, 0x04, 0x6c, 0xf5, 0xc9, 0x69, 0x25, 0x9c, 0x67, 0xe6, 0x55, 0x8d, 0xd2, 0xa5,
+	0x18, 0xd2, 0xa9, 0x5b, 0xf5, 0x16, 0x5c, 0x26, 0x0a, 0x30, 0xa3, 0xed, 0x98, 0x92, 0xd8, 0x87,
+	0x1d, 0xd4, 0x41, 0x64, 0xa8, 0x47, 0x8d, 0x15, 0xce, 0xb3, 0xe7, 0x84, 0x5e, 0xf9, 0xe7, 0x31,
+	0x83, 0xfe, 0x79, 0xc8, 0x95, 0x70, 0xdb, 0x65, 0x57, 0xf2, 0x4d, 0x98, 0xa4, 0x1d, 0xb6, 0x02,
+	0x8b, 0x0c, 0xda, 0xd4, 0xe6, 0x5a, 0xda, 0xce, 0x16, 0xc2, 0x13, 0x88, 0xfd, 0xa5, 0x7f, 0x57,
+	0x81, 0x1c, 0xdd, 0x04, 0x1e, 0x12, 0xc6, 0xfe, 0x2e, 0x2c, 0xb2, 0x30, 0x11, 0x99, 0x27, 0xb6,
+	0x63, 0x35, 0xed, 0x8f, 0x48, 0x2b, 0x98, 0x2b, 0xb1, 0xc0, 0x0b, 0x77, 0xa5, 0x32, 0xb5, 0x22,
+	0x5b, 0x0f, 0xcf, 0x72, 0xea, 0x88, 0xb9, 0xff, 0x37, 0xfb, 0xce, 0x21, 0x3d, 0x82, 0xb1, 0x88,
+	0x64, 0x6a, 0xc8, 0x6f, 0xbd, 0x0c, 0xf3, 0x09, 0x6c, 0xc4, 0x52, 0xe2, 0x93, 0x35, 0x72, 0x4e,
+	0x00, 0x21, 0xd1, 0x23, 0x62, 0x15, 0x26, 0x91, 0x53, 0x8b, 0x58, 0xb1, 0x09, 0xe4, 0xd4, 0x48,
+	0xa1, 0xfe, 0xaf, 0x23, 0x30, 0x27, 0x75, 0x9a, 0x8d, 0xe4, 0x2e, 0x8c, 0x06, 0x1e, 0xdb, 0x5b,
+	0x53, 0x9b, 0x9b, 0x69, 0xad, 0xee, 0x12, 0x2c, 0xe0, 0x1f, 0x07, 0x6e, 0x0d, 0x19, 0x44, 0x5e,
+	0xfb, 0x7e, 0x06, 0x26, 0x38, 0x49, 0x7d, 0x1d, 0xc6, 0xc8, 0x12, 0x64, 0x53, 0x93, 0xea, 0xe6,
+	0x6d, 0x49, 0xee, 0x3e, 0x95, 0xc0, 0xfb, 0x30, 0xf4, 0x28, 0x78, 0x90, 0x2d, 0x5c, 0x09, 0xf5,
+	0x36, 0xa8, 0x6d, 0xcb, 0x0b, 0xec, 0xaa, 0xdd, 0x26, 0x11, 0xe2, 0x99, 0x1b, 0x20, 0x1e, 0xf9,
+	0xce, 0xc9, 0x25, 0x4f, 0x70, 0x01, 0x1e, 0x31, 0x16, 0x58, 0x13, 0x3e, 0xba, 0x44, 0x81, 0xc6,
+	0xd4, 0x84, 0xa1, 0x05, 0xf3, 0xf2, 0x5c, 0x9b, 0x6c, 0x1f, 0x8e, 0x91, 0x7d, 0xf8, 0xb9, 0xc1,
+	0x47, 0x43, 0x5e, 0x14, 0x6c, 0x73, 0xaa, 0x27, 0x5d, 0x34, 0xfd, 0x09, 0xa8, 0xdd, 0x9c, 0x6a,
+	0x16, 0xa6, 0x1e, 0x1f, 0x14, 0x0f, 0x0e, 0x0e, 0x2b, 0xc5, 0x4a, 0x69, 0x27, 0xf7, 0x82, 0x3a,
+	0x07, 0x33, 0x07, 0x87, 0x15, 0xf3, 0x9d, 0xc7, 0xe5, 0xca, 0xde, 0xee, 0x5e, 0x69, 0x27, 0xa7,
+	0xa8, 0x33, 0x30, 0x19, 0xfe, 0xcc, 0xe0, 0x9f, 0xbb, 0x7b, 0x07, 0xc5, 0xfd, 0xbd, 0xf7, 0x4b,
+	0x3b, 0xb9, 0x11, 0x7d, 0x1f, 0x16, 0x70, 0x73, 0x84, 0x5b, 0xce, 0xd7, 0xf4, 0x2a, 0x4c, 0x12,
+	0xdf, 0xea, 0xc4, 0x73, 0x5b, 0x6c, 0xbd, 0x4c, 0x60, 0xc2, 0xae, 0xe7, 0xb6, 0xd4, 0x25, 0xb8,
+	0x44, 0x0a, 0x03, 0x97, 0xad, 0x95, 0x71, 0xfc, 0xb3, 0xe2, 0xea, 0x1f, 0x67, 0x60, 0x65, 0x07,
+	0x05, 0xa8, 0x1a, 0xa0, 0x5a, 0xb9, 0x69, 0xf9, 0x0d, 0xdb, 0xa9, 0x87, 0xa7, 0xd5, 0x57, 0xb0,
+	0x4e, 0x46, 0x64, 0xcb, 0x66, 0x2b, 0xdd, 0x20, 0xa6, 0x68, 0xe9, 0x2a, 0x31, 0x42, 0xa5, 0x1a,
+	0x35, 0x95, 0xd1, 0xf2, 0x24, 0x3f, 0x4d, 0x49, 0xf4, 0xd3, 0x8a, 0x70, 0xc9, 0x3d, 0x39, 0x41,
+	0x8e, 0x4f, 0xb7, 0x62, 0x8f, 0xe3, 0x94, 0xeb, 0x3e, 0xa4, 0xec, 0x06, 0x97, 0x4b, 0xb2, 0x20,
+	0xfa, 0x63, 0xc8, 0xd3, 0xe5, 0x2a, 0xcc, 0x54, 0xaf, 0x5c, 0xd1, 0x55, 0xc8, 0x0a, 0x33, 0x15,
+	0xf5, 0x2a, 0x05, 0x99, 0xee, 0xca, 0xf7, 0x60, 0xa9, 0x4b, 0x2d, 0x1b, 0xe8, 0xe7, 0xb0, 0x7d,
+	0xfa, 0x5d, 0x50, 0xe9, 0x22, 0x08, 0x3c, 0x64, 0xb5, 0x24, 0xc7, 0x90, 0x1e, 0x1c, 0x52, 0x3b,
+	0x27, 0x09, 0x85, 0xc4, 0x70, 0xdb, 0x90, 0x0f, 0x43, 0x84, 0x88, 0xe0, 0x75, 0xc8, 0xb5, 0x6c,
+	0xc7, 0x14, 0x1b, 0xcb, 0x11, 0xbe, 0x58, 0xb6, 0x65, 0x3b, 0x47, 0x12, 0x59, 0x7f, 0x0b, 0x2e,
+	0x3f, 0xb5, 0x83, 0x46, 0xcd, 0xb3, 0x9e, 0x59, 0xcd, 0x6d, 0x0f, 0xd5, 0x90, 0x13, 0xd8, 0x56,
+	0x73, 0xf0, 0xdc, 0xc5, 0x6f, 0x66, 0xe0, 0x4a, 0x8a, 0x06, 0x36, 0x20, 0x55, 0x98, 0xaa, 0x86,
+	0x64, 0xb6, 0xf6, 0x8a, 0x69, 0xb3, 0xdb, 0x53, 0x57, 0x41, 0xa6, 0xc9, 0x5a, 0xb5, 0x5f, 0x55,
+	0x60, 0x4a, 0x2a, 0xec, 0x97, 0xf6, 0xd9, 0x82, 0x2b, 0xcf, 0x44, 0x45, 0xa6, 0xa4, 0x28, 0x9a,
+	0x9e, 0x58, 0x7d, 0x96, 0xd4, 0x1a, 0x96, 0x3a, 0x58, 0x80, 0xb1, 0x13, 0x9c, 0xb8, 0x20, 0xeb,
+	0x6d, 0xc2, 0xa0, 0x3f, 0xf4, 0x43, 0xc9, 0x5d, 0xdf, 0xe9, 0x04, 0x36, 0xf2, 0xa5, 0x74, 0x0c,
+	0x35, 0xb9, 0xcc, 0x5d, 0x27, 0x3f, 0xfa, 0xbb, 0xdb, 0x7f, 0x23, 0xbb, 0x20, 0x5c, 0x23, 0x1b,
+	0xda, 0x7d, 0x18, 0xaf, 0x11, 0x0a, 0x1b, 0xd5, 0x7b, 0x7d, 0xcd, 0x57, 0x54, 0x41, 0x61, 0xa7,
+	0x13, 0x5c, 0x18, 0x4c, 0x87, 0xf6, 0x8f, 0x0a, 0x8c, 0x62, 0x42, 0xbf, 0xc1, 0x8b, 0x05, 0x3d,
+	0x52, 0xa6, 0x41, 0x0e, 0x7a, 0xca, 0x29, 0x1b, 0x6a, 0x24, 0x69, 0x43, 0x85, 0xfb, 0x62, 0x54,
+	0xf6, 0x09, 0x5f, 0x81, 0x59, 0x91, 0xd6, 0xc0, 0xd5, 0xf8, 0x2c, 0x4c, 0x9e, 0xe1, 0x54, 0x5c,
+	0x89, 0x1f, 0xce, 0xc4, 0xb8, 0x3c, 0x13, 0x7f, 0xac, 0x80, 0x5a, 0xbe, 0x70, 0xaa, 0x31, 0xb7,
+	0x0d, 0x67, 0x1b, 0x2e, 0x9c, 0xaa, 0xed, 0xd4, 0x45, 0xb6, 0x81, 0xfe, 0x8c, 0x66, 0x6f, 0x32,
+	0xd1, 0xec, 0x0d, 0x8e, 0x6d, 0x1a, 0x76, 0xbd, 0x81, 0xfc, 0x40, 0xf6, 0xb3, 0xa6, 0x18, 0x8d,
+	0xb0, 0xdc, 0x02, 0x55, 0x66, 0x31, 0x4f, 0x1d, 0xf7, 0x99, 0xc3, 0x9c, 0xd6, 0x9c, 0xc4, 0xf8,
+	0x2e, 0xa6, 0xeb, 0xf7, 0xe0, 0x32, 0x71, 0xb5, 0xa4, 0x04, 0x09, 0x6e, 0x69, 0xef, 0xe5, 0xa2,
+	0xff, 0x8b, 0x02, 0x57, 0x52, 0xc4, 0xc2, 0x84, 0x21, 0x35, 0xc5, 0x55, 0xb7, 0xe3, 0x88, 0x00,
+	0x8f, 0x90, 0xb6, 0x31, 0x45, 0xbd, 0x09, 0x73, 0xf2, 0xf4, 0x51, 0x36, 0xda, 0x5d, 0x79, 0x5e,
+	0x29, 0xf3, 0x6b, 0xb0, 0x2c, 0x12, 0xd0, 0xec, 0xb0, 0x61, 0xc9, 0x0e, 0x6a, 0xbf, 0x33, 0x46,
+	0x9e, 0x27, 0x9e, 0xc3, 0xe2, 0x2d, 0x1c, 0x81, 0x15, 0x60, 0xbe, 0x66, 0xfb, 0x81, 0xed, 0x54,
+	0x03, 0xe2, 0xf0, 0x11, 0xd7, 0x80, 0x1b, 0xf3, 0x39, 0x5e, 0x44, 0x5c, 0x3c, 0x5c, 0xa0, 0x23,
+	0x58, 0xe4, 0x3e, 0x1f, 0x31, 0xf2, 0xd2, 0x22, 0xcf, 0x0a, 0xaf, 0x91, 0x79, 0x04, 0x74, 0xb5,
+	0x7f, 0xba, 0x9f, 0xef, 0x88, 0xf5, 0xd0, 0xd8, 0x49, 0x68, 0xd5, 0xaf, 0xc3, 0x3c, 0x39, 0x6a,
+	0xfd, 0xad, 0x0b, 0xd9, 0xe4, 0x26, 0x58, 0x03, 0xfd, 0xbf, 0x15, 0x58, 0x88, 0xf2, 0xb2, 0x16,
+	0x1d, 0xc0, 0x38, 0x19, 0x4f, 0xde, 0x90, 0xfb, 0x3d, 0x3d, 0x8e, 0x98, 0x74, 0x01, 0xff, 0x20,
+	0x05, 0x06, 0xd3, 0xa2, 0xfd, 0x92, 0x02, 0x93, 0x82, 0xfa, 0x13, 0x74, 0xc3, 0xb0, 0x69, 0xb2,
+	0x1c, 0xd7, 0xb1, 0xab, 0x2c, 0xa5, 0x35, 0x61, 0x84, 0x04, 0xfd, 0x1e, 0x4c, 0xe0, 0x46, 0x54,
+	0xec, 0xea, 0x69, 0xa2, 0x71, 0x14, 0x0b, 0x32, 0x23, 0x2f, 0x48, 0x6e, 0xba, 0xb6, 0x2e, 0x0c,
+	0x37, 0x1c, 0xce, 0x68, 0x43, 0x94, 0x58, 0x43, 0xf4, 0x1f, 0x29, 0x70, 0x99, 0x48, 0x1d, 0xb6,
+	0x91, 0x17, 0xae, 0xb6, 0x70, 0xce, 0x35, 0x98, 0x88, 0x65, 0x11, 0xc4, 0x6f, 0x55, 0x87, 0xe9,
+	0x48, 0x52, 0x92, 0x36, 0x27, 0x42, 0x23, 0x0e, 0x27, 0x8b, 0x11, 0xcd, 0xd0, 0xed, 0x19, 0x91,
+	0xd3, 0xa1, 0xc8, 0x13, 0xee, 0x0d, 0x66, 0xa7, 0xe2, 0x11, 0x76, 0xb6, 0x54, 0x79, 0x49, 0xc8,
+	0x8e, 0x9d, 0x1a, 0xb7, 0xd9, 0x71, 0x02, 0x9c, 0xd4, 0x46, 0xe7, 0x76, 0xe0, 0xb3, 0x78, 0x68,
+	0x56, 0x90, 0x71, 0x3e, 0xdf, 0xd7, 0xff, 0x20, 0x03, 0x2b, 0xa4, 0x9f, 0x89, 0x59, 0x56, 0x3b,
+	0xd6, 0x11, 0xba, 0x98, 0x4a, 0x3d, 0x17, 0x53, 0x92, 0xa2, 0x02, 0x89, 0xf2, 0x6a, 0xa8, 0x26,
+	0x15, 0x46, 0xc7, 0x43, 0xfb, 0xb6, 0x02, 0xf3, 0x09, 0x5c, 0x6a, 0x09, 0xa6, 0x24, 0xbe, 0x7e,
+	0x2b, 0x4e, 0xd6, 0x2f, 0xcb, 0xa9, 0x9b, 0xb0, 0x18, 0x3b, 0x1d, 0x22, 0xc7, 0xca, 0xbc, 0x15,
+	0x39, 0x1b, 0xc8, 0x5c, 0xeb, 0xff, 0xa4, 0x40, 0x3e, 0x4c, 0xf5, 0x3d, 0xb3, 0xbc, 0x9a, 0x18,
+	0x18, 0x71, 0xec, 0xa3, 0xa8, 0xcf, 0x38, 0xd3, 0x96, 0x13, 0x8a, 0xea, 0xdb, 0x70, 0x59, 0x3e,
+	0xc8, 0xc2, 0x40, 0xd8, 0x23, 0xea, 0x58, 0xe5, 0x9a, 0xc4, 0x23, 0xc2, 0x61, 0x5a, 0x21, 0x9e,
+	0x48, 0x3e, 0xdd, 0x5c, 0x88, 0x99, 0x27, 0x4e, 0x66, 0x8c, 0x2f, 0xc1, 0x34, 0x8d, 0x48, 0x18,
+	0x17, 0x5d, 0x1a, 0x34, 0x4a, 0xa1, 0x2c, 0xfa, 0x2d, 0x58, 0xa0, 0x77, 0x6f, 0xec, 0xca, 0xad,
+	0xf7, 0x39, 0xfe, 0x0d, 0x58, 0x8c, 0x71, 0xb3, 0xbe, 0x6f, 0xc0, 0x42, 0xe4, 0xa6, 0x30, 0x7a,
+	0xf7, 0xa8, 0x4a, 0xd7, 0x84, 0x4c, 0x12, 0xe7, 0x02, 0xba, 0xee, 0x06, 0xe5, 0xd1, 0x5f, 0xb0,
+	0xa2, 0x57, 0x82, 0x74, 0xf8, 0x4f, 0x61, 0x29, 0x7e, 0xdb, 0xd8, 0xdb, 0x51, 0x59, 0x85, 0xc9,
+	0x36, 0x36, 0x03, 0xbe, 0xfd, 0x11, 0x75, 0xd1, 0xc7, 0x8c, 0x09, 0x4c, 0x28, 0xdb, 0x1f, 0x91,
+	0xc4, 0x29, 0x29, 0x0c, 0xdc, 0x53, 0xe4, 0x90, 0x31, 0x9c, 0x34, 0x08, 0x7b, 0x05, 0x13, 0xf4,
+	0xdf, 0x52, 0x60, 0xb9, 0xbb, 0x36, 0xd6, 0xe3, 0x9b, 0x30, 0x17, 0x09, 0x11, 0xec, 0x2a, 0x3b,
+	0xe1, 0x47, 0x8d, 0x9c, 0x1c, 0x24, 0x60, 0x3a, 0x4e, 0x91, 0x39, 0xe8, 0x3c, 0x30, 0xa5, 0xda,
+	0x32, 0xa4, 0xb6, 0x19, 0x4c, 0x3e, 0xe2, 0x35, 0xe2, 0x06, 0xd1, 0x61, 0x24, 0xcd, 0xa5, 0x93,
+	0x3a, 0x49, 0x28, 0xb8, 0xbd, 0xba, 0x0d, 0x8b, 0xc4, 0x8a, 0x96, 0x1b, 0x9d, 0x93, 0x93, 0x26,
+	0x99, 0xe7, 0x9f, 0x54, 0xdf, 0x7f, 0x43, 0x81, 0x7c, 0xbc, 0xae, 0x9f, 0x61, 0xcf, 0x2b, 0x90,
+	0x7f, 0xcf, 0xf6, 0x7d, 0x7e, 0x0c, 0xa0, 0x70, 0xda, 0x23, 0x9d, 0x54, 0x7a, 0x76, 0x32, 0x13,
+	0xef, 0xe4, 0xf7, 0x15, 0x58, 0xea, 0x52, 0xfb, 0xb3, 0xeb, 0x65, 0x38, 0x8d, 0xa3, 0xf2, 0xa6,
+	0x7b, 0x17, 0xe6, 0xcb, 0xa7, 0x76, 0xbb, 0x8d, 0x88, 0x4b, 0xe7, 0x7f, 0xb2, 0x70, 0xfb, 0x16,
+	0x2c, 0x44, 0x95, 0x85, 0x59, 0x79, 0xea, 0xaa, 0xd2, 0x2e, 0xd2, 0x1f, 0x7a, 0x19, 0x56, 0x1f,
+	0xd9, 0x7e, 0xe0, 0x7a, 0xd8, 0xd4, 0x8a, 0x4b, 0xc8, 0x4f, 0xd8, 0x84, 0x7f, 0x50, 0xe0, 0x72,
+	0xb2, 0xd6, 0x30, 0xf4, 0x0a, 0xcd, 0x70, 0xdf, 0xb0, 0xbf, 0x97, 0x2a, 0xc9, 0x6b, 0xc1, 0x66,
+	0x1e, 0x84, 0x2d, 0xf7, 0xb5, 0x2d, 0x98, 0x89, 0x14, 0xa6, 0xa1, 0x30, 0x7a, 0x78, 0x26, 0xd8,
+	0x2b, 0xc3, 0x3a, 0xb6, 0x5d, 0xea, 0x4b, 0xf6, 0xf2, 0xca, 0xbe, 0x9d, 0x81, 0x85, 0x28, 0x2f,
+	0xeb, 0xec, 0x07, 0x00, 0x22, 0xa8, 0xe0, 0x7d, 0xfd, 0xb9, 0xf4, 0x24, 0x42, 0xb7, 0x86, 0x30,
+	0xdd, 0x2d, 0x4a, 0x24, 0x8d, 0xda, 0xef, 0x29, 0x30, 0xd7, 0xc5, 0x91, 0x72, 0xc9, 0xfe, 0x0a,
+	0x84, 0x01, 0x4e, 0x78, 0x6a, 0x8c, 0x1a, 0x33, 0x82, 0x4a, 0x96, 0xe9, 0x75, 0xc8, 0xd9, 0xcc,
+	0x2a, 0x9b, 0x2d, 0x84, 0x33, 0xbb, 0xdc, 0x49, 0xc9, 0x72, 0xfa, 0x7b, 0x94, 0x8c, 0x3d, 0xa2,
+	0x2a, 0xab, 0x93, 0x21, 0x3e, 0xc4, 0x6f, 0xfd, 0x3b, 0x0a, 0x2c, 0x63, 0x9f, 0xf7, 0x89, 0x1b,
+	0xd8, 0x4e, 0xfd, 0x08, 0x79, 0xb6, 0x1b, 0x31, 0xa6, 0x55, 0x7a, 0xb1, 0x66, 0xb6, 0x49, 0x09,
+	0x37, 0xa6, 0x8c, 0x4a, 0xd9, 0xf1, 0xc6, 0xa3, 0xc5, 0x26, 0xce, 0x45, 0x4a, 0x21, 0xd0, 0x0c,
+	0x25, 0x97, 0x1c, 0x1a, 0x07, 0x45, 0xf9, 0xe4, 0x3b, 0x0a, 0xc1, 0x47, 0xee, 0x28, 0xfe, 0x37,
+	0x03, 0x1a, 0x6b, 0x13, 0xda, 0xb6, 0x9c, 0x1a, 0xde, 0xe6, 0x92, 0x53, 0xff, 0x25, 0x80, 0xaa,
+	0xa0, 0xb2, 0xc9, 0x4a, 0x4d, 0xdc, 0xa5, 0xeb, 0x29, 0x08, 0x92, 0x21, 0xe9, 0xc3, 0xf7, 0xb7,
+	0x67, 0x64, 0x2c, 0x78, 0x97, 0x99, 0x8f, 0x78, 0x26, 0x0d, 0x10, 0x5e, 0x92, 0x38, 0x4b, 0xd2,
+	0x40, 0x76, 0xbd, 0xc1, 0xe3, 0xb9, 0xc9, 0x96, 0xed, 0x3c, 0x22, 0x04, 0x52, 0x6c, 0x9d, 0xf3,
+	0xe2, 0x51, 0x56, 0x6c, 0x9d, 0xd3, 0x62, 0xed, 0x8f, 0x14, 0x98, 0x14, 0x95, 0x87, 0xcb, 0x5b,
+	0xba, 0x01, 0xa4, 0xcb, 0x9b, 0x5c, 0x38, 0xe7, 0x61, 0x9c, 0xe9, 0x61, 0x1b, 0xb8, 0x21, 0xea,
+	0xc0, 0x01, 0x0d, 0x33, 0xd7, 0xac, 0x09, 0x98, 0x22, 0x82, 0xaf, 0x13, 0xb7, 0xd9, 0x74, 0x9f,
+	0x99, 0x38, 0x5c, 0xc2, 0xc6, 0xde, 0xc4, 0xff, 0xe0, 0x5d, 0xca, 0xc2, 0xca, 0x3c, 0x2d, 0xdf,
+	0x61, 0xc5, 0x45, 0x56, 0xaa, 0x7f, 0x8f, 0xad, 0x88, 0x5d, 0x52, 0x1c, 0x8b, 0x80, 0x0b, 0x30,
+	0xcf, 0x30, 0x0f, 0x91, 0x1b, 0x07, 0xba, 0x2c, 0xe6, 0x68, 0x91, 0x7c, 0xd9, 0x70, 0x15, 0xb2,
+	0xb1, 0x66, 0xf0, 0xac, 0x58, 0xb4, 0x76, 0x7c, 0xd7, 0xe5, 0x5b, 0x27, 0x28, 0xaa, 0x96, 0xad,
+	0x67, 0x5c, 0x20, 0x29, 0xd5, 0xdf, 0x02, 0xed, 0x21, 0xbd, 0xc6, 0xe7, 0xd7, 0x6b, 0xf2, 0x45,
+	0xec, 0x4b, 0x30, 0xcd, 0xef, 0x37, 0xa4, 0x08, 0x62, 0xaa, 0x16, 0xb2, 0xea, 0x4f, 0x60, 0x99,
+	0x29, 0xe8, 0xf6, 0x60, 0x3e, 0x89, 0x29, 0xfb, 0x33, 0x05, 0x56, 0x12, 0x14, 0xb3, 0x86, 0x15,
+	0x01, 0x24, 0xec, 0x16, 0x5d, 0xb7, 0xa9, 0x48, 0x11, 0x21, 0x6f, 0x48, 0x42, 0x3f, 0x2e, 0x43,
+	0x7e, 0x57, 0x40, 0x38, 0xd8, 0x00, 0x92, 0x35, 0x23, 0x9b, 0x21, 0x39, 0x01, 0x40, 0x7f, 0x60,
+	0xdc, 0xc7, 0xe3, 0x76, 0xd5, 0x6d, 0x61, 0x60, 0x86, 0xb8, 0xb0, 0x79, 0x4e, 0x53, 0x9d, 0x74,
+	0x9b, 0x94, 0x49, 0xbc, 0x4d, 0xd2, 0xd7, 0x61, 0x65, 0xdf, 0xf2, 0x03, 0x96, 0x44, 0xa7, 0x16,
+	0xb3, 0xd7, 0xf5, 0xbe, 0xfe, 0x87, 0x0a, 0x2c, 0x53, 0xee, 0xe0, 0x82, 0x2f, 0xaf, 0x24, 0x0b,
+	0xab, 0x08, 0x0b, 0x8b, 0xf7, 0x18, 0x69, 0x03, 0x0f, 0x08, 0xd9, 0x2f, 0xb2, 0x7a, 0x79, 0xbd,
+	0x51, 0x24, 0x91, 0x20, 0xd3, 0x2b, 0xaf, 0xab, 0x78, 0x5e, 0xce, 0x90, 0x67, 0x0a, 0x3a, 0xdb,
+	0x64, 0xb3, 0x84, 0x2c, 0x1a, 0xaf, 0x7f, 0x67, 0x0c, 0x96, 0xf0, 0x96, 0x42, 0xe5, 0x6a, 0x03,
+	0xb5, 0xac, 0x3d, 0xe7, 0xc4, 0x95, 0x17, 0xee, 0x89, 0xeb, 0x9d, 0x9a, 0x67, 0xc8, 0x13, 0xb8,
+	0x9d, 0x51, 0x63, 0x0a, 0xd3, 0x9e, 0x50, 0x52, 0x12, 0x00, 0x0b, 0xef, 0xf4, 0x70, 0xe0, 0x3d,
+	0x54, 0xb7, 0xfd, 0xc0, 0xbb, 0x88, 0x1c, 0x0b, 0x79, 0x51, 0x6e, 0xb0, 0x62, 0x71, 0x46, 0x74,
+	0x41, 0x02, 0x7d, 0x26, 0x39, 0x1a, 0x93, 0x64, 0x11, 0x83, 0x4f, 0x25, 0x5f, 0x87, 0x15, 0x76,
+	0x0c, 0x30, 0xac, 0x4b, 0xcb, 0x3e, 0x17, 0xa2, 0x34, 0x9e, 0xcd, 0x53, 0x06, 0x83, 0x94, 0xbf,
+	0x67, 0x9f, 0x73, 0xd1, 0xfb, 0xb0, 0x14, 0x47, 0x4d, 0x71, 0x41, 0x8a, 0x7a, 0x5a, 0x8c, 0x21,
+	0xa3, 0x98, 0xdc, 0x67, 0x61, 0x39, 0x72, 0xf2, 0x10, 0xb7, 0x84, 0x09, 0x5e, 0x92, 0x05, 0x43,
+	0x07, 0x84, 0x0a, 0xde, 0x83, 0x7c, 0x43, 0x78, 0x27, 0x11, 0xb1, 0x09, 0x1a, 0xe3, 0x84, 0xa5,
+	0x92, 0x54, 0x11, 0xae, 0xb0, 0xea, 0x48, 0x38, 0x87, 0x01, 0x62, 0xd1, 0x01, 0x9a, 0xa4, 0x11,
+	0x22, 0x65, 0x2a, 0x53, 0x9e, 0xe8, 0x20, 0x3d, 0x10, 0x83, 0x24, 0xc7, 0xd3, 0x4c, 0x1c, 0x88,
+	0x38, 0x1b, 0x0a, 0x39, 0x32, 0x8f, 0xf7, 0x96, 0x04, 0xb1, 0x91, 0x66, 0x4f, 0xc9, 0xbd, 0xa5,
+	0x97, 0x85, 0x61, 0xbb, 0xef, 0xc0, 0x62, 0x2c, 0xe3, 0xc5, 0xa4, 0xa6, 0x89, 0x94, 0x1a, 0xc9,
+	0x68, 0xd1, 0x70, 0xae, 0x2c, 0x60, 0x3a, 0x0c, 0xe2, 0xc6, 0x4e, 0xc2, 0x81, 0xef, 0x5f, 0x92,
+	0x60, 0x81, 0xbf, 0xa6, 0xc0, 0x62, 0x4c, 0x2b, 0x5b, 0xe6, 0x3f, 0xb9, 0x1c, 0x55, 0x72, 0x56,
+	0xfd, 0x47, 0x0a, 0xa8, 0xe1, 0x62, 0x12, 0xcd, 0xf8, 0x22, 0x40, 0xb8, 0x00, 0xd9, 0x69, 0xfc,
+	0x7a, 0x2a, 0xd0, 0xa1, 0x4b, 0xbe, 0x50, 0xc6, 0xce, 0x9a, 0xa0, 0x1b, 0x92, 0x32, 0x2d, 0x80,
+	0xd9, 0x68, 0x69, 0x8a, 0xa7, 0x97, 0x04, 0x20, 0xcc, 0x3c, 0x2f, 0x80, 0x50, 0xff, 0x73, 0xdc,
+	0xcf, 0x46, 0xc7, 0x73, 0xf6, 0xed, 0x96, 0x1d, 0xc8, 0x16, 0x9b, 0xad, 0x5c, 0xb3, 0x8a, 0x4b,
+	0xcd, 0x26, 0x2e, 0xe6, 0x16, 0x9b, 0x15, 0x85, 0x72, 0xcf, 0x97, 0x12, 0x48, 0x4d, 0x3d, 0x8c,
+	0xa4, 0xa5, 0x1e, 0xf4, 0xff, 0x53, 0x60, 0x85, 0xae, 0x7b, 0xdb, 0xa9, 0x33, 0x62, 0x38, 0x3b,
+	0x0f, 0x60, 0x85, 0x7b, 0x9e, 0x16, 0x67, 0x8a, 0xe5, 0x33, 0x96, 0x18, 0x43, 0x5c, 0x89, 0xfa,
+	0x39, 0xd0, 0xda, 0x1e, 0x3a, 0xb3, 0xdd, 0x8e, 0x9f, 0x20, 0x4c, 0x7b, 0xb1, 0xcc, 0x39, 0xba,
+	0xa4, 0x37, 0x61, 0x91, 0xd7, 0x4c, 0x7b, 0x14, 0xed, 0xca, 0x3c, 0x2b, 0xac, 0xe0, 0x32, 0x29,
+	0x8d, 0x22, 0x6a, 0x8c, 0x0a, 0xd1, 0x63, 0x74, 0x81, 0x97, 0xca, 0x52, 0x78, 0x8b, 0xe4, 0x09,
+	0x81, 0x19, 0x61, 0x54, 0x93, 0x4d, 0x01, 0x1b, 0xce, 0x96, 0x64, 0x88, 0x69, 0xce, 0xa8, 0x48,
+	0x48, 0x24, 0xd1, 0xc5, 0x71, 0x96, 0x2d, 0x69, 0x7e, 0x66, 0x18, 0x95, 0xb1, 0xbd, 0x0c, 0x33,
+	0xdc, 0x1b, 0x92, 0x4d, 0x02, 0x77, 0x91, 0xe8, 0x09, 0xb0, 0x05, 0x0b, 0xac, 0x0d, 0xdc, 0xdd,
+	0xa3, 0x27, 0xc0, 0x10, 0x60, 0x25, 0xfd, 0xf7, 0x15, 0x58, 0x8c, 0x29, 0x09, 0x6f, 0x9a, 0x22,
+	0x60, 0x97, 0x7b, 0x7d, 0xc0, 0x54, 0x51, 0xf1, 0x42, 0x0c, 0x56, 0x73, 0x47, 0xc0, 0xb3, 0xa7,
+	0xe0, 0xd2, 0xe3, 0x83, 0x77, 0x0f, 0x0e, 0x9f, 0x1e, 0xe4, 0x5e, 0xc0, 0x3f, 0x8e, 0x4a, 0x07,
+	0x3b, 0x7b, 0x07, 0x0f, 0xe9, 0xd5, 0xf9, 0x91, 0x71, 0xb8, 0x5d, 0x2a, 0x97, 0xf1, 0xd5, 0xb9,
+	0xfe, 0x14, 0x96, 0xde, 0xe1, 0x20, 0x5e, 0x1a, 0xa8, 0x5e, 0xc8, 0x50, 0x44, 0x72, 0x4f, 0x2a,
+	0x67, 0x6e, 0xe8, 0xd5, 0x69, 0x89, 0xa7, 0x6f, 0x70, 0xb0, 0x22, 0xbb, 0x28, 0x18, 0x60, 0x41,
+	0x7d, 0x93, 0xff, 0x51, 0x60, 0xb9, 0x5b, 0x33, 0xeb, 0xf6, 0x31, 0x4c, 0x55, 0x1b, 0xa8, 0x7a,
+	0xda, 0x76, 0x6d, 0x47, 0x04, 0xd0, 0x6f, 0xa7, 0xf5, 0x3d, 0x4d, 0x4d, 0x81, 0xd4, 0xb4, 0x2d,
+	0x14, 0x19, 0xb2, 0x52, 0xed, 0x19, 0x64, 0x63, 0xe5, 0x29, 0x59, 0xa8, 0x04, 0x4c, 0x74, 0x26,
+	0x11, 0x13, 0xfd, 0x0a, 0x84, 0x14, 0x7a, 0xcc, 0x52, 0xec, 0xe3, 0x8c, 0xa0, 0x12, 0x0f, 0xfa,
+	0x4f, 0x47, 0x61, 0x69, 0xd7, 0xf5, 0x4e, 0xb7, 0x1b, 0xae, 0x5d, 0x45, 0xe5, 0xc0, 0xf5, 0x42,
+	0x1f, 0xab, 0x05, 0x0b, 0xa1, 0x8a, 0xb0, 0xb5, 0xec, 0xbc, 0x4f, 0x05, 0xe9, 0xa7, 0xa8, 0x2b,
+	0x48, 0x7d, 0x9f, 0x17, 0x7a, 0xa5, 0x0e, 0xb7, 0x60, 0x21, 0x74, 0xd2, 0xa4, 0xea, 0x32, 0x9f,
+	0xbc, 0x3a, 0xa1, 0x57, 0xaa, 0xae, 0x22, 0x2e, 0x70, 0x46, 0x7a, 0x47, 0x9e, 0x69, 0x15, 0x54,
+	0x3c, 0xab, 0x7a, 0xca, 0x8d, 0x22, 0xbf, 0xc6, 0x79, 0x0c, 0xd0, 0x77, 0x0e, 0x93, 0x9c, 0xbf,
+	0xa8, 0x45, 0x1c, 0x89, 0x59, 0x44, 0xed, 0x23, 0x98, 0x96, 0xab, 0xeb, 0x73, 0xb7, 0x22, 0xa1,
+	0x9f, 0x25, 0x03, 0xcb, 0xd0, 0xcf, 0x91, 0xf4, 0x8c, 0x0c, 0xb4, 0xcb, 0xc3, 0xf8, 0x33, 0x39,
+	0xd0, 0x65, 0xbf, 0xf4, 0xff, 0x50, 0xe0, 0x53, 0x92, 0x63, 0x53, 0xb1, 0xbc, 0x3a, 0x0a, 0xb6,
+	0xad, 0x6a, 0x23, 0x5c, 0x29, 0x5f, 0x86, 0x4b, 0x01, 0x21, 0xf3, 0xed, 0xb1, 0x9d, 0x36, 0x98,
+	0xbd, 0x15, 0x15, 0x28, 0xcd, 0x2f, 0x39, 0x81, 0x77, 0x61, 0x70, 0x9d, 0x1a, 0x82, 0x69, 0xb9,
+	0x40, 0xcd, 0xc1, 0x08, 0xbf, 0x94, 0x1e, 0x35, 0xf0, 0x9f, 0xea, 0x5b, 0x30, 0x76, 0x66, 0x35,
+	0x3b, 0x1c, 0xc2, 0x75, 0x7d, 0x80, 0xdb, 0x0b, 0xaa, 0xd1, 0xa0, 0x72, 0x0f, 0x32, 0xaf, 0x29,
+	0xfa, 0x37, 0xe5, 0x67, 0x40, 0xec, 0x70, 0xdf, 0x41, 0xcd, 0xc0, 0x1a, 0xda, 0x91, 0x8a, 0xa2,
+	0x36, 0x32, 0x31, 0xd4, 0x86, 0xba, 0x02, 0x13, 0x22, 0xc1, 0x42, 0x67, 0xe0, 0x12, 0xa2, 0xa9,
+	0x15, 0xfd, 0x6b, 0x70, 0x25, 0xa5, 0x09, 0x6c, 0xa8, 0x5f, 0x86, 0x19, 0xaa, 0x3a, 0x6a, 0x44,
+	0xa7, 0x09, 0x91, 0xdb, 0x31, 0x0c, 0xf0, 0x75, 0x6a, 0x31, 0x53, 0x09, 0xc8, 0xe1, 0x8e, 0x2d,
+	0x5e, 0x98, 0x35, 0xac, 0x96, 0x54, 0x3f, 0x62, 0xd0, 0x1f, 0xfa, 0xaf, 0xc8, 0x03, 0x90, 0xf4,
+	0x3e, 0x61, 0xe0, 0x01, 0x88, 0x1d, 0xc7, 0x99, 0xde, 0xc7, 0xf1, 0x48, 0xec, 0x38, 0x6e, 0xc0,
+	0x95, 0x94, 0x66, 0xb0, 0x41, 0x78, 0x98, 0x78, 0x6b, 0x36, 0xd0, 0x9d, 0x55, 0x44, 0x50, 0xff,
+	0x50, 0x02, 0xae, 0x1c, 0x37, 0x7f, 0x2a, 0xf7, 0x20, 0xbf, 0xa3, 0xc0, 0xa7, 0xd2, 0xea, 0xfc,
+	0x19, 0xde, 0x09, 0x3c, 0x82, 0x15, 0x81, 0x24, 0x12, 0x8f, 0xb3, 0xf8, 0x28, 0x0c, 0xd3, 0x20,
+	0xfd, 0x21, 0x68, 0x49, 0x9a, 0x24, 0xb4, 0x3c, 0x2f, 0x35, 0x19, 0x2a, 0x9f, 0xa3, 0xe5, 0x25,
+	0x29, 0x0c, 0xcf, 0x7f, 0x0a, 0xcb, 0xb1, 0x65, 0x80, 0x6a, 0x3f, 0x96, 0x98, 0xe6, 0xe7, 0x61,
+	0x25, 0x41, 0x71, 0x78, 0xed, 0x6c, 0x31, 0x1a, 0x03, 0x87, 0x88, 0xdf, 0xfd, 0xe2, 0x96, 0x57,
+	0x60, 0x36, 0x11, 0x89, 0x3b, 0x63, 0xcb, 0x10, 0x5c, 0x7d, 0x5d, 0xbc, 0x02, 0x60, 0x3d, 0xe5,
+	0x9d, 0x0a, 0xdf, 0x29, 0x28, 0x91, 0x77, 0x0a, 0x1b, 0x90, 0x8f, 0x0b, 0xb0, 0xc6, 0xa6, 0x49,
+	0x34, 0xa4, 0xa1, 0x0b, 0x7d, 0xf2, 0xe1, 0x27, 0xb3, 0x3f, 0x34, 0xe9, 0xfb, 0x19, 0x58, 0x49,
+	0xa8, 0x8a, 0xb5, 0xef, 0x31, 0x4c, 0xf0, 0x70, 0xbb, 0x5f, 0x68, 0x96, 0xaa, 0xa4, 0xc0, 0x08,
+	0x86, 0x50, 0xa5, 0xfd, 0xa5, 0x02, 0x97, 0x18, 0x75, 0xa8, 0x43, 0xb9, 0xc7, 0x23, 0xd0, 0xe4,
+	0xa0, 0x53, 0x7e, 0xf9, 0x39, 0x1a, 0x7d, 0xf9, 0x79, 0x13, 0xe6, 0xd0, 0xc9, 0x09, 0x8a, 0x86,
+	0x49, 0x34, 0x65, 0x92, 0x13, 0x05, 0x3c, 0x44, 0xf8, 0x96, 0x02, 0x7a, 0xd2, 0x0b, 0xd3, 0x72,
+	0xa7, 0xd5, 0xb2, 0x42, 0x2f, 0xf6, 0xa7, 0x74, 0xbe, 0xfe, 0x97, 0x02, 0x2f, 0xf7, 0x6c, 0x4d,
+	0x68, 0x6b, 0x88, 0x02, 0x9f, 0x45, 0x83, 0xdc, 0xd6, 0x50, 0x22, 0x0d, 0x03, 0x09, 0xf8, 0x5a,
+	0x4e, 0x8b, 0xf0, 0x4b, 0x0b, 0x11, 0x66, 0x4a, 0x85, 0x1c, 0x7e, 0x80, 0x35, 0xb7, 0xc8, 0x55,
+	0xa1, 0xc9, 0xb0, 0x6b, 0x2c, 0x9a, 0xa1, 0x44, 0x0a, 0x50, 0xc3, 0x50, 0x15, 0x8e, 0xf1, 0xe2,
+	0x40, 0x8c, 0x90, 0x80, 0x37, 0x5b, 0x18, 0x0f, 0x13, 0xf8, 0xf6, 0x18, 0xb1, 0x65, 0x33, 0x22,
+	0x14, 0xc6, 0x44, 0xfd, 0xcb, 0xb0, 0x1a, 0x7f, 0xd5, 0x28, 0x27, 0x99, 0x57, 0x61, 0x52, 0x00,
+	0x93, 0xd8, 0x1e, 0x9a, 0xa8, 0x31, 0x26, 0x1c, 0xbd, 0xe1, 0xe7, 0x0c, 0x04, 0x19, 0x10, 0x6e,
+	0xf8, 0x29, 0x46, 0x23, 0xfe, 0x73, 0x55, 0xbc, 0xa9, 0x45, 0xb2, 0x95, 0x61, 0xf3, 0xf9, 0xe3,
+	0x81, 0x56, 0xe8, 0xef, 0xc2, 0x6a, 0x62, 0x25, 0x61, 0x2e, 0x94, 0x2c, 0x0f, 0x76, 0x5c, 0xd1,
+	0x1f, 0xf8, 0x68, 0xf0, 0x90, 0xe5, 0xbb, 0xdc, 0x1c, 0xb0, 0x5f, 0x37, 0x5e, 0x83, 0x99, 0x30,
+	0x27, 0xed, 0x36, 0x51, 0x34, 0xfc, 0x9a, 0x86, 0x89, 0x62, 0xa5, 0x52, 0x2a, 0x57, 0x4a, 0x46,
+	0x4e, 0xc1, 0xbf, 0x8e, 0x8c, 0xc3, 0xa3, 0xc3, 0x72, 0xc9, 0xc8, 0x65, 0x6e, 0x7c, 0x4b, 0x81,
+	0x6c, 0xec, 0x1d, 0x83, 0xaa, 0xc2, 0x2c, 0x13, 0x36, 0xcb, 0x95, 0x62, 0xe5, 0x71, 0x39, 0xf7,
+	0x02, 0xa6, 0xb1, 0x10, 0xce, 0x2c, 0x6e, 0x57, 0xf6, 0x9e, 0x94, 0x72, 0x8a, 0x0a, 0x30, 0xce,
+	0xfe, 0xce, 0xe0, 0xf2, 0xbd, 0x83, 0xbd, 0xca, 0x1e, 0x86, 0x4c, 0x9b, 0xa5, 0x2f, 0xec, 0x55,
+	0x72, 0x23, 0x6a, 0x0e, 0xa6, 0x9f, 0xee, 0x55, 0x1e, 0xed, 0x18, 0xc5, 0xa7, 0xc5, 0xad, 0xfd,
+	0x52, 0x6e, 0x14, 0x4b, 0xe0, 0xb2, 0xd2, 0x4e, 0x6e, 0x0c, 0x4b, 0xd0, 0xbf, 0xcd, 0xf2, 0x7e,
+	0xb1, 0xfc, 0xa8, 0xb4, 0x93, 0x1b, 0xbf, 0x61, 0x42, 0x36, 0x86, 0x02, 0x56, 0xe7, 0x21, 0xcb,
+	0x1b, 0x73, 0xb8, 0xbb, 0x5b, 0x3a, 0x28, 0x97, 0x72, 0x2f, 0x60, 0xe2, 0xce, 0xe1, 0xe3, 0xad,
+	0xfd, 0x92, 0x49, 0xbb, 0x52, 0xdc, 0xcf, 0x29, 0x18, 0xb7, 0xcd, 0x88, 0x4f, 0x0e, 0x2b, 0xb8,
+	0x4d, 0x73, 0x30, 0x53, 0x7e, 0x6c, 0x18, 0x87, 0x8f, 0x0f, 0x76, 0x28, 0x69, 0x64, 0xf3, 0xaf,
+	0x5f, 0x81, 0x19, 0x9a, 0xbb, 0x2a, 0xd3, 0x37, 0xf4, 0xea, 0x17, 0x61, 0xee, 0xa9, 0x65, 0x07,
+	0xbb, 0xae, 0x17, 0xbe, 0x60, 0x54, 0xf3, 0x5d, 0x4f, 0xf0, 0x4a, 0xf8, 0xe9, 0xbc, 0x76, 0x23,
+	0x35, 0x07, 0xd5, 0xf5, 0xfa, 0x71, 0x43, 0x51, 0xf7, 0x61, 0x66, 0x9b, 0xa3, 0xb0, 0x1e, 0x21,
+	0xab, 0x96, 0xaa, 0x76, 0x90, 0x34, 0x9b, 0x6a, 0xc0, 0xdc, 0x7e, 0x3c, 0x21, 0x39, 0xbc, 0x46,
+	0x49, 0x78, 0x43, 0x51, 0x3d, 0xc8, 0xc6, 0x1e, 0x6d, 0xa9, 0x85, 0xb4, 0x2e, 0x26, 0xbf, 0x0d,
+	0xd3, 0xd6, 0x07, 0xe6, 0x17, 0x19, 0x87, 0x09, 0x8e, 0xe3, 0x4b, 0x6d, 0xfe, 0xb5, 0x5e, 0x37,
+	0x86, 0x91, 0xa7, 0x27, 0x6f, 0xc3, 0x04, 0x8e, 0xe5, 0x7a, 0x6a, 0xbb, 0x9c, 0x36, 0x18, 0x58,
+	0x52, 0xfd, 0x2b, 0x05, 0x26, 0xc5, 0x0b, 0x02, 0xf5, 0xda, 0x00, 0x8f, 0x0c, 0x68, 0xc7, 0xaf,
+	0x0f, 0xfc, 0x1c, 0x41, 0x3f, 0xfc, 0xb8, 0xb8, 0xa1, 0x16, 0x76, 0x51, 0x50, 0x6d, 0x20, 0x7f,
+	0x8d, 0xf8, 0x16, 0x6b, 0x81, 0x87, 0xd0, 0x9a, 0x6f, 0x3b, 0x55, 0xb4, 0xd6, 0xb4, 0xfc, 0x60,
+	0x4d, 0x84, 0xb3, 0xb4, 0xbc, 0xf0, 0x8b, 0xff, 0xfc, 0xc3, 0xdf, 0xce, 0xe4, 0xd5, 0x05, 0xfc,
+	0xd5, 0x05, 0xf6, 0x0d, 0x06, 0x52, 0x80, 0xe5, 0xd4, 0x53, 0xe9, 0xc1, 0x0c, 0x45, 0x21, 0xfa,
+	0xea, 0xad, 0xb4, 0xf6, 0x24, 0x3d, 0x45, 0x18, 0xa2, 0xf5, 0xea, 0x07, 0x30, 0xd7, 0xf5, 0x70,
+	0x20, 0x75, 0xac, 0xef, 0x0c, 0xfd, 0xf6, 0x00, 0x2f, 0xc2, 0x18, 0xe6, 0x3e, 0x7d, 0x11, 0x26,
+	0x63, 0xfe, 0xb5, 0xf5, 0x81, 0xf9, 0xc5, 0xab, 0x89, 0x29, 0x09, 0x98, 0xaf, 0xde, 0xe8, 0x39,
+	0x1a, 0x11, 0x10, 0xfe, 0x40, 0x9b, 0x75, 0x43, 0x51, 0x7d, 0xc9, 0x63, 0x8e, 0x60, 0x7a, 0x49,
+	0x85, 0xa9, 0x1d, 0x4c, 0x46, 0xfe, 0x0f, 0xba, 0x9f, 0x8f, 0x00, 0x42, 0x64, 0xf4, 0xf0, 0xa7,
+	0x58, 0x02, 0xaa, 0xfa, 0x97, 0x15, 0x86, 0xa8, 0x8a, 0xe3, 0x92, 0xd5, 0xd4, 0x4c, 0x61, 0x2f,
+	0xf4, 0xb3, 0xf6, 0xea, 0x90, 0x52, 0xe2, 0xe1, 0xfa, 0x4c, 0x04, 0x44, 0x9c, 0xda, 0xb7, 0xdb,
+	0xfd, 0x4e, 0x8e, 0x28, 0x06, 0xd9, 0x86, 0x69, 0x19, 0xcb, 0xab, 0xde, 0x1c, 0x0c, 0xf1, 0x4b,
+	0xfb, 0x72, 0x6b, 0x18, 0x78, 0xb0, 0xba, 0x0f, 0xb3, 0x1c, 0x86, 0xcb, 0x16, 0x41, 0x5a, 0x1f,
+	0xd6, 0x7a, 0x81, 0x5b, 0xb0, 0xfc, 0x86, 0xa2, 0x9e, 0xc3, 0x42, 0x12, 0xd0, 0xb6, 0xcf, 0x4a,
+	0x8e, 0x80, 0x79, 0xb5, 0x7b, 0x3d, 0x79, 0xd3, 0x20, 0xbc, 0x1e, 0x7b, 0xb7, 0x26, 0x07, 0xf1,
+	0x43, 0x55, 0x7b, 0x67, 0x68, 0x20, 0xac, 0xda, 0x84, 0x99, 0x28, 0x36, 0x32, 0x75, 0xe8, 0x93,
+	0xa0, 0x9a, 0xda, 0xed, 0x01, 0xb9, 0xc3, 0x45, 0x21, 0x23, 0xc0, 0xd2, 0x17, 0x45, 0x02, 0xe8,
+	0x4c, 0xbb, 0x35, 0x18, 0x33, 0xab, 0xea, 0x9b, 0x0a, 0x2c, 0x24, 0xc1, 0xb3, 0xd4, 0xbb, 0xc3,
+	0x81, 0xb9, 0xfa, 0x4c, 0x68, 0x4f, 0x30, 0x59, 0x00, 0x4b, 0xb8, 0x51, 0x45, 0xf9, 0x89, 0x00,
+	0x03, 0x41, 0xdd, 0x1c, 0x0c, 0x66, 0xd5, 0xaf, 0xe7, 0x49, 0xa8, 0xae, 0xf7, 0x21, 0x1b, 0x4b,
+	0xc2, 0xa6, 0xee, 0x87, 0xf5, 0x21, 0xb3, 0xb8, 0x6a, 0x03, 0xf2, 0x5d, 0x49, 0x41, 0x92, 0x93,
+	0x4c, 0xad, 0xe2, 0xfe, 0xf3, 0xe5, 0x36, 0xd5, 0x2f, 0x41, 0x2e, 0x0e, 0xc7, 0x49, 0xad, 0x63,
+	0xa3, 0xd7, 0xd1, 0x94, 0x08, 0xe8, 0x69, 0xc2, 0x4c, 0xe4, 0xda, 0x25, 0x7d, 0xd9, 0x27, 0xdd,
+	0x10, 0x69, 0xb7, 0x07, 0xe4, 0x16, 0x36, 0x51, 0xed, 0x46, 0xee, 0xa4, 0xf6, 0x26, 0xf5, 0x6d,
+	0x6a, 0x0f, 0xf4, 0xcf, 0x39, 0xcc, 0x75, 0x21, 0x70, 0xd4, 0x8d, 0x3e, 0x8a, 0xba, 0xf2, 0x77,
+	0xda, 0x9d, 0x21, 0x24, 0x58, 0xcd, 0x1d, 0xc8, 0x75, 0x7d, 0x83, 0x69, 0xbd, 0xf7, 0xa9, 0xd0,
+	0x5d, 0xef, 0xc6, 0xe0, 0x02, 0x62, 0x48, 0x17, 0x0e, 0xd0, 0x79, 0x10, 0xc7, 0xf0, 0x3d, 0xdf,
+	0x12, 0x49, 0x44, 0x01, 0x7e, 0x05, 0xd4, 0x6e, 0x14, 0xdd, 0xf0, 0x93, 0xd6, 0x03, 0xd1, 0xf7,
+	0x0d, 0xd0, 0xde, 0xe9, 0xbe, 0xd9, 0x61, 0x37, 0x61, 0xe9, 0x83, 0x98, 0x72, 0xa9, 0xa7, 0x6d,
+	0x0c, 0x2e, 0x20, 0xee, 0xea, 0xe6, 0x13, 0x00, 0x51, 0xa9, 0x7d, 0xbc, 0x3b, 0x58, 0x40, 0x12,
+	0x45, 0x55, 0xb9, 0x30, 0x1b, 0xc5, 0x72, 0xab, 0xb7, 0x7b, 0x3a, 0x2a, 0x71, 0x7c, 0xb9, 0x56,
+	0x18, 0x94, 0x3d, 0x74, 0x7a, 0x63, 0xb8, 0xea, 0x74, 0x9f, 0x30, 0x19, 0xd7, 0xad, 0xad, 0x0f,
+	0xcc, 0x2f, 0x8e, 0x93, 0xd9, 0xe8, 0xc3, 0x8c, 0xa1, 0xcc, 0x76, 0x7a, 0x60, 0x98, 0xfc, 0xd8,
+	0xe3, 0x18, 0xe6, 0x13, 0x20, 0x69, 0xc3, 0x4f, 0x5b, 0x2f, 0x5c, 0xdb, 0x07, 0x30, 0xd7, 0x85,
+	0x3f, 0x1b, 0x3e, 0x34, 0x49, 0x87, 0xb0, 0x7d, 0x09, 0x72, 0x71, 0xb4, 0xda, 0xf0, 0x7b, 0x37,
+	0x15, 0xef, 0xf6, 0x3e, 0x64, 0x63, 0x70, 0xb3, 0xe1, 0x4d, 0x60, 0x1a, 0x5e, 0xad, 0x09, 0x33,
+	0x11, 0x84, 0x4f, 0xba, 0xe9, 0x48, 0x82, 0x17, 0x69, 0xb7, 0x07, 0xe4, 0x66, 0xb5, 0x1d, 0x01,
+	0x84, 0x28, 0x9c, 0xe7, 0xc8, 0x9e, 0x74, 0x23, 0x80, 0xb0, 0xc6, 0x10, 0xf7, 0x32, 0xbc, 0xc6,
+	0x6e, 0xac, 0xcd, 0x07, 0x30, 0xd7, 0x05, 0x69, 0x19, 0x7e, 0xad, 0xa4, 0xa3, 0x62, 0xbe, 0x00,
+	0xb3, 0x51, 0xc0, 0x48, 0xaa, 0xf2, 0xd4, 0x9d, 0x94, 0x0c, 0x38, 0xd9, 0xfc, 0xc1, 0x08, 0x64,
+	0xf9, 0x6e, 0x0e, 0xd3, 0x56, 0x40, 0x49, 0x24, 0xb1, 0x34, 0x48, 0x78, 0xa8, 0x7d, 0xa6, 0x77,
+	0x9f, 0x24, 0x2b, 0xbd, 0x18, 0xcb, 0xae, 0x16, 0xe9, 0x15, 0x67, 0x61, 0x00, 0x27, 0x49, 0xfa,
+	0x4e, 0x9d, 0xb6, 0x3e, 0x30, 0x3f, 0xab, 0xf9, 0xeb, 0xe2, 0x43, 0x1b, 0x72, 0xc8, 0xac, 0x6e,
+	0xf6, 0xb9, 0x5e, 0x48, 0xc8, 0xd2, 0x6a, 0x77, 0x87, 0x92, 0x61, 0xf5, 0xfb, 0x30, 0x8f, 0x61,
+	0xdd, 0xb1, 0xe6, 0xa9, 0x57, 0x07, 0x18, 0x5d, 0xcc, 0x98, 0x5e, 0x69, 0x8f, 0x6c, 0xf5, 0xe6,
+	0x77, 0x47, 0xc5, 0x87, 0xbc, 0xc4, 0xec, 0x86, 0xbb, 0x97, 0xdd, 0x0d, 0xf4, 0xdb, 0xbd, 0x91,
+	0x2f, 0x4f, 0x69, 0xb7, 0x07, 0xe4, 0x0e, 0x87, 0x3d, 0xe1, 0xa3, 0x71, 0xe9, 0xc3, 0x9e, 0xfe,
+	0xb1, 0x3b, 0xed, 0xee, 0x50, 0x32, 0xe2, 0x94, 0x9d, 0x66, 0x0d, 0xa3, 0x47, 0xd5, 0x20, 0x19,
+	0x16, 0xed, 0x6a, 0x9f, 0x3e, 0x4a, 0x76, 0x28, 0xb7, 0xed, 0xb6, 0xda, 0x9d, 0x00, 0x89, 0xef,
+	0x82, 0x0d, 0x56, 0xc3, 0xf5, 0x9e, 0x67, 0x6e, 0xc4, 0xb1, 0x7d, 0x1f, 0xb2, 0xb1, 0x8f, 0x9c,
+	0x0d, 0x7f, 0x92, 0xa7, 0x7c, 0x25, 0x6d, 0xf3, 0x17, 0x72, 0x90, 0x0b, 0x33, 0xf4, 0x6c, 0x81,
+	0x7c, 0x5d, 0x64, 0xad, 0x43, 0xb3, 0xd8, 0x77, 0x9f, 0x24, 0x7c, 0x21, 0x54, 0xbb, 0x3b, 0x94,
+	0x8c, 0x48, 0x6d, 0xbb, 0x30, 0x1b, 0xfd, 0x24, 0x4e, 0xba, 0xbf, 0x94, 0xf8, 0x71, 0x34, 0xad,
+	0x30, 0x28, 0xbb, 0xf0, 0x42, 0x13, 0x3f, 0x48, 0x75, 0x77, 0x88, 0xaf, 0x5f, 0xf5, 0x5f, 0xa4,
+	0xbd, 0xbe, 0xbd, 0xf5, 0x61, 0xf7, 0x3d, 0xc9, 0x90, 0x5d, 0x1e, 0xf6, 0x13, 0xa4, 0x24, 0x39,
+	0x90, 0x74, 0xa5, 0xa7, 0xf6, 0x9f, 0xb4, 0xee, 0x6f, 0xe8, 0x6a, 0xf7, 0x86, 0x13, 0x0a, 0x03,
+	0xa7, 0xf8, 0x27, 0x4c, 0xd3, 0x7d, 0xfe, 0x94, 0x0f, 0xa5, 0x6a, 0x1b, 0x83, 0x0b, 0x48, 0x69,
+	0xc7, 0xc4, 0x2f, 0x86, 0xa4, 0xa7, 0x1d, 0x7b, 0x7d, 0xee, 0x44, 0x7b, 0x75, 0x48, 0xa9, 0xd0,
+	0x4b, 0x8f, 0x7d, 0x61, 0x43, 0x2d, 0x0c, 0xfc, 0x29, 0x8e, 0x41, 0x67, 0x3d, 0xf6, 0xed, 0x0f,
+	0xdc, 0xf5, 0x44, 0xb8, 0x90, 0x7a, 0x6f, 0xd0, 0x6b, 0x76, 0x19, 0xe0, 0xa4, 0xbd, 0x3a, 0xa4,
+	0x54, 0x52, 0x33, 0x22, 0x76, 0xa1, 0x7f, 0x33, 0x92, 0x2c, 0xc3, 0xab, 0x43, 0x4a, 0xb1, 0x66,
+	0x60, 0x1c, 0x6e, 0x32, 0xb2, 0x46, 0xed, 0x3f, 0xa7, 0x49, 0xe8, 0x1f, 0xed, 0xfe, 0xb0, 0x62,
+	0xac, 0x25, 0x5f, 0x03, 0xb5, 0x1b, 0x02, 0xa3, 0xde, 0xe9, 0x9b, 0xc8, 0x8f, 0x03, 0x6f, 0xb4,
+	0xcd, 0x61, 0x44, 0xc2, 0xcc, 0x49, 0x17, 0xba, 0x25, 0x3d, 0x73, 0x92, 0x86, 0xb0, 0xd1, 0xee,
+	0x0c, 0x21, 0x11, 0x46, 0xc6, 0x51, 0x9c, 0x4a, 0xdf, 0x63, 0x2f, 0x0a, 0x80, 0xd1, 0x0a, 0x83,
+	0xb2, 0x27, 0x74, 0x55, 0xf8, 0xe9, 0x1b, 0x43, 0x20, 0x4c, 0x06, 0xed, 0x6a, 0x97, 0x07, 0xff,
+	0xbb, 0x0a, 0xac, 0xf6, 0x80, 0x50, 0xa8, 0x0f, 0x86, 0x39, 0x41, 0xa3, 0x28, 0x10, 0xed, 0x8d,
+	0xe7, 0x92, 0xa5, 0x0d, 0xdb, 0xfa, 0xfb, 0x91, 0x8f, 0x8b, 0x7f, 0x37, 0xa2, 0xfe, 0x40, 0x81,
+	0xb1, 0x23, 0xef, 0xc2, 0x6f, 0xa9, 0x9f, 0x7e, 0xa7, 0x7c, 0x78, 0xb0, 0x66, 0x1c, 0x6d, 0xaf,
+	0xf1, 0x4f, 0xc1, 0xaf, 0xb5, 0x3d, 0xf7, 0xcc, 0xae, 0xe1, 0xeb, 0xc3, 0x8b, 0x35, 0xc2, 0x54,
+	0xd0, 0xb7, 0x71, 0xbc, 0x7f, 0xe1, 0xb7, 0xac, 0xc0, 0xae, 0xae, 0xed, 0x5b, 0xc7, 0xbe, 0xba,
+	0xd2, 0x08, 0x82, 0xb6, 0xff, 0x60, 0x7d, 0xbd, 0xcd, 0xe9, 0x4d, 0xeb, 0xd8, 0x2f, 0x54, 0xdd,
+	0x96, 0x96, 0x0f, 0x90, 0xd5, 0x7a, 0xbb, 0x8b, 0x7e, 0xe3, 0x2b, 0xf0, 0xe2, 0xc3, 0x83, 0xc7,
+	0x6b, 0x38, 0xb5, 0xe6, 0x59, 0xcd, 0x35, 0xba, 0x34, 0xd7, 0xf6, 0xed, 0x2a, 0x72, 0x7c, 0xb4,
+	0x76, 0x76, 0xb7, 0xb0, 0xa1, 0xbe, 0xc9, 0xb5, 0xd6, 0xed, 0xa0, 0xd1, 0x39, 0xc6, 0x62, 0xd1,
+	0x0a, 0xe8, 0x2f, 0x7c, 0x7f, 0x79, 0xbc, 0xde, 0xb2, 0xfc, 0x00, 0x79, 0xeb, 0xfb, 0x7b, 0xdb,
+	0xf8, 0x2e, 0xbf, 0xd0, 0xaa, 0x6d, 0x8e, 0x6d, 0x14, 0x36, 0x0a, 0x1b, 0x5a, 0xd6, 0x6a, 0xdb,
+	0x85, 0xb6, 0x77, 0x41, 0x6a, 0x76, 0x50, 0x70, 0x2d, 0xb3, 0x99, 0xb3, 0xda, 0xed, 0xa6, 0x5d,
+	0x25, 0x47, 0xc2, 0xfa, 0x57, 0x7d, 0xd7, 0xd9, 0x5c, 0x91, 0x29, 0x75, 0xaf, 0x5d, 0xbd, 0xfd,
+	0x0c, 0x1d, 0xdf, 0x0e, 0xd0, 0x79, 0x90, 0x52, 0xd4, 0x43, 0x0a, 0x17, 0x3d, 0xe8, 0xaa, 0xe2,
+	0x41, 0x7a, 0x15, 0xde, 0x7d, 0xec, 0xa8, 0x5e, 0xf8, 0xad, 0xb5, 0x87, 0xa4, 0xa3, 0xea, 0x67,
+	0x06, 0xeb, 0xf8, 0xf1, 0x38, 0xf1, 0x01, 0xef, 0xfe, 0xff, 0x00, 0xbc, 0x54, 0x71, 0xed, 0xcd,
+	0x5f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconServiceClient is the client API for BeaconService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconServiceClient interface {
 	WaitForChainStart(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
+	// CanonicalHead can be called on demand to fetch the current, head block of a beacon node.
 	CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.BeaconBlock, error)
+	// LatestAttestation streams the latest aggregated attestation to connected validator clients.
 	LatestAttestation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_LatestAttestationClient, error)
-	PendingDeposits(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingDepositsResponse, error)
+	PendingDeposits(ctx context.Context, in *PendingDepositsRequest, opts ...grpc.CallOption) (*PendingDepositsResponse, error)
 	Eth1Data(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataResponse, error)
 	ForkData(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.Fork, error)
-	BlockTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	BlockTree(ctx context.Context, in *BlockTreeRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
 	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	// DetectedSlashings returns the slashable offenses observed by the node which are not yet included on chain.
+	DetectedSlashings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DetectedSlashingsResponse, error)
+	// BeaconCommittee returns the ordered validator indices of a committee at a slot within the epoch lookahead.
+	BeaconCommittee(ctx context.Context, in *BeaconCommitteeRequest, opts ...grpc.CallOption) (*BeaconCommitteeResponse, error)
+	// BlockStream streams every beacon block processed by the node as it is added to the chain.
+	BlockStream(ctx context.Context, in *BlockStreamRequest, opts ...grpc.CallOption) (BeaconService_BlockStreamClient, error)
+	// AggregateAttestationStream streams attestations aggregated by attestation data as new
+	// attestations arrive and merge into them.
+	AggregateAttestationStream(ctx context.Context, in *AggregateStreamRequest, opts ...grpc.CallOption) (BeaconService_AggregateAttestationStreamClient, error)
+	// SyncStatus reports whether the node is syncing along with its head slot and the highest slot known among peers.
+	SyncStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatusResponse, error)
+	// EpochAttestationStats returns aggregation statistics of the attestations included in the blocks of an epoch.
+	EpochAttestationStats(ctx context.Context, in *EpochAttestationStatsRequest, opts ...grpc.CallOption) (*EpochAttestationStatsResponse, error)
+	// Eth1DataVotes returns the eth1 data votes of the head state ordered by descending vote count.
+	Eth1DataVotes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error)
+	// BlocksBySlot returns every block saved at a slot, marking the one on the canonical chain if any.
+	BlocksBySlot(ctx context.Context, in *BlocksBySlotRequest, opts ...grpc.CallOption) (*BlocksBySlotResponse, error)
+	// SlotTickStream streams the slot and epoch at the start of every slot based on the genesis time.
+	SlotTickStream(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_SlotTickStreamClient, error)
+	// BlockOperationCounts returns the number of each kind of operation included in the body of a block.
+	BlockOperationCounts(ctx context.Context, in *BlockByRootRequest, opts ...grpc.CallOption) (*BlockOperationCountsResponse, error)
+	// BlockAttestations returns the attestations included in the body of a block.
+	BlockAttestations(ctx context.Context, in *BlockByRootRequest, opts ...grpc.CallOption) (*BlockAttestationsResponse, error)
+	// ActiveBalance returns the total effective balance of the validators active in an epoch.
+	ActiveBalance(ctx context.Context, in *ActiveBalanceRequest, opts ...grpc.CallOption) (*ActiveBalanceResponse, error)
+	// SkippedSlots returns the slots within a range which have no block on the canonical chain.
+	SkippedSlots(ctx context.Context, in *SkippedSlotsRequest, opts ...grpc.CallOption) (*SkippedSlotsResponse, error)
+	// HistoricalBlockRoots returns the block roots recorded in the head state for a range of slots, in slot order.
+	HistoricalBlockRoots(ctx context.Context, in *HistoricalBlockRootsRequest, opts ...grpc.CallOption) (*HistoricalBlockRootsResponse, error)
+	// SlotAttestationCoverage returns the fraction of each committee at a slot whose attestations were included on the canonical chain.
+	SlotAttestationCoverage(ctx context.Context, in *SlotCoverageRequest, opts ...grpc.CallOption) (*SlotCoverageResponse, error)
+	// ForkChoiceStore returns the justified and finalized checkpoints and the blocks tracked by fork choice along with their vote weights.
+	ForkChoiceStore(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ForkChoiceStoreResponse, error)
+	// AttestationTargetCache returns the latest attestation target of each validator as used by fork choice.
+	AttestationTargetCache(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AttestationTargetCacheResponse, error)
+	// Eth1FollowStatus returns the latest eth1 block number and the highest eth1 block whose deposits are considered safe for inclusion.
+	Eth1FollowStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1FollowStatusResponse, error)
+	// DepositStatus returns whether the deposit at a Merkle tree index was processed into the head state or is still pending.
+	DepositStatus(ctx context.Context, in *DepositStatusRequest, opts ...grpc.CallOption) (*DepositStatusResponse, error)
+	// GenesisDepositRoot returns the deposit root of the eth1 data the beacon chain started from.
+	GenesisDepositRoot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenesisDepositRootResponse, error)
+	// GenesisValidators returns a page of the validator registry of the genesis state.
+	GenesisValidators(ctx context.Context, in *GenesisValidatorsRequest, opts ...grpc.CallOption) (*GenesisValidatorsResponse, error)
+	// ActiveValidators returns a page of the indices of the validators active in an epoch.
+	ActiveValidators(ctx context.Context, in *ActiveValidatorsRequest, opts ...grpc.CallOption) (*ActiveValidatorsResponse, error)
+	// NextEth1VotingPeriod returns when the eth1 voting period of the head state ends and its votes are reset.
+	NextEth1VotingPeriod(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1VotingPeriodResponse, error)
+	// Eth1VoteCandidates returns the eth1 blocks the node considers valid to vote for in the current eth1 voting period.
+	Eth1VoteCandidates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1VoteCandidatesResponse, error)
+	// JustifiedCheckpointHistory returns the justified checkpoint recorded in the historical state of each epoch in a range.
+	JustifiedCheckpointHistory(ctx context.Context, in *JustifiedHistoryRequest, opts ...grpc.CallOption) (*JustifiedHistoryResponse, error)
+	// PendingDepositCount returns the number of pending deposits which have not yet been processed into the head state.
+	PendingDepositCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingDepositCountResponse, error)
+	// EpochShuffling returns a page of the shuffled validator indices assigned to the committees of an epoch within the seed lookahead.
+	EpochShuffling(ctx context.Context, in *EpochShufflingRequest, opts ...grpc.CallOption) (*EpochShufflingResponse, error)
+	// MissedAttesters returns a page of the validators assigned to a committee in the previous epoch whose attestation was not included in a canonical block.
+	MissedAttesters(ctx context.Context, in *MissedAttestersRequest, opts ...grpc.CallOption) (*MissedAttestersResponse, error)
+	// ProposerReward returns the rewards the proposer of a block earned for the attestations and slashings it included.
+	ProposerReward(ctx context.Context, in *BlockByRootRequest, opts ...grpc.CallOption) (*ProposerRewardResponse, error)
+	// UpcomingActivations returns the validators the registry update at the end of the current epoch of the head state activates.
+	UpcomingActivations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UpcomingActivationsResponse, error)
+	// LastFinalizedSlot returns the slot of the block at the last finalized checkpoint.
+	LastFinalizedSlot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LastFinalizedSlotResponse, error)
+	// FinalityDistance returns how many slots and epochs the head state is ahead of its finalized epoch.
+	FinalityDistance(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FinalityDistanceResponse, error)
+	// StateSchemaInfo returns the fork version and slot of the head state along with the length of each of its lists.
+	StateSchemaInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StateSchemaInfoResponse, error)
+	// ProposedBlock returns the canonical block at a slot if it was proposed by the requested validator.
+	ProposedBlock(ctx context.Context, in *ProposedBlockRequest, opts ...grpc.CallOption) (*ProposedBlockResponse, error)
+	// Crosslinks returns the latest crosslink of every shard recorded in the head state, ordered by shard.
+	Crosslinks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CrosslinksResponse, error)
+	// ChurnLimit returns the maximum balance activated or exited at a validator registry update of the head state.
+	ChurnLimit(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChurnLimitResponse, error)
+	// AttestingBalances returns the attesting balances of the current and previous epochs of the head state justification is decided on.
+	AttestingBalances(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AttestingBalancesResponse, error)
+	// TotalDeposited returns the total amount deposited in the deposit contract as observed by the node.
+	TotalDeposited(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TotalDepositedResponse, error)
+}
+
+type beaconServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
+	return &beaconServiceClient{cc}
+}
+
+func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconServiceWaitForChainStartClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconService_WaitForChainStartClient interface {
+	Recv() (*ChainStartResponse, error)
+	grpc.ClientStream
+}
+
+type beaconServiceWaitForChainStartClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
+	m := new(ChainStartResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.BeaconBlock, error) {
+	out := new(v1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) LatestAttestation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_LatestAttestationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconService/LatestAttestation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconServiceLatestAttestationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconService_LatestAttestationClient interface {
+	Recv() (*v1.Attestation, error)
+	grpc.ClientStream
+}
+
+type beaconServiceLatestAttestationClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceLatestAttestationClient) Recv() (*v1.Attestation, error) {
+	m := new(v1.Attestation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconServiceClient) PendingDeposits(ctx context.Context, in *PendingDepositsRequest, opts ...grpc.CallOption) (*PendingDepositsResponse, error) {
+	out := new(PendingDepositsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/PendingDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) Eth1Data(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataResponse, error) {
+	out := new(Eth1DataResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Eth1Data", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) ForkData(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.Fork, error) {
+	out := new(v1.Fork)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ForkData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) BlockTree(ctx context.Context, in *BlockTreeRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) DetectedSlashings(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*DetectedSlashingsResponse, error) {
+	out := new(DetectedSlashingsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/DetectedSlashings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) BeaconCommittee(ctx context.Context, in *BeaconCommitteeRequest, opts ...grpc.CallOption) (*BeaconCommitteeResponse, error) {
+	out := new(BeaconCommitteeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BeaconCommittee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) BlockStream(ctx context.Context, in *BlockStreamRequest, opts ...grpc.CallOption) (BeaconService_BlockStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[2], "/ethereum.beacon.rpc.v1.BeaconService/BlockStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconServiceBlockStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconService_BlockStreamClient interface {
+	Recv() (*v1.BeaconBlock, error)
+	grpc.ClientStream
+}
+
+type beaconServiceBlockStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceBlockStreamClient) Recv() (*v1.BeaconBlock, error) {
+	m := new(v1.BeaconBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconServiceClient) AggregateAttestationStream(ctx context.Context, in *AggregateStreamRequest, opts ...grpc.CallOption) (BeaconService_AggregateAttestationStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[3], "/ethereum.beacon.rpc.v1.BeaconService/AggregateAttestationStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconServiceAggregateAttestationStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconService_AggregateAttestationStreamClient interface {
+	Recv() (*v1.Attestation, error)
+	grpc.ClientStream
+}
+
+type beaconServiceAggregateAttestationStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceAggregateAttestationStreamClient) Recv() (*v1.Attestation, error) {
+	m := new(v1.Attestation)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconServiceClient) SyncStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*SyncStatusResponse, error) {
+	out := new(SyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/SyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) EpochAttestationStats(ctx context.Context, in *EpochAttestationStatsRequest, opts ...grpc.CallOption) (*EpochAttestationStatsResponse, error) {
+	out := new(EpochAttestationStatsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/EpochAttestationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) Eth1DataVotes(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataVotesResponse, error) {
+	out := new(Eth1DataVotesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Eth1DataVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) BlocksBySlot(ctx context.Context, in *BlocksBySlotRequest, opts ...grpc.CallOption) (*BlocksBySlotResponse, error) {
+	out := new(BlocksBySlotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlocksBySlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) SlotTickStream(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_SlotTickStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[4], "/ethereum.beacon.rpc.v1.BeaconService/SlotTickStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconServiceSlotTickStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconService_SlotTickStreamClient interface {
+	Recv() (*SlotTick, error)
+	grpc.ClientStream
+}
+
+type beaconServiceSlotTickStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceSlotTickStreamClient) Recv() (*SlotTick, error) {
+	m := new(SlotTick)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *beaconServiceClient) BlockOperationCounts(ctx context.Context, in *BlockByRootRequest, opts ...grpc.CallOption) (*BlockOperationCountsResponse, error) {
+	out := new(BlockOperationCountsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockOperationCounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) BlockAttestations(ctx context.Context, in *BlockByRootRequest, opts ...grpc.CallOption) (*BlockAttestationsResponse, error) {
+	out := new(BlockAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) ActiveBalance(ctx context.Context, in *ActiveBalanceRequest, opts ...grpc.CallOption) (*ActiveBalanceResponse, error) {
+	out := new(ActiveBalanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ActiveBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) SkippedSlots(ctx context.Context, in *SkippedSlotsRequest, opts ...grpc.CallOption) (*SkippedSlotsResponse, error) {
+	out := new(SkippedSlotsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/SkippedSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) HistoricalBlockRoots(ctx context.Context, in *HistoricalBlockRootsRequest, opts ...grpc.CallOption) (*HistoricalBlockRootsResponse, error) {
+	out := new(HistoricalBlockRootsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/HistoricalBlockRoots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) SlotAttestationCoverage(ctx context.Context, in *SlotCoverageRequest, opts ...grpc.CallOption) (*SlotCoverageResponse, error) {
+	out := new(SlotCoverageResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/SlotAttestationCoverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) ForkChoiceStore(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ForkChoiceStoreResponse, error) {
+	out := new(ForkChoiceStoreResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ForkChoiceStore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) AttestationTargetCache(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AttestationTargetCacheResponse, error) {
+	out := new(AttestationTargetCacheResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/AttestationTargetCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) Eth1FollowStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1FollowStatusResponse, error) {
+	out := new(Eth1FollowStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Eth1FollowStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) DepositStatus(ctx context.Context, in *DepositStatusRequest, opts ...grpc.CallOption) (*DepositStatusResponse, error) {
+	out := new(DepositStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/DepositStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) GenesisDepositRoot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GenesisDepositRootResponse, error) {
+	out := new(GenesisDepositRootResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/GenesisDepositRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) GenesisValidators(ctx context.Context, in *GenesisValidatorsRequest, opts ...grpc.CallOption) (*GenesisValidatorsResponse, error) {
+	out := new(GenesisValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/GenesisValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) ActiveValidators(ctx context.Context, in *ActiveValidatorsRequest, opts ...grpc.CallOption) (*ActiveValidatorsResponse, error) {
+	out := new(ActiveValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ActiveValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) NextEth1VotingPeriod(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1VotingPeriodResponse, error) {
+	out := new(Eth1VotingPeriodResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/NextEth1VotingPeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) Eth1VoteCandidates(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1VoteCandidatesResponse, error) {
+	out := new(Eth1VoteCandidatesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Eth1VoteCandidates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) JustifiedCheckpointHistory(ctx context.Context, in *JustifiedHistoryRequest, opts ...grpc.CallOption) (*JustifiedHistoryResponse, error) {
+	out := new(JustifiedHistoryResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/JustifiedCheckpointHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) PendingDepositCount(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingDepositCountResponse, error) {
+	out := new(PendingDepositCountResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/PendingDepositCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) EpochShuffling(ctx context.Context, in *EpochShufflingRequest, opts ...grpc.CallOption) (*EpochShufflingResponse, error) {
+	out := new(EpochShufflingResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/EpochShuffling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) MissedAttesters(ctx context.Context, in *MissedAttestersRequest, opts ...grpc.CallOption) (*MissedAttestersResponse, error) {
+	out := new(MissedAttestersResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/MissedAttesters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) ProposerReward(ctx context.Context, in *BlockByRootRequest, opts ...grpc.CallOption) (*ProposerRewardResponse, error) {
+	out := new(ProposerRewardResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ProposerReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) UpcomingActivations(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*UpcomingActivationsResponse, error) {
+	out := new(UpcomingActivationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/UpcomingActivations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) LastFinalizedSlot(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*LastFinalizedSlotResponse, error) {
+	out := new(LastFinalizedSlotResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/LastFinalizedSlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) FinalityDistance(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*FinalityDistanceResponse, error) {
+	out := new(FinalityDistanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/FinalityDistance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) StateSchemaInfo(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*StateSchemaInfoResponse, error) {
+	out := new(StateSchemaInfoResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/StateSchemaInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) ProposedBlock(ctx context.Context, in *ProposedBlockRequest, opts ...grpc.CallOption) (*ProposedBlockResponse, error) {
+	out := new(ProposedBlockResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ProposedBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) Crosslinks(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CrosslinksResponse, error) {
+	out := new(CrosslinksResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Crosslinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) ChurnLimit(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ChurnLimitResponse, error) {
+	out := new(ChurnLimitResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ChurnLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) AttestingBalances(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*AttestingBalancesResponse, error) {
+	out := new(AttestingBalancesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/AttestingBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) TotalDeposited(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*TotalDepositedResponse, error) {
+	out := new(TotalDepositedResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/TotalDeposited", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BeaconServiceServer is the server API for BeaconService service.
+type BeaconServiceServer interface {
+	WaitForChainStart(*empty.Empty, BeaconService_WaitForChainStartServer) error
+	// CanonicalHead can be called on demand to fetch the current, head block of a beacon node.
+	CanonicalHead(context.Context, *empty.Empty) (*v1.BeaconBlock, error)
+	// LatestAttestation streams the latest aggregated attestation to connected validator clients.
+	LatestAttestation(*empty.Empty, BeaconService_LatestAttestationServer) error
+	PendingDeposits(context.Context, *PendingDepositsRequest) (*PendingDepositsResponse, error)
+	Eth1Data(context.Context, *empty.Empty) (*Eth1DataResponse, error)
+	ForkData(context.Context, *empty.Empty) (*v1.Fork, error)
+	BlockTree(context.Context, *BlockTreeRequest) (*BlockTreeResponse, error)
+	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+	// DetectedSlashings returns the slashable offenses observed by the node which are not yet included on chain.
+	DetectedSlashings(context.Context, *empty.Empty) (*DetectedSlashingsResponse, error)
+	// BeaconCommittee returns the ordered validator indices of a committee at a slot within the epoch lookahead.
+	BeaconCommittee(context.Context, *BeaconCommitteeRequest) (*BeaconCommitteeResponse, error)
+	// BlockStream streams every beacon block processed by the node as it is added to the chain.
+	BlockStream(*BlockStreamRequest, BeaconService_BlockStreamServer) error
+	// AggregateAttestationStream streams attestations aggregated by attestation data as new
+	// attestations arrive and merge into them.
+	AggregateAttestationStream(*AggregateStreamRequest, BeaconService_AggregateAttestationStreamServer) error
+	// SyncStatus reports whether the node is syncing along with its head slot and the highest slot known among peers.
+	SyncStatus(context.Context, *empty.Empty) (*SyncStatusResponse, error)
+	// EpochAttestationStats returns aggregation statistics of the attestations included in the blocks of an epoch.
+	EpochAttestationStats(context.Context, *EpochAttestationStatsRequest) (*EpochAttestationStatsResponse, error)
+	// Eth1DataVotes returns the eth1 data votes of the head state ordered by descending vote count.
+	Eth1DataVotes(context.Context, *empty.Empty) (*Eth1DataVotesResponse, error)
+	// BlocksBySlot returns every block saved at a slot, marking the one on the canonical chain if any.
+	BlocksBySlot(context.Context, *BlocksBySlotRequest) (*BlocksBySlotResponse, error)
+	// SlotTickStream streams the slot and epoch at the start of every slot based on the genesis time.
+	SlotTickStream(*empty.Empty, BeaconService_SlotTickStreamServer) error
+	// BlockOperationCounts returns the number of each kind of operation included in the body of a block.
+	BlockOperationCounts(context.Context, *BlockByRootRequest) (*BlockOperationCountsResponse, error)
+	// BlockAttestations returns the attestations included in the body of a block.
+	BlockAttestations(context.Context, *BlockByRootRequest) (*BlockAttestationsResponse, error)
+	// ActiveBalance returns the total effective balance of the validators active in an epoch.
+	ActiveBalance(context.Context, *ActiveBalanceRequest) (*ActiveBalanceResponse, error)
+	// SkippedSlots returns the slots within a range which have no block on the canonical chain.
+	SkippedSlots(context.Context, *SkippedSlotsRequest) (*SkippedSlotsResponse, error)
+	// HistoricalBlockRoots returns the block roots recorded in the head state for a range of slots, in slot order.
+	HistoricalBlockRoots(context.Context, *HistoricalBlockRootsRequest) (*HistoricalBlockRootsResponse, error)
+	// SlotAttestationCoverage returns the fraction of each committee at a slot whose attestations were included on the canonical chain.
+	SlotAttestationCoverage(context.Context, *SlotCoverageRequest) (*SlotCoverageResponse, error)
+	// ForkChoiceStore returns the justified and finalized checkpoints and the blocks tracked by fork choice along with their vote weights.
+	ForkChoiceStore(context.Context, *empty.Empty) (*ForkChoiceStoreResponse, error)
+	// AttestationTargetCache returns the latest attestation target of each validator as used by fork choice.
+	AttestationTargetCache(context.Context, *empty.Empty) (*AttestationTargetCacheResponse, error)
+	// Eth1FollowStatus returns the latest eth1 block number and the highest eth1 block whose deposits are considered safe for inclusion.
+	Eth1FollowStatus(context.Context, *empty.Empty) (*Eth1FollowStatusResponse, error)
+	// DepositStatus returns whether the deposit at a Merkle tree index was processed into the head state or is still pending.
+	DepositStatus(context.Context, *DepositStatusRequest) (*DepositStatusResponse, error)
+	// GenesisDepositRoot returns the deposit root of the eth1 data the beacon chain started from.
+	GenesisDepositRoot(context.Context, *empty.Empty) (*GenesisDepositRootResponse, error)
+	// GenesisValidators returns a page of the validator registry of the genesis state.
+	GenesisValidators(context.Context, *GenesisValidatorsRequest) (*GenesisValidatorsResponse, error)
+	// ActiveValidators returns a page of the indices of the validators active in an epoch.
+	ActiveValidators(context.Context, *ActiveValidatorsRequest) (*ActiveValidatorsResponse, error)
+	// NextEth1VotingPeriod returns when the eth1 voting period of the head state ends and its votes are reset.
+	NextEth1VotingPeriod(context.Context, *empty.Empty) (*Eth1VotingPeriodResponse, error)
+	// Eth1VoteCandidates returns the eth1 blocks the node considers valid to vote for in the current eth1 voting period.
+	Eth1VoteCandidates(context.Context, *empty.Empty) (*Eth1VoteCandidatesResponse, error)
+	// JustifiedCheckpointHistory returns the justified checkpoint recorded in the historical state of each epoch in a range.
+	JustifiedCheckpointHistory(context.Context, *JustifiedHistoryRequest) (*JustifiedHistoryResponse, error)
+	// PendingDepositCount returns the number of pending deposits which have not yet been processed into the head state.
+	PendingDepositCount(context.Context, *empty.Empty) (*PendingDepositCountResponse, error)
+	// EpochShuffling returns a page of the shuffled validator indices assigned to the committees of an epoch within the seed lookahead.
+	EpochShuffling(context.Context, *EpochShufflingRequest) (*EpochShufflingResponse, error)
+	// MissedAttesters returns a page of the validators assigned to a committee in the previous epoch whose attestation was not included in a canonical block.
+	MissedAttesters(context.Context, *MissedAttestersRequest) (*MissedAttestersResponse, error)
+	// ProposerReward returns the rewards the proposer of a block earned for the attestations and slashings it included.
+	ProposerReward(context.Context, *BlockByRootRequest) (*ProposerRewardResponse, error)
+	// UpcomingActivations returns the validators the registry update at the end of the current epoch of the head state activates.
+	UpcomingActivations(context.Context, *empty.Empty) (*UpcomingActivationsResponse, error)
+	// LastFinalizedSlot returns the slot of the block at the last finalized checkpoint.
+	LastFinalizedSlot(context.Context, *empty.Empty) (*LastFinalizedSlotResponse, error)
+	// FinalityDistance returns how many slots and epochs the head state is ahead of its finalized epoch.
+	FinalityDistance(context.Context, *empty.Empty) (*FinalityDistanceResponse, error)
+	// StateSchemaInfo returns the fork version and slot of the head state along with the length of each of its lists.
+	StateSchemaInfo(context.Context, *empty.Empty) (*StateSchemaInfoResponse, error)
+	// ProposedBlock returns the canonical block at a slot if it was proposed by the requested validator.
+	ProposedBlock(context.Context, *ProposedBlockRequest) (*ProposedBlockResponse, error)
+	// Crosslinks returns the latest crosslink of every shard recorded in the head state, ordered by shard.
+	Crosslinks(context.Context, *empty.Empty) (*CrosslinksResponse, error)
+	// ChurnLimit returns the maximum balance activated or exited at a validator registry update of the head state.
+	ChurnLimit(context.Context, *empty.Empty) (*ChurnLimitResponse, error)
+	// AttestingBalances returns the attesting balances of the current and previous epochs of the head state justification is decided on.
+	AttestingBalances(context.Context, *empty.Empty) (*AttestingBalancesResponse, error)
+	// TotalDeposited returns the total amount deposited in the deposit contract as observed by the node.
+	TotalDeposited(context.Context, *empty.Empty) (*TotalDepositedResponse, error)
+}
+
+func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
+	s.RegisterService(&_BeaconService_serviceDesc, srv)
+}
+
+func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
+}
+
+type BeaconService_WaitForChainStartServer interface {
+	Send(*ChainStartResponse) error
+	grpc.ServerStream
+}
+
+type beaconServiceWaitForChainStartServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_LatestAttestation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconServiceServer).LatestAttestation(m, &beaconServiceLatestAttestationServer{stream})
+}
+
+type BeaconService_LatestAttestationServer interface {
+	Send(*v1.Attestation) error
+	grpc.ServerStream
+}
+
+type beaconServiceLatestAttestationServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceLatestAttestationServer) Send(m *v1.Attestation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconService_PendingDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingDepositsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).PendingDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/PendingDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).PendingDeposits(ctx, req.(*PendingDepositsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_Eth1Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).Eth1Data(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Eth1Data",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).Eth1Data(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_ForkData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).ForkData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ForkData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).ForkData(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*BlockTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeBlockSlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_DetectedSlashings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).DetectedSlashings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/DetectedSlashings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).DetectedSlashings(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BeaconCommittee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BeaconCommitteeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BeaconCommittee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BeaconCommittee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BeaconCommittee(ctx, req.(*BeaconCommitteeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlockStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlockStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconServiceServer).BlockStream(m, &beaconServiceBlockStreamServer{stream})
+}
+
+type BeaconService_BlockStreamServer interface {
+	Send(*v1.BeaconBlock) error
+	grpc.ServerStream
+}
+
+type beaconServiceBlockStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceBlockStreamServer) Send(m *v1.BeaconBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconService_AggregateAttestationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AggregateStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconServiceServer).AggregateAttestationStream(m, &beaconServiceAggregateAttestationStreamServer{stream})
+}
+
+type BeaconService_AggregateAttestationStreamServer interface {
+	Send(*v1.Attestation) error
+	grpc.ServerStream
+}
+
+type beaconServiceAggregateAttestationStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceAggregateAttestationStreamServer) Send(m *v1.Attestation) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconService_SyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).SyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/SyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).SyncStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_EpochAttestationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EpochAttestationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).EpochAttestationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/EpochAttestationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).EpochAttestationStats(ctx, req.(*EpochAttestationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_Eth1DataVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).Eth1DataVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Eth1DataVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).Eth1DataVotes(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlocksBySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlocksBySlotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlocksBySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlocksBySlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlocksBySlot(ctx, req.(*BlocksBySlotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_SlotTickStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(empty.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconServiceServer).SlotTickStream(m, &beaconServiceSlotTickStreamServer{stream})
 }
 
-type beaconServiceClient struct {
-	cc *grpc.ClientConn
+type BeaconService_SlotTickStreamServer interface {
+	Send(*SlotTick) error
+	grpc.ServerStream
 }
 
-func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
-	return &beaconServiceClient{cc}
+type beaconServiceSlotTickStreamServer struct {
+	grpc.ServerStream
 }
 
-func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
-	if err != nil {
+func (x *beaconServiceSlotTickStreamServer) Send(m *SlotTick) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BeaconService_BlockOperationCounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByRootRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &beaconServiceWaitForChainStartClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockOperationCounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockOperationCounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockOperationCounts(ctx, req.(*BlockByRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_BlockAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByRootRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).BlockAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).BlockAttestations(ctx, req.(*BlockByRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_ActiveBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return x, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).ActiveBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ActiveBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).ActiveBalance(ctx, req.(*ActiveBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type BeaconService_WaitForChainStartClient interface {
-	Recv() (*ChainStartResponse, error)
-	grpc.ClientStream
+func _BeaconService_SkippedSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkippedSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).SkippedSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/SkippedSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).SkippedSlots(ctx, req.(*SkippedSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type beaconServiceWaitForChainStartClient struct {
-	grpc.ClientStream
+func _BeaconService_HistoricalBlockRoots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HistoricalBlockRootsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).HistoricalBlockRoots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/HistoricalBlockRoots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).HistoricalBlockRoots(ctx, req.(*HistoricalBlockRootsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
-	m := new(ChainStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _BeaconService_SlotAttestationCoverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SlotCoverageRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).SlotAttestationCoverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/SlotAttestationCoverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).SlotAttestationCoverage(ctx, req.(*SlotCoverageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.BeaconBlock, error) {
-	out := new(v1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
-	if err != nil {
+func _BeaconService_ForkChoiceStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).ForkChoiceStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ForkChoiceStore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).ForkChoiceStore(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *beaconServiceClient) LatestAttestation(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (BeaconService_LatestAttestationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconService/LatestAttestation", opts...)
-	if err != nil {
+func _BeaconService_AttestationTargetCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &beaconServiceLatestAttestationClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).AttestationTargetCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/AttestationTargetCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).AttestationTargetCache(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_Eth1FollowStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).Eth1FollowStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Eth1FollowStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).Eth1FollowStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_DepositStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DepositStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return x, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).DepositStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/DepositStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).DepositStatus(ctx, req.(*DepositStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type BeaconService_LatestAttestationClient interface {
-	Recv() (*v1.Attestation, error)
-	grpc.ClientStream
+func _BeaconService_GenesisDepositRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).GenesisDepositRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/GenesisDepositRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).GenesisDepositRoot(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type beaconServiceLatestAttestationClient struct {
-	grpc.ClientStream
+func _BeaconService_GenesisValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenesisValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).GenesisValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/GenesisValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).GenesisValidators(ctx, req.(*GenesisValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *beaconServiceLatestAttestationClient) Recv() (*v1.Attestation, error) {
-	m := new(v1.Attestation)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _BeaconService_ActiveValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActiveValidatorsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).ActiveValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ActiveValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).ActiveValidators(ctx, req.(*ActiveValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *beaconServiceClient) PendingDeposits(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*PendingDepositsResponse, error) {
-	out := new(PendingDepositsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/PendingDeposits", in, out, opts...)
-	if err != nil {
+func _BeaconService_NextEth1VotingPeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).NextEth1VotingPeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/NextEth1VotingPeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).NextEth1VotingPeriod(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *beaconServiceClient) Eth1Data(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1DataResponse, error) {
-	out := new(Eth1DataResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Eth1Data", in, out, opts...)
-	if err != nil {
+func _BeaconService_Eth1VoteCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).Eth1VoteCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Eth1VoteCandidates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).Eth1VoteCandidates(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_JustifiedCheckpointHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JustifiedHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).JustifiedCheckpointHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/JustifiedCheckpointHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).JustifiedCheckpointHistory(ctx, req.(*JustifiedHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_PendingDepositCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).PendingDepositCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/PendingDepositCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).PendingDepositCount(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_EpochShuffling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EpochShufflingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).EpochShuffling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/EpochShuffling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).EpochShuffling(ctx, req.(*EpochShufflingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *beaconServiceClient) ForkData(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1.Fork, error) {
-	out := new(v1.Fork)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/ForkData", in, out, opts...)
-	if err != nil {
+func _BeaconService_MissedAttesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MissedAttestersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).MissedAttesters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/MissedAttesters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).MissedAttesters(ctx, req.(*MissedAttestersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *beaconServiceClient) BlockTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTree", in, out, opts...)
-	if err != nil {
+func _BeaconService_ProposerReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockByRootRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).ProposerReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ProposerReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).ProposerReward(ctx, req.(*BlockByRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
-	if err != nil {
+func _BeaconService_UpcomingActivations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-// BeaconServiceServer is the server API for BeaconService service.
-type BeaconServiceServer interface {
-	WaitForChainStart(*empty.Empty, BeaconService_WaitForChainStartServer) error
-	CanonicalHead(context.Context, *empty.Empty) (*v1.BeaconBlock, error)
-	LatestAttestation(*empty.Empty, BeaconService_LatestAttestationServer) error
-	PendingDeposits(context.Context, *empty.Empty) (*PendingDepositsResponse, error)
-	Eth1Data(context.Context, *empty.Empty) (*Eth1DataResponse, error)
-	ForkData(context.Context, *empty.Empty) (*v1.Fork, error)
-	BlockTree(context.Context, *empty.Empty) (*BlockTreeResponse, error)
-	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
-}
-
-func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
-	s.RegisterService(&_BeaconService_serviceDesc, srv)
-}
-
-func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).UpcomingActivations(ctx, in)
 	}
-	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
-}
-
-type BeaconService_WaitForChainStartServer interface {
-	Send(*ChainStartResponse) error
-	grpc.ServerStream
-}
-
-type beaconServiceWaitForChainStartServer struct {
-	grpc.ServerStream
-}
-
-func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
-	return x.ServerStream.SendMsg(m)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/UpcomingActivations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).UpcomingActivations(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BeaconService_LastFinalizedSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
+		return srv.(BeaconServiceServer).LastFinalizedSlot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/LastFinalizedSlot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*empty.Empty))
+		return srv.(BeaconServiceServer).LastFinalizedSlot(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_LatestAttestation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _BeaconService_FinalityDistance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(BeaconServiceServer).LatestAttestation(m, &beaconServiceLatestAttestationServer{stream})
-}
-
-type BeaconService_LatestAttestationServer interface {
-	Send(*v1.Attestation) error
-	grpc.ServerStream
-}
-
-type beaconServiceLatestAttestationServer struct {
-	grpc.ServerStream
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).FinalityDistance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/FinalityDistance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).FinalityDistance(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *beaconServiceLatestAttestationServer) Send(m *v1.Attestation) error {
-	return x.ServerStream.SendMsg(m)
+func _BeaconService_StateSchemaInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).StateSchemaInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/StateSchemaInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).StateSchemaInfo(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_PendingDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _BeaconService_ProposedBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProposedBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).PendingDeposits(ctx, in)
+		return srv.(BeaconServiceServer).ProposedBlock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/PendingDeposits",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ProposedBlock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).PendingDeposits(ctx, req.(*empty.Empty))
+		return srv.(BeaconServiceServer).ProposedBlock(ctx, req.(*ProposedBlockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_Eth1Data_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BeaconService_Crosslinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).Eth1Data(ctx, in)
+		return srv.(BeaconServiceServer).Crosslinks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Eth1Data",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Crosslinks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).Eth1Data(ctx, req.(*empty.Empty))
+		return srv.(BeaconServiceServer).Crosslinks(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_ForkData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BeaconService_ChurnLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).ForkData(ctx, in)
+		return srv.(BeaconServiceServer).ChurnLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ForkData",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/ChurnLimit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).ForkData(ctx, req.(*empty.Empty))
+		return srv.(BeaconServiceServer).ChurnLimit(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _BeaconService_AttestingBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTree(ctx, in)
+		return srv.(BeaconServiceServer).AttestingBalances(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/AttestingBalances",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*empty.Empty))
+		return srv.(BeaconServiceServer).AttestingBalances(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TreeBlockSlotRequest)
+func _BeaconService_TotalDeposited_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
+		return srv.(BeaconServiceServer).TotalDeposited(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/TotalDeposited",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
+		return srv.(BeaconServiceServer).TotalDeposited(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2020,6 +8567,146 @@ var _BeaconService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockTreeBySlots",
 			Handler:    _BeaconService_BlockTreeBySlots_Handler,
 		},
+		{
+			MethodName: "DetectedSlashings",
+			Handler:    _BeaconService_DetectedSlashings_Handler,
+		},
+		{
+			MethodName: "BeaconCommittee",
+			Handler:    _BeaconService_BeaconCommittee_Handler,
+		},
+		{
+			MethodName: "SyncStatus",
+			Handler:    _BeaconService_SyncStatus_Handler,
+		},
+		{
+			MethodName: "EpochAttestationStats",
+			Handler:    _BeaconService_EpochAttestationStats_Handler,
+		},
+		{
+			MethodName: "Eth1DataVotes",
+			Handler:    _BeaconService_Eth1DataVotes_Handler,
+		},
+		{
+			MethodName: "BlocksBySlot",
+			Handler:    _BeaconService_BlocksBySlot_Handler,
+		},
+		{
+			MethodName: "BlockOperationCounts",
+			Handler:    _BeaconService_BlockOperationCounts_Handler,
+		},
+		{
+			MethodName: "BlockAttestations",
+			Handler:    _BeaconService_BlockAttestations_Handler,
+		},
+		{
+			MethodName: "ActiveBalance",
+			Handler:    _BeaconService_ActiveBalance_Handler,
+		},
+		{
+			MethodName: "SkippedSlots",
+			Handler:    _BeaconService_SkippedSlots_Handler,
+		},
+		{
+			MethodName: "HistoricalBlockRoots",
+			Handler:    _BeaconService_HistoricalBlockRoots_Handler,
+		},
+		{
+			MethodName: "SlotAttestationCoverage",
+			Handler:    _BeaconService_SlotAttestationCoverage_Handler,
+		},
+		{
+			MethodName: "ForkChoiceStore",
+			Handler:    _BeaconService_ForkChoiceStore_Handler,
+		},
+		{
+			MethodName: "AttestationTargetCache",
+			Handler:    _BeaconService_AttestationTargetCache_Handler,
+		},
+		{
+			MethodName: "Eth1FollowStatus",
+			Handler:    _BeaconService_Eth1FollowStatus_Handler,
+		},
+		{
+			MethodName: "DepositStatus",
+			Handler:    _BeaconService_DepositStatus_Handler,
+		},
+		{
+			MethodName: "GenesisDepositRoot",
+			Handler:    _BeaconService_GenesisDepositRoot_Handler,
+		},
+		{
+			MethodName: "GenesisValidators",
+			Handler:    _BeaconService_GenesisValidators_Handler,
+		},
+		{
+			MethodName: "ActiveValidators",
+			Handler:    _BeaconService_ActiveValidators_Handler,
+		},
+		{
+			MethodName: "NextEth1VotingPeriod",
+			Handler:    _BeaconService_NextEth1VotingPeriod_Handler,
+		},
+		{
+			MethodName: "Eth1VoteCandidates",
+			Handler:    _BeaconService_Eth1VoteCandidates_Handler,
+		},
+		{
+			MethodName: "JustifiedCheckpointHistory",
+			Handler:    _BeaconService_JustifiedCheckpointHistory_Handler,
+		},
+		{
+			MethodName: "PendingDepositCount",
+			Handler:    _BeaconService_PendingDepositCount_Handler,
+		},
+		{
+			MethodName: "EpochShuffling",
+			Handler:    _BeaconService_EpochShuffling_Handler,
+		},
+		{
+			MethodName: "MissedAttesters",
+			Handler:    _BeaconService_MissedAttesters_Handler,
+		},
+		{
+			MethodName: "ProposerReward",
+			Handler:    _BeaconService_ProposerReward_Handler,
+		},
+		{
+			MethodName: "UpcomingActivations",
+			Handler:    _BeaconService_UpcomingActivations_Handler,
+		},
+		{
+			MethodName: "LastFinalizedSlot",
+			Handler:    _BeaconService_LastFinalizedSlot_Handler,
+		},
+		{
+			MethodName: "FinalityDistance",
+			Handler:    _BeaconService_FinalityDistance_Handler,
+		},
+		{
+			MethodName: "StateSchemaInfo",
+			Handler:    _BeaconService_StateSchemaInfo_Handler,
+		},
+		{
+			MethodName: "ProposedBlock",
+			Handler:    _BeaconService_ProposedBlock_Handler,
+		},
+		{
+			MethodName: "Crosslinks",
+			Handler:    _BeaconService_Crosslinks_Handler,
+		},
+		{
+			MethodName: "ChurnLimit",
+			Handler:    _BeaconService_ChurnLimit_Handler,
+		},
+		{
+			MethodName: "AttestingBalances",
+			Handler:    _BeaconService_AttestingBalances_Handler,
+		},
+		{
+			MethodName: "TotalDeposited",
+			Handler:    _BeaconService_TotalDeposited_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2032,6 +8719,21 @@ var _BeaconService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _BeaconService_LatestAttestation_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "BlockStream",
+			Handler:       _BeaconService_BlockStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AggregateAttestationStream",
+			Handler:       _BeaconService_AggregateAttestationStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SlotTickStream",
+			Handler:       _BeaconService_SlotTickStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
@@ -2042,6 +8744,10 @@ var _BeaconService_serviceDesc = grpc.ServiceDesc{
 type AttesterServiceClient interface {
 	AttestHead(ctx context.Context, in *v1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
 	AttestationDataAtSlot(ctx context.Context, in *AttestationDataRequest, opts ...grpc.CallOption) (*AttestationDataResponse, error)
+	// ValidateAttestation checks whether an attestation would be accepted against the head state without broadcasting it.
+	ValidateAttestation(ctx context.Context, in *ValidateAttestationRequest, opts ...grpc.CallOption) (*ValidateAttestationResponse, error)
+	// HashAttestationData returns the roots the node computes for attestation data when keying and verifying attestations.
+	HashAttestationData(ctx context.Context, in *v1.AttestationData, opts ...grpc.CallOption) (*AttestationDataRootResponse, error)
 }
 
 type attesterServiceClient struct {
@@ -2070,48 +8776,106 @@ func (c *attesterServiceClient) AttestationDataAtSlot(ctx context.Context, in *A
 	return out, nil
 }
 
+func (c *attesterServiceClient) ValidateAttestation(ctx context.Context, in *ValidateAttestationRequest, opts ...grpc.CallOption) (*ValidateAttestationResponse, error) {
+	out := new(ValidateAttestationResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/ValidateAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attesterServiceClient) HashAttestationData(ctx context.Context, in *v1.AttestationData, opts ...grpc.CallOption) (*AttestationDataRootResponse, error) {
+	out := new(AttestationDataRootResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/HashAttestationData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AttesterServiceServer is the server API for AttesterService service.
 type AttesterServiceServer interface {
 	AttestHead(context.Context, *v1.Attestation) (*AttestResponse, error)
 	AttestationDataAtSlot(context.Context, *AttestationDataRequest) (*AttestationDataResponse, error)
+	// ValidateAttestation checks whether an attestation would be accepted against the head state without broadcasting it.
+	ValidateAttestation(context.Context, *ValidateAttestationRequest) (*ValidateAttestationResponse, error)
+	// HashAttestationData returns the roots the node computes for attestation data when keying and verifying attestations.
+	HashAttestationData(context.Context, *v1.AttestationData) (*AttestationDataRootResponse, error)
 }
 
 func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
 	s.RegisterService(&_AttesterService_serviceDesc, srv)
 }
 
-func _AttesterService_AttestHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1.Attestation)
+func _AttesterService_AttestHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.Attestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).AttestHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/AttestHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).AttestHead(ctx, req.(*v1.Attestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttesterService_AttestationDataAtSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).AttestationDataAtSlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/AttestationDataAtSlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).AttestationDataAtSlot(ctx, req.(*AttestationDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttesterService_ValidateAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateAttestationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttesterServiceServer).AttestHead(ctx, in)
+		return srv.(AttesterServiceServer).ValidateAttestation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/AttestHead",
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/ValidateAttestation",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).AttestHead(ctx, req.(*v1.Attestation))
+		return srv.(AttesterServiceServer).ValidateAttestation(ctx, req.(*ValidateAttestationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AttesterService_AttestationDataAtSlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationDataRequest)
+func _AttesterService_HashAttestationData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1.AttestationData)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AttesterServiceServer).AttestationDataAtSlot(ctx, in)
+		return srv.(AttesterServiceServer).HashAttestationData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/AttestationDataAtSlot",
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/HashAttestationData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).AttestationDataAtSlot(ctx, req.(*AttestationDataRequest))
+		return srv.(AttesterServiceServer).HashAttestationData(ctx, req.(*v1.AttestationData))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2128,6 +8892,14 @@ var _AttesterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AttestationDataAtSlot",
 			Handler:    _AttesterService_AttestationDataAtSlot_Handler,
 		},
+		{
+			MethodName: "ValidateAttestation",
+			Handler:    _AttesterService_ValidateAttestation_Handler,
+		},
+		{
+			MethodName: "HashAttestationData",
+			Handler:    _AttesterService_HashAttestationData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
@@ -2141,6 +8913,8 @@ type ProposerServiceClient interface {
 	PendingAttestations(ctx context.Context, in *PendingAttestationsRequest, opts ...grpc.CallOption) (*PendingAttestationsResponse, error)
 	ProposeBlock(ctx context.Context, in *v1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
 	ComputeStateRoot(ctx context.Context, in *v1.BeaconBlock, opts ...grpc.CallOption) (*StateRootResponse, error)
+	// CurrentProposer returns the validator expected to propose a block at the current slot of the head state.
+	CurrentProposer(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CurrentProposerResponse, error)
 }
 
 type proposerServiceClient struct {
@@ -2187,12 +8961,23 @@ func (c *proposerServiceClient) ComputeStateRoot(ctx context.Context, in *v1.Bea
 	return out, nil
 }
 
+func (c *proposerServiceClient) CurrentProposer(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*CurrentProposerResponse, error) {
+	out := new(CurrentProposerResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/CurrentProposer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProposerServiceServer is the server API for ProposerService service.
 type ProposerServiceServer interface {
 	ProposerIndex(context.Context, *ProposerIndexRequest) (*ProposerIndexResponse, error)
 	PendingAttestations(context.Context, *PendingAttestationsRequest) (*PendingAttestationsResponse, error)
 	ProposeBlock(context.Context, *v1.BeaconBlock) (*ProposeResponse, error)
 	ComputeStateRoot(context.Context, *v1.BeaconBlock) (*StateRootResponse, error)
+	// CurrentProposer returns the validator expected to propose a block at the current slot of the head state.
+	CurrentProposer(context.Context, *empty.Empty) (*CurrentProposerResponse, error)
 }
 
 func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
@@ -2271,6 +9056,24 @@ func _ProposerService_ComputeStateRoot_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProposerService_CurrentProposer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).CurrentProposer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/CurrentProposer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).CurrentProposer(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ProposerService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
 	HandlerType: (*ProposerServiceServer)(nil),
@@ -2291,6 +9094,10 @@ var _ProposerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ComputeStateRoot",
 			Handler:    _ProposerService_ComputeStateRoot_Handler,
 		},
+		{
+			MethodName: "CurrentProposer",
+			Handler:    _ProposerService_CurrentProposer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
@@ -2306,6 +9113,26 @@ type ValidatorServiceClient interface {
 	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
 	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
 	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+	// WithdrawalCredentials returns the withdrawal credentials of the requested validators from the head state.
+	WithdrawalCredentials(ctx context.Context, in *WithdrawalCredentialsRequest, opts ...grpc.CallOption) (*WithdrawalCredentialsResponse, error)
+	// ValidatorDuties returns the attestation and proposal duties of the requested validators for an epoch.
+	ValidatorDuties(ctx context.Context, in *ValidatorDutiesRequest, opts ...grpc.CallOption) (*ValidatorDutiesResponse, error)
+	// ValidatorBalanceDelta returns the signed balance change of a validator between the historical states at two slots.
+	ValidatorBalanceDelta(ctx context.Context, in *ValidatorBalanceDeltaRequest, opts ...grpc.CallOption) (*ValidatorBalanceDeltaResponse, error)
+	// ValidatorAttestations returns the attestations included on the canonical chain in which a validator participated.
+	ValidatorAttestations(ctx context.Context, in *ValidatorAttestationsRequest, opts ...grpc.CallOption) (*ValidatorAttestationsResponse, error)
+	// WithdrawableValidators returns a page of the indices of the validators which are withdrawable at an epoch.
+	WithdrawableValidators(ctx context.Context, in *WithdrawableValidatorsRequest, opts ...grpc.CallOption) (*WithdrawableValidatorsResponse, error)
+	// AggregatePublicKey returns the aggregate of the public keys of the requested validators in the head state.
+	AggregatePublicKey(ctx context.Context, in *AggregatePublicKeyRequest, opts ...grpc.CallOption) (*AggregatePublicKeyResponse, error)
+	// ValidatorAttested returns whether a validator's attestation for a slot was included in a canonical block.
+	ValidatorAttested(ctx context.Context, in *ValidatorAttestedRequest, opts ...grpc.CallOption) (*ValidatorAttestedResponse, error)
+	// ValidatePubkey parses a compressed BLS public key with the BLS library of the node and returns its canonical serialization.
+	ValidatePubkey(ctx context.Context, in *ValidatePubkeyRequest, opts ...grpc.CallOption) (*ValidatePubkeyResponse, error)
+	// ValidatorBalances returns the balance and effective balance in the head state of each requested validator.
+	ValidatorBalances(ctx context.Context, in *ValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalancesResponse, error)
+	// ValidatorPerformanceSummary returns a validator's duty performance and balance change over a range of epochs.
+	ValidatorPerformanceSummary(ctx context.Context, in *ValidatorPerformanceSummaryRequest, opts ...grpc.CallOption) (*ValidatorPerformanceSummaryResponse, error)
 }
 
 type validatorServiceClient struct {
@@ -2393,6 +9220,96 @@ func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *Exite
 	return out, nil
 }
 
+func (c *validatorServiceClient) WithdrawalCredentials(ctx context.Context, in *WithdrawalCredentialsRequest, opts ...grpc.CallOption) (*WithdrawalCredentialsResponse, error) {
+	out := new(WithdrawalCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/WithdrawalCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorDuties(ctx context.Context, in *ValidatorDutiesRequest, opts ...grpc.CallOption) (*ValidatorDutiesResponse, error) {
+	out := new(ValidatorDutiesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorDuties", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorBalanceDelta(ctx context.Context, in *ValidatorBalanceDeltaRequest, opts ...grpc.CallOption) (*ValidatorBalanceDeltaResponse, error) {
+	out := new(ValidatorBalanceDeltaResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorBalanceDelta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorAttestations(ctx context.Context, in *ValidatorAttestationsRequest, opts ...grpc.CallOption) (*ValidatorAttestationsResponse, error) {
+	out := new(ValidatorAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) WithdrawableValidators(ctx context.Context, in *WithdrawableValidatorsRequest, opts ...grpc.CallOption) (*WithdrawableValidatorsResponse, error) {
+	out := new(WithdrawableValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/WithdrawableValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) AggregatePublicKey(ctx context.Context, in *AggregatePublicKeyRequest, opts ...grpc.CallOption) (*AggregatePublicKeyResponse, error) {
+	out := new(AggregatePublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/AggregatePublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorAttested(ctx context.Context, in *ValidatorAttestedRequest, opts ...grpc.CallOption) (*ValidatorAttestedResponse, error) {
+	out := new(ValidatorAttestedResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorAttested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatePubkey(ctx context.Context, in *ValidatePubkeyRequest, opts ...grpc.CallOption) (*ValidatePubkeyResponse, error) {
+	out := new(ValidatePubkeyResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatePubkey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorBalances(ctx context.Context, in *ValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalancesResponse, error) {
+	out := new(ValidatorBalancesResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *validatorServiceClient) ValidatorPerformanceSummary(ctx context.Context, in *ValidatorPerformanceSummaryRequest, opts ...grpc.CallOption) (*ValidatorPerformanceSummaryResponse, error) {
+	out := new(ValidatorPerformanceSummaryResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformanceSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ValidatorServiceServer is the server API for ValidatorService service.
 type ValidatorServiceServer interface {
 	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
@@ -2401,6 +9318,26 @@ type ValidatorServiceServer interface {
 	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
 	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
 	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+	// WithdrawalCredentials returns the withdrawal credentials of the requested validators from the head state.
+	WithdrawalCredentials(context.Context, *WithdrawalCredentialsRequest) (*WithdrawalCredentialsResponse, error)
+	// ValidatorDuties returns the attestation and proposal duties of the requested validators for an epoch.
+	ValidatorDuties(context.Context, *ValidatorDutiesRequest) (*ValidatorDutiesResponse, error)
+	// ValidatorBalanceDelta returns the signed balance change of a validator between the historical states at two slots.
+	ValidatorBalanceDelta(context.Context, *ValidatorBalanceDeltaRequest) (*ValidatorBalanceDeltaResponse, error)
+	// ValidatorAttestations returns the attestations included on the canonical chain in which a validator participated.
+	ValidatorAttestations(context.Context, *ValidatorAttestationsRequest) (*ValidatorAttestationsResponse, error)
+	// WithdrawableValidators returns a page of the indices of the validators which are withdrawable at an epoch.
+	WithdrawableValidators(context.Context, *WithdrawableValidatorsRequest) (*WithdrawableValidatorsResponse, error)
+	// AggregatePublicKey returns the aggregate of the public keys of the requested validators in the head state.
+	AggregatePublicKey(context.Context, *AggregatePublicKeyRequest) (*AggregatePublicKeyResponse, error)
+	// ValidatorAttested returns whether a validator's attestation for a slot was included in a canonical block.
+	ValidatorAttested(context.Context, *ValidatorAttestedRequest) (*ValidatorAttestedResponse, error)
+	// ValidatePubkey parses a compressed BLS public key with the BLS library of the node and returns its canonical serialization.
+	ValidatePubkey(context.Context, *ValidatePubkeyRequest) (*ValidatePubkeyResponse, error)
+	// ValidatorBalances returns the balance and effective balance in the head state of each requested validator.
+	ValidatorBalances(context.Context, *ValidatorBalancesRequest) (*ValidatorBalancesResponse, error)
+	// ValidatorPerformanceSummary returns a validator's duty performance and balance change over a range of epochs.
+	ValidatorPerformanceSummary(context.Context, *ValidatorPerformanceSummaryRequest) (*ValidatorPerformanceSummaryResponse, error)
 }
 
 func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
@@ -2518,6 +9455,186 @@ func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ValidatorService_WithdrawalCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).WithdrawalCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/WithdrawalCredentials",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).WithdrawalCredentials(ctx, req.(*WithdrawalCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorDuties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorDutiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorDuties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorDuties",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorDuties(ctx, req.(*ValidatorDutiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorBalanceDelta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorBalanceDeltaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorBalanceDelta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorBalanceDelta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorBalanceDelta(ctx, req.(*ValidatorBalanceDeltaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorAttestations(ctx, req.(*ValidatorAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_WithdrawableValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawableValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).WithdrawableValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/WithdrawableValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).WithdrawableValidators(ctx, req.(*WithdrawableValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_AggregatePublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AggregatePublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).AggregatePublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/AggregatePublicKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).AggregatePublicKey(ctx, req.(*AggregatePublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorAttested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorAttestedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorAttested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorAttested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorAttested(ctx, req.(*ValidatorAttestedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatePubkey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePubkeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatePubkey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatePubkey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatePubkey(ctx, req.(*ValidatePubkeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorBalances(ctx, req.(*ValidatorBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorPerformanceSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorPerformanceSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformanceSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorPerformanceSummary(ctx, req.(*ValidatorPerformanceSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ValidatorService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
 	HandlerType: (*ValidatorServiceServer)(nil),
@@ -2542,6 +9659,46 @@ var _ValidatorService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitedValidators",
 			Handler:    _ValidatorService_ExitedValidators_Handler,
 		},
+		{
+			MethodName: "WithdrawalCredentials",
+			Handler:    _ValidatorService_WithdrawalCredentials_Handler,
+		},
+		{
+			MethodName: "ValidatorDuties",
+			Handler:    _ValidatorService_ValidatorDuties_Handler,
+		},
+		{
+			MethodName: "ValidatorBalanceDelta",
+			Handler:    _ValidatorService_ValidatorBalanceDelta_Handler,
+		},
+		{
+			MethodName: "ValidatorAttestations",
+			Handler:    _ValidatorService_ValidatorAttestations_Handler,
+		},
+		{
+			MethodName: "WithdrawableValidators",
+			Handler:    _ValidatorService_WithdrawableValidators_Handler,
+		},
+		{
+			MethodName: "AggregatePublicKey",
+			Handler:    _ValidatorService_AggregatePublicKey_Handler,
+		},
+		{
+			MethodName: "ValidatorAttested",
+			Handler:    _ValidatorService_ValidatorAttested_Handler,
+		},
+		{
+			MethodName: "ValidatePubkey",
+			Handler:    _ValidatorService_ValidatePubkey_Handler,
+		},
+		{
+			MethodName: "ValidatorBalances",
+			Handler:    _ValidatorService_ValidatorBalances_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformanceSummary",
+			Handler:    _ValidatorService_ValidatorPerformanceSummary_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
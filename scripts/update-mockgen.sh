@@ -4,10 +4,10 @@
 # Use a space to separate mock destination from its interfaces.
 
 mocks=(
-      "./beacon-chain/internal/beacon_service_mock.go BeaconServiceServer,BeaconService_LatestAttestationServer,BeaconService_WaitForChainStartServer"
+      "./beacon-chain/internal/beacon_service_mock.go BeaconServiceServer,BeaconService_LatestAttestationServer,BeaconService_WaitForChainStartServer,BeaconService_BlockStreamServer"
       "./beacon-chain/internal/validator_service_mock.go ValidatorServiceServer,ValidatorService_WaitForActivationServer"
       "./validator/internal/attester_service_mock.go AttesterServiceClient"
-       "./validator/internal/beacon_service_mock.go BeaconServiceClient,BeaconService_LatestAttestationClient,BeaconService_WaitForChainStartClient"
+       "./validator/internal/beacon_service_mock.go BeaconServiceClient,BeaconService_LatestAttestationClient,BeaconService_WaitForChainStartClient,BeaconService_BlockStreamClient"
        "./validator/internal/proposer_service_mock.go ProposerServiceClient"
        "./validator/internal/validator_service_mock.go ValidatorServiceClient,ValidatorService_WaitForActivationClient")
 
@@ -110,6 +110,21 @@ func (s *Signature) VerifyAggregate(pubKeys []*PublicKey, msg []byte, domain uin
 	return s.val.VerifyAggregateCommonWithDomain(keys, bytesutil.ToBytes32(msg), domain)
 }
 
+// VerifyAggregateMessages verifies an aggregated signature of distinct messages, where
+// each message is signed by the public key at the same position.
+func (s *Signature) VerifyAggregateMessages(pubKeys []*PublicKey, msgs [][]byte, domain uint64) bool {
+	if len(pubKeys) != len(msgs) {
+		return false
+	}
+	keys := make([]*g1.PublicKey, len(pubKeys))
+	hashes := make([][32]byte, len(msgs))
+	for i := range pubKeys {
+		keys[i] = pubKeys[i].val
+		hashes[i] = bytesutil.ToBytes32(msgs[i])
+	}
+	return s.val.VerifyAggregateWithDomain(keys, hashes, domain)
+}
+
 // Marshal a signature into a byte slice.
 func (s *Signature) Marshal() []byte {
 	k := s.val.Serialize()
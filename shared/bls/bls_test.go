@@ -51,3 +51,24 @@ func TestVerifyAggregate(t *testing.T) {
 		t.Error("Signature did not verify")
 	}
 }
+
+func TestVerifyAggregateMessages(t *testing.T) {
+	pubkeys := make([]*bls.PublicKey, 0, 100)
+	sigs := make([]*bls.Signature, 0, 100)
+	msgs := make([][]byte, 0, 100)
+	for i := 0; i < 100; i++ {
+		msg := []byte{byte(i)}
+		priv, _ := bls.RandKey(rand.Reader)
+		pubkeys = append(pubkeys, priv.PublicKey())
+		sigs = append(sigs, priv.Sign(msg, 0))
+		msgs = append(msgs, msg)
+	}
+	aggSig := bls.AggregateSignatures(sigs)
+	if !aggSig.VerifyAggregateMessages(pubkeys, msgs, 0) {
+		t.Error("Signature did not verify")
+	}
+	msgs[0], msgs[1] = msgs[1], msgs[0]
+	if aggSig.VerifyAggregateMessages(pubkeys, msgs, 0) {
+		t.Error("Signature verified with messages assigned to the wrong keys")
+	}
+}
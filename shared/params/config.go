@@ -259,3 +259,16 @@ func UseDemoBeaconConfig() {
 func OverrideBeaconConfig(c *BeaconChainConfig) {
 	beaconConfig = c
 }
+
+// SnapshotConfig returns a copy of the current beacon config. Passing it to RestoreConfig
+// undoes any later overrides, including changes made in place to the config returned by
+// BeaconConfig().
+func SnapshotConfig() *BeaconChainConfig {
+	c := *beaconConfig
+	return &c
+}
+
+// RestoreConfig replaces the beacon config with a snapshot taken by SnapshotConfig.
+func RestoreConfig(snapshot *BeaconChainConfig) {
+	beaconConfig = snapshot
+}
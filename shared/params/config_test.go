@@ -12,3 +12,23 @@ func TestOverrideBeaconConfig(t *testing.T) {
 		t.Errorf("Shardcount in BeaconConfig incorrect. Wanted %d, got %d", 5, c.ShardCount)
 	}
 }
+
+func TestRestoreConfig_UndoesOverrides(t *testing.T) {
+	snapshot := SnapshotConfig()
+	defer RestoreConfig(snapshot)
+	shardCount := snapshot.ShardCount
+
+	cfg := BeaconConfig()
+	cfg.ShardCount = shardCount + 1
+	OverrideBeaconConfig(cfg)
+	RestoreConfig(snapshot)
+	if c := BeaconConfig(); c.ShardCount != shardCount {
+		t.Errorf("Expected in place changes to be undone, wanted shard count %d, got %d", shardCount, c.ShardCount)
+	}
+
+	OverrideBeaconConfig(&BeaconChainConfig{ShardCount: shardCount + 2})
+	RestoreConfig(snapshot)
+	if c := BeaconConfig(); c.ShardCount != shardCount {
+		t.Errorf("Expected override to be undone, wanted shard count %d, got %d", shardCount, c.ShardCount)
+	}
+}
@@ -68,7 +68,11 @@ func (s *SlotTicker) start(
 			waitTime := until(nextTickTime)
 			select {
 			case <-after(waitTime):
-				s.c <- slot
+				select {
+				case s.c <- slot:
+				case <-s.done:
+					return
+				}
 				slot++
 				nextTickTime = nextTickTime.Add(d)
 			case <-s.done:
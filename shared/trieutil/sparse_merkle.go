@@ -45,6 +45,51 @@ func GenerateTrieFromItems(items [][]byte, depth int) (*MerkleTrie, error) {
 	return &MerkleTrie{branches: branches, originalItems: items}, nil
 }
 
+// InsertIntoTrie inserts an item at the given index of the Merkle trie, either replacing an
+// existing item or appending right after the last one, and only recomputes the hashes along
+// the path from the new leaf up to the root instead of regenerating the whole trie.
+func (m *MerkleTrie) InsertIntoTrie(item []byte, index int) error {
+	if index < 0 || index > len(m.originalItems) {
+		return fmt.Errorf("insertion index out of range in trie, max index: %d, received: %d", len(m.originalItems), index)
+	}
+	emptyNode := parentHash([]byte{}, []byte{})
+	leaf := hashutil.Hash(item)
+	node := leaf[:]
+	idx := index
+	// We walk up from the leaves, which are the last layer of the branches, replacing
+	// or appending the updated node in each layer and padding it to an even length
+	// with an empty node, as done when generating the trie.
+	for i := len(m.branches) - 1; i >= 0; i-- {
+		layer := m.branches[i]
+		if idx < len(layer) {
+			layer[idx] = node
+		} else {
+			layer = append(layer, node)
+		}
+		if i == 0 {
+			m.branches[i] = layer
+			break
+		}
+		if len(layer)%2 == 1 {
+			layer = append(layer, emptyNode)
+		}
+		m.branches[i] = layer
+		sibling := layer[idx^1]
+		if idx%2 == 0 {
+			node = parentHash(node, sibling)
+		} else {
+			node = parentHash(sibling, node)
+		}
+		idx /= 2
+	}
+	if index == len(m.originalItems) {
+		m.originalItems = append(m.originalItems, item)
+	} else {
+		m.originalItems[index] = item
+	}
+	return nil
+}
+
 // VerifyMerkleProof verifies a Merkle branch against a root of a trie.
 func VerifyMerkleProof(root []byte, item []byte, merkleIndex int, proof [][]byte) bool {
 	leaf := hashutil.Hash(item)
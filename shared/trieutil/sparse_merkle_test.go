@@ -123,3 +123,61 @@ func BenchmarkVerifyMerkleBranch(b *testing.B) {
 		}
 	}
 }
+
+func TestMerkleTrie_InsertIntoTrie_MatchesFullRebuild(t *testing.T) {
+	items := [][]byte{
+		[]byte("A"),
+		[]byte("BB"),
+		[]byte("CCC"),
+		[]byte("DDDD"),
+		[]byte("EEEEE"),
+		[]byte("FFFFFF"),
+		[]byte("GGGGGGG"),
+		[]byte("HHHHHHHH"),
+		[]byte("IIIIIIIII"),
+	}
+	m, err := GenerateTrieFromItems(items[:1], 32)
+	if err != nil {
+		t.Fatalf("Could not generate Merkle trie from items: %v", err)
+	}
+	for i := 1; i < len(items); i++ {
+		if err := m.InsertIntoTrie(items[i], i); err != nil {
+			t.Fatalf("Could not insert item %d into trie: %v", i, err)
+		}
+		rebuilt, err := GenerateTrieFromItems(items[:i+1], 32)
+		if err != nil {
+			t.Fatalf("Could not generate Merkle trie from items: %v", err)
+		}
+		if m.Root() != rebuilt.Root() {
+			t.Errorf("Expected root %#x after inserting item %d, received %#x", rebuilt.Root(), i, m.Root())
+		}
+		proof, err := m.MerkleProof(i)
+		if err != nil {
+			t.Fatalf("Could not generate Merkle proof: %v", err)
+		}
+		root := m.Root()
+		if ok := VerifyMerkleProof(root[:], items[i], i, proof); !ok {
+			t.Errorf("Merkle proof for item %d did not verify", i)
+		}
+	}
+}
+
+func TestMerkleTrie_InsertIntoTrie_ReplacesItem(t *testing.T) {
+	m, err := GenerateTrieFromItems([][]byte{{}}, 32)
+	if err != nil {
+		t.Fatalf("Could not generate Merkle trie from items: %v", err)
+	}
+	if err := m.InsertIntoTrie([]byte("A"), 0); err != nil {
+		t.Fatalf("Could not insert item into trie: %v", err)
+	}
+	rebuilt, err := GenerateTrieFromItems([][]byte{[]byte("A")}, 32)
+	if err != nil {
+		t.Fatalf("Could not generate Merkle trie from items: %v", err)
+	}
+	if m.Root() != rebuilt.Root() {
+		t.Errorf("Expected root %#x, received %#x", rebuilt.Root(), m.Root())
+	}
+	if err := m.InsertIntoTrie([]byte("C"), 2); err == nil {
+		t.Error("Expected out of range insertion to fail, received nil")
+	}
+}
@@ -49,7 +49,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, idx string) {
 	}
 
 	// Get validator ETH1 deposits which have not been included in the beacon chain.
-	pDepResp, err := v.beaconClient.PendingDeposits(ctx, &ptypes.Empty{})
+	pDepResp, err := v.beaconClient.PendingDeposits(ctx, &pb.PendingDepositsRequest{})
 	if err != nil {
 		log.WithError(err).Error("Failed to get pendings deposits")
 		return
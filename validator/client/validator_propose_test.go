@@ -79,7 +79,7 @@ func TestProposeBlock_PendingDepositsFailure(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(nil /*response*/, errors.New("something bad happened"))
 
 	validator.ProposeBlock(context.Background(), 55, hex.EncodeToString(validatorKey.PublicKey.Marshal()))
@@ -98,7 +98,7 @@ func TestProposeBlock_UsePendingDeposits(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{
 		PendingDeposits: []*pbp2p.Deposit{
 			{DepositData: []byte{'D', 'A', 'T', 'A'}},
@@ -158,7 +158,7 @@ func TestProposeBlock_Eth1DataFailure(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
 
 	m.beaconClient.EXPECT().Eth1Data(
@@ -182,7 +182,7 @@ func TestProposeBlock_UsesEth1Data(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
 
 	m.beaconClient.EXPECT().Eth1Data(
@@ -239,7 +239,7 @@ func TestProposeBlock_PendingAttestations_UsesCurrentSlot(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
 
 	m.beaconClient.EXPECT().Eth1Data(
@@ -301,7 +301,7 @@ func TestProposeBlock_PendingAttestationsFailure(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
 
 	m.beaconClient.EXPECT().Eth1Data(
@@ -341,7 +341,7 @@ func TestProposeBlock_ComputeStateFailure(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
 
 	m.beaconClient.EXPECT().Eth1Data(
@@ -383,7 +383,7 @@ func TestProposeBlock_UsesComputedState(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
 
 	m.beaconClient.EXPECT().Eth1Data(
@@ -442,7 +442,7 @@ func TestProposeBlock_BroadcastsABlock(t *testing.T) {
 
 	m.beaconClient.EXPECT().PendingDeposits(
 		gomock.Any(), // ctx
-		gomock.Eq(&ptypes.Empty{}),
+		gomock.Eq(&pb.PendingDepositsRequest{}),
 	).Return(&pb.PendingDepositsResponse{}, nil /*err*/)
 
 	m.beaconClient.EXPECT().Eth1Data(
@@ -76,3 +76,43 @@ func (mr *MockAttesterServiceClientMockRecorder) AttestationDataAtSlot(arg0, arg
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestationDataAtSlot", reflect.TypeOf((*MockAttesterServiceClient)(nil).AttestationDataAtSlot), varargs...)
 }
+
+// HashAttestationData mocks base method
+func (m *MockAttesterServiceClient) HashAttestationData(arg0 context.Context, arg1 *v1.AttestationData, arg2 ...grpc.CallOption) (*v10.AttestationDataRootResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HashAttestationData", varargs...)
+	ret0, _ := ret[0].(*v10.AttestationDataRootResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HashAttestationData indicates an expected call of HashAttestationData
+func (mr *MockAttesterServiceClientMockRecorder) HashAttestationData(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashAttestationData", reflect.TypeOf((*MockAttesterServiceClient)(nil).HashAttestationData), varargs...)
+}
+
+// ValidateAttestation mocks base method
+func (m *MockAttesterServiceClient) ValidateAttestation(arg0 context.Context, arg1 *v10.ValidateAttestationRequest, arg2 ...grpc.CallOption) (*v10.ValidateAttestationResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidateAttestation", varargs...)
+	ret0, _ := ret[0].(*v10.ValidateAttestationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateAttestation indicates an expected call of ValidateAttestation
+func (mr *MockAttesterServiceClientMockRecorder) ValidateAttestation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAttestation", reflect.TypeOf((*MockAttesterServiceClient)(nil).ValidateAttestation), varargs...)
+}
@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceClient,BeaconService_LatestAttestationClient,BeaconService_WaitForChainStartClient)
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: BeaconServiceClient,BeaconService_LatestAttestationClient,BeaconService_WaitForChainStartClient,BeaconService_BlockStreamClient,BeaconService_SlotTickStreamClient,BeaconService_AggregateAttestationStreamClient)
 
 // Package internal is a generated GoMock package.
 package internal
@@ -39,8 +39,188 @@ func (m *MockBeaconServiceClient) EXPECT() *MockBeaconServiceClientMockRecorder
 	return m.recorder
 }
 
+// ActiveBalance mocks base method
+func (m *MockBeaconServiceClient) ActiveBalance(arg0 context.Context, arg1 *v10.ActiveBalanceRequest, arg2 ...grpc.CallOption) (*v10.ActiveBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ActiveBalance", varargs...)
+	ret0, _ := ret[0].(*v10.ActiveBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveBalance indicates an expected call of ActiveBalance
+func (mr *MockBeaconServiceClientMockRecorder) ActiveBalance(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveBalance", reflect.TypeOf((*MockBeaconServiceClient)(nil).ActiveBalance), varargs...)
+}
+
+// ActiveValidators mocks base method
+func (m *MockBeaconServiceClient) ActiveValidators(arg0 context.Context, arg1 *v10.ActiveValidatorsRequest, arg2 ...grpc.CallOption) (*v10.ActiveValidatorsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ActiveValidators", varargs...)
+	ret0, _ := ret[0].(*v10.ActiveValidatorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActiveValidators indicates an expected call of ActiveValidators
+func (mr *MockBeaconServiceClientMockRecorder) ActiveValidators(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActiveValidators", reflect.TypeOf((*MockBeaconServiceClient)(nil).ActiveValidators), varargs...)
+}
+
+// AggregateAttestationStream mocks base method
+func (m *MockBeaconServiceClient) AggregateAttestationStream(arg0 context.Context, arg1 *v10.AggregateStreamRequest, arg2 ...grpc.CallOption) (v10.BeaconService_AggregateAttestationStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AggregateAttestationStream", varargs...)
+	ret0, _ := ret[0].(v10.BeaconService_AggregateAttestationStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregateAttestationStream indicates an expected call of AggregateAttestationStream
+func (mr *MockBeaconServiceClientMockRecorder) AggregateAttestationStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregateAttestationStream", reflect.TypeOf((*MockBeaconServiceClient)(nil).AggregateAttestationStream), varargs...)
+}
+
+// AttestationTargetCache mocks base method
+func (m *MockBeaconServiceClient) AttestationTargetCache(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.AttestationTargetCacheResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttestationTargetCache", varargs...)
+	ret0, _ := ret[0].(*v10.AttestationTargetCacheResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttestationTargetCache indicates an expected call of AttestationTargetCache
+func (mr *MockBeaconServiceClientMockRecorder) AttestationTargetCache(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestationTargetCache", reflect.TypeOf((*MockBeaconServiceClient)(nil).AttestationTargetCache), varargs...)
+}
+
+// AttestingBalances mocks base method
+func (m *MockBeaconServiceClient) AttestingBalances(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.AttestingBalancesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AttestingBalances", varargs...)
+	ret0, _ := ret[0].(*v10.AttestingBalancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttestingBalances indicates an expected call of AttestingBalances
+func (mr *MockBeaconServiceClientMockRecorder) AttestingBalances(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttestingBalances", reflect.TypeOf((*MockBeaconServiceClient)(nil).AttestingBalances), varargs...)
+}
+
+// BeaconCommittee mocks base method
+func (m *MockBeaconServiceClient) BeaconCommittee(arg0 context.Context, arg1 *v10.BeaconCommitteeRequest, arg2 ...grpc.CallOption) (*v10.BeaconCommitteeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BeaconCommittee", varargs...)
+	ret0, _ := ret[0].(*v10.BeaconCommitteeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BeaconCommittee indicates an expected call of BeaconCommittee
+func (mr *MockBeaconServiceClientMockRecorder) BeaconCommittee(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BeaconCommittee", reflect.TypeOf((*MockBeaconServiceClient)(nil).BeaconCommittee), varargs...)
+}
+
+// BlockAttestations mocks base method
+func (m *MockBeaconServiceClient) BlockAttestations(arg0 context.Context, arg1 *v10.BlockByRootRequest, arg2 ...grpc.CallOption) (*v10.BlockAttestationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockAttestations", varargs...)
+	ret0, _ := ret[0].(*v10.BlockAttestationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockAttestations indicates an expected call of BlockAttestations
+func (mr *MockBeaconServiceClientMockRecorder) BlockAttestations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockAttestations", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlockAttestations), varargs...)
+}
+
+// BlockOperationCounts mocks base method
+func (m *MockBeaconServiceClient) BlockOperationCounts(arg0 context.Context, arg1 *v10.BlockByRootRequest, arg2 ...grpc.CallOption) (*v10.BlockOperationCountsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockOperationCounts", varargs...)
+	ret0, _ := ret[0].(*v10.BlockOperationCountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockOperationCounts indicates an expected call of BlockOperationCounts
+func (mr *MockBeaconServiceClientMockRecorder) BlockOperationCounts(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockOperationCounts", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlockOperationCounts), varargs...)
+}
+
+// BlockStream mocks base method
+func (m *MockBeaconServiceClient) BlockStream(arg0 context.Context, arg1 *v10.BlockStreamRequest, arg2 ...grpc.CallOption) (v10.BeaconService_BlockStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockStream", varargs...)
+	ret0, _ := ret[0].(v10.BeaconService_BlockStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockStream indicates an expected call of BlockStream
+func (mr *MockBeaconServiceClientMockRecorder) BlockStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockStream", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlockStream), varargs...)
+}
+
 // BlockTree mocks base method
-func (m *MockBeaconServiceClient) BlockTree(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.BlockTreeResponse, error) {
+func (m *MockBeaconServiceClient) BlockTree(arg0 context.Context, arg1 *v10.BlockTreeRequest, arg2 ...grpc.CallOption) (*v10.BlockTreeResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{arg0, arg1}
 	for _, a := range arg2 {
@@ -52,178 +232,1127 @@ func (m *MockBeaconServiceClient) BlockTree(arg0 context.Context, arg1 *types.Em
 	return ret0, ret1
 }
 
-// BlockTree indicates an expected call of BlockTree
-func (mr *MockBeaconServiceClientMockRecorder) BlockTree(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// BlockTree indicates an expected call of BlockTree
+func (mr *MockBeaconServiceClientMockRecorder) BlockTree(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTree", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlockTree), varargs...)
+}
+
+// BlockTreeBySlots mocks base method
+func (m *MockBeaconServiceClient) BlockTreeBySlots(arg0 context.Context, arg1 *v10.TreeBlockSlotRequest, arg2 ...grpc.CallOption) (*v10.BlockTreeResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockTreeBySlots", varargs...)
+	ret0, _ := ret[0].(*v10.BlockTreeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockTreeBySlots indicates an expected call of BlockTreeBySlots
+func (mr *MockBeaconServiceClientMockRecorder) BlockTreeBySlots(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTreeBySlots", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlockTreeBySlots), varargs...)
+}
+
+// BlocksBySlot mocks base method
+func (m *MockBeaconServiceClient) BlocksBySlot(arg0 context.Context, arg1 *v10.BlocksBySlotRequest, arg2 ...grpc.CallOption) (*v10.BlocksBySlotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlocksBySlot", varargs...)
+	ret0, _ := ret[0].(*v10.BlocksBySlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlocksBySlot indicates an expected call of BlocksBySlot
+func (mr *MockBeaconServiceClientMockRecorder) BlocksBySlot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlocksBySlot", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlocksBySlot), varargs...)
+}
+
+// CanonicalHead mocks base method
+func (m *MockBeaconServiceClient) CanonicalHead(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.BeaconBlock, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CanonicalHead", varargs...)
+	ret0, _ := ret[0].(*v1.BeaconBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanonicalHead indicates an expected call of CanonicalHead
+func (mr *MockBeaconServiceClientMockRecorder) CanonicalHead(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceClient)(nil).CanonicalHead), varargs...)
+}
+
+// ChurnLimit mocks base method
+func (m *MockBeaconServiceClient) ChurnLimit(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.ChurnLimitResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChurnLimit", varargs...)
+	ret0, _ := ret[0].(*v10.ChurnLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChurnLimit indicates an expected call of ChurnLimit
+func (mr *MockBeaconServiceClientMockRecorder) ChurnLimit(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChurnLimit", reflect.TypeOf((*MockBeaconServiceClient)(nil).ChurnLimit), varargs...)
+}
+
+// Crosslinks mocks base method
+func (m *MockBeaconServiceClient) Crosslinks(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.CrosslinksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Crosslinks", varargs...)
+	ret0, _ := ret[0].(*v10.CrosslinksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Crosslinks indicates an expected call of Crosslinks
+func (mr *MockBeaconServiceClientMockRecorder) Crosslinks(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Crosslinks", reflect.TypeOf((*MockBeaconServiceClient)(nil).Crosslinks), varargs...)
+}
+
+// DepositStatus mocks base method
+func (m *MockBeaconServiceClient) DepositStatus(arg0 context.Context, arg1 *v10.DepositStatusRequest, arg2 ...grpc.CallOption) (*v10.DepositStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DepositStatus", varargs...)
+	ret0, _ := ret[0].(*v10.DepositStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DepositStatus indicates an expected call of DepositStatus
+func (mr *MockBeaconServiceClientMockRecorder) DepositStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DepositStatus", reflect.TypeOf((*MockBeaconServiceClient)(nil).DepositStatus), varargs...)
+}
+
+// DetectedSlashings mocks base method
+func (m *MockBeaconServiceClient) DetectedSlashings(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.DetectedSlashingsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DetectedSlashings", varargs...)
+	ret0, _ := ret[0].(*v10.DetectedSlashingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetectedSlashings indicates an expected call of DetectedSlashings
+func (mr *MockBeaconServiceClientMockRecorder) DetectedSlashings(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetectedSlashings", reflect.TypeOf((*MockBeaconServiceClient)(nil).DetectedSlashings), varargs...)
+}
+
+// EpochAttestationStats mocks base method
+func (m *MockBeaconServiceClient) EpochAttestationStats(arg0 context.Context, arg1 *v10.EpochAttestationStatsRequest, arg2 ...grpc.CallOption) (*v10.EpochAttestationStatsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EpochAttestationStats", varargs...)
+	ret0, _ := ret[0].(*v10.EpochAttestationStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpochAttestationStats indicates an expected call of EpochAttestationStats
+func (mr *MockBeaconServiceClientMockRecorder) EpochAttestationStats(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpochAttestationStats", reflect.TypeOf((*MockBeaconServiceClient)(nil).EpochAttestationStats), varargs...)
+}
+
+// EpochShuffling mocks base method
+func (m *MockBeaconServiceClient) EpochShuffling(arg0 context.Context, arg1 *v10.EpochShufflingRequest, arg2 ...grpc.CallOption) (*v10.EpochShufflingResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EpochShuffling", varargs...)
+	ret0, _ := ret[0].(*v10.EpochShufflingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EpochShuffling indicates an expected call of EpochShuffling
+func (mr *MockBeaconServiceClientMockRecorder) EpochShuffling(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EpochShuffling", reflect.TypeOf((*MockBeaconServiceClient)(nil).EpochShuffling), varargs...)
+}
+
+// Eth1Data mocks base method
+func (m *MockBeaconServiceClient) Eth1Data(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1DataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eth1Data", varargs...)
+	ret0, _ := ret[0].(*v10.Eth1DataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1Data indicates an expected call of Eth1Data
+func (mr *MockBeaconServiceClientMockRecorder) Eth1Data(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1Data), varargs...)
+}
+
+// Eth1DataVotes mocks base method
+func (m *MockBeaconServiceClient) Eth1DataVotes(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1DataVotesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eth1DataVotes", varargs...)
+	ret0, _ := ret[0].(*v10.Eth1DataVotesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1DataVotes indicates an expected call of Eth1DataVotes
+func (mr *MockBeaconServiceClientMockRecorder) Eth1DataVotes(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1DataVotes", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1DataVotes), varargs...)
+}
+
+// Eth1FollowStatus mocks base method
+func (m *MockBeaconServiceClient) Eth1FollowStatus(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1FollowStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eth1FollowStatus", varargs...)
+	ret0, _ := ret[0].(*v10.Eth1FollowStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1FollowStatus indicates an expected call of Eth1FollowStatus
+func (mr *MockBeaconServiceClientMockRecorder) Eth1FollowStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1FollowStatus", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1FollowStatus), varargs...)
+}
+
+// Eth1VoteCandidates mocks base method
+func (m *MockBeaconServiceClient) Eth1VoteCandidates(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1VoteCandidatesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eth1VoteCandidates", varargs...)
+	ret0, _ := ret[0].(*v10.Eth1VoteCandidatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1VoteCandidates indicates an expected call of Eth1VoteCandidates
+func (mr *MockBeaconServiceClientMockRecorder) Eth1VoteCandidates(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1VoteCandidates", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1VoteCandidates), varargs...)
+}
+
+// FinalityDistance mocks base method
+func (m *MockBeaconServiceClient) FinalityDistance(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.FinalityDistanceResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FinalityDistance", varargs...)
+	ret0, _ := ret[0].(*v10.FinalityDistanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinalityDistance indicates an expected call of FinalityDistance
+func (mr *MockBeaconServiceClientMockRecorder) FinalityDistance(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinalityDistance", reflect.TypeOf((*MockBeaconServiceClient)(nil).FinalityDistance), varargs...)
+}
+
+// ForkChoiceStore mocks base method
+func (m *MockBeaconServiceClient) ForkChoiceStore(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.ForkChoiceStoreResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkChoiceStore", varargs...)
+	ret0, _ := ret[0].(*v10.ForkChoiceStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkChoiceStore indicates an expected call of ForkChoiceStore
+func (mr *MockBeaconServiceClientMockRecorder) ForkChoiceStore(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkChoiceStore", reflect.TypeOf((*MockBeaconServiceClient)(nil).ForkChoiceStore), varargs...)
+}
+
+// ForkData mocks base method
+func (m *MockBeaconServiceClient) ForkData(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.Fork, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ForkData", varargs...)
+	ret0, _ := ret[0].(*v1.Fork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ForkData indicates an expected call of ForkData
+func (mr *MockBeaconServiceClientMockRecorder) ForkData(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkData", reflect.TypeOf((*MockBeaconServiceClient)(nil).ForkData), varargs...)
+}
+
+// GenesisDepositRoot mocks base method
+func (m *MockBeaconServiceClient) GenesisDepositRoot(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.GenesisDepositRootResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenesisDepositRoot", varargs...)
+	ret0, _ := ret[0].(*v10.GenesisDepositRootResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenesisDepositRoot indicates an expected call of GenesisDepositRoot
+func (mr *MockBeaconServiceClientMockRecorder) GenesisDepositRoot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenesisDepositRoot", reflect.TypeOf((*MockBeaconServiceClient)(nil).GenesisDepositRoot), varargs...)
+}
+
+// GenesisValidators mocks base method
+func (m *MockBeaconServiceClient) GenesisValidators(arg0 context.Context, arg1 *v10.GenesisValidatorsRequest, arg2 ...grpc.CallOption) (*v10.GenesisValidatorsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GenesisValidators", varargs...)
+	ret0, _ := ret[0].(*v10.GenesisValidatorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenesisValidators indicates an expected call of GenesisValidators
+func (mr *MockBeaconServiceClientMockRecorder) GenesisValidators(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenesisValidators", reflect.TypeOf((*MockBeaconServiceClient)(nil).GenesisValidators), varargs...)
+}
+
+// HistoricalBlockRoots mocks base method
+func (m *MockBeaconServiceClient) HistoricalBlockRoots(arg0 context.Context, arg1 *v10.HistoricalBlockRootsRequest, arg2 ...grpc.CallOption) (*v10.HistoricalBlockRootsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HistoricalBlockRoots", varargs...)
+	ret0, _ := ret[0].(*v10.HistoricalBlockRootsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HistoricalBlockRoots indicates an expected call of HistoricalBlockRoots
+func (mr *MockBeaconServiceClientMockRecorder) HistoricalBlockRoots(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoricalBlockRoots", reflect.TypeOf((*MockBeaconServiceClient)(nil).HistoricalBlockRoots), varargs...)
+}
+
+// JustifiedCheckpointHistory mocks base method
+func (m *MockBeaconServiceClient) JustifiedCheckpointHistory(arg0 context.Context, arg1 *v10.JustifiedHistoryRequest, arg2 ...grpc.CallOption) (*v10.JustifiedHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "JustifiedCheckpointHistory", varargs...)
+	ret0, _ := ret[0].(*v10.JustifiedHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// JustifiedCheckpointHistory indicates an expected call of JustifiedCheckpointHistory
+func (mr *MockBeaconServiceClientMockRecorder) JustifiedCheckpointHistory(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JustifiedCheckpointHistory", reflect.TypeOf((*MockBeaconServiceClient)(nil).JustifiedCheckpointHistory), varargs...)
+}
+
+// LastFinalizedSlot mocks base method
+func (m *MockBeaconServiceClient) LastFinalizedSlot(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.LastFinalizedSlotResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LastFinalizedSlot", varargs...)
+	ret0, _ := ret[0].(*v10.LastFinalizedSlotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastFinalizedSlot indicates an expected call of LastFinalizedSlot
+func (mr *MockBeaconServiceClientMockRecorder) LastFinalizedSlot(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastFinalizedSlot", reflect.TypeOf((*MockBeaconServiceClient)(nil).LastFinalizedSlot), varargs...)
+}
+
+// LatestAttestation mocks base method
+func (m *MockBeaconServiceClient) LatestAttestation(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_LatestAttestationClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "LatestAttestation", varargs...)
+	ret0, _ := ret[0].(v10.BeaconService_LatestAttestationClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LatestAttestation indicates an expected call of LatestAttestation
+func (mr *MockBeaconServiceClientMockRecorder) LatestAttestation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAttestation", reflect.TypeOf((*MockBeaconServiceClient)(nil).LatestAttestation), varargs...)
+}
+
+// MissedAttesters mocks base method
+func (m *MockBeaconServiceClient) MissedAttesters(arg0 context.Context, arg1 *v10.MissedAttestersRequest, arg2 ...grpc.CallOption) (*v10.MissedAttestersResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MissedAttesters", varargs...)
+	ret0, _ := ret[0].(*v10.MissedAttestersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MissedAttesters indicates an expected call of MissedAttesters
+func (mr *MockBeaconServiceClientMockRecorder) MissedAttesters(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MissedAttesters", reflect.TypeOf((*MockBeaconServiceClient)(nil).MissedAttesters), varargs...)
+}
+
+// NextEth1VotingPeriod mocks base method
+func (m *MockBeaconServiceClient) NextEth1VotingPeriod(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1VotingPeriodResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NextEth1VotingPeriod", varargs...)
+	ret0, _ := ret[0].(*v10.Eth1VotingPeriodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextEth1VotingPeriod indicates an expected call of NextEth1VotingPeriod
+func (mr *MockBeaconServiceClientMockRecorder) NextEth1VotingPeriod(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextEth1VotingPeriod", reflect.TypeOf((*MockBeaconServiceClient)(nil).NextEth1VotingPeriod), varargs...)
+}
+
+// PendingDepositCount mocks base method
+func (m *MockBeaconServiceClient) PendingDepositCount(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.PendingDepositCountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingDepositCount", varargs...)
+	ret0, _ := ret[0].(*v10.PendingDepositCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingDepositCount indicates an expected call of PendingDepositCount
+func (mr *MockBeaconServiceClientMockRecorder) PendingDepositCount(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDepositCount", reflect.TypeOf((*MockBeaconServiceClient)(nil).PendingDepositCount), varargs...)
+}
+
+// PendingDeposits mocks base method
+func (m *MockBeaconServiceClient) PendingDeposits(arg0 context.Context, arg1 *v10.PendingDepositsRequest, arg2 ...grpc.CallOption) (*v10.PendingDepositsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PendingDeposits", varargs...)
+	ret0, _ := ret[0].(*v10.PendingDepositsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PendingDeposits indicates an expected call of PendingDeposits
+func (mr *MockBeaconServiceClientMockRecorder) PendingDeposits(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceClient)(nil).PendingDeposits), varargs...)
+}
+
+// ProposedBlock mocks base method
+func (m *MockBeaconServiceClient) ProposedBlock(arg0 context.Context, arg1 *v10.ProposedBlockRequest, arg2 ...grpc.CallOption) (*v10.ProposedBlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProposedBlock", varargs...)
+	ret0, _ := ret[0].(*v10.ProposedBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposedBlock indicates an expected call of ProposedBlock
+func (mr *MockBeaconServiceClientMockRecorder) ProposedBlock(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposedBlock", reflect.TypeOf((*MockBeaconServiceClient)(nil).ProposedBlock), varargs...)
+}
+
+// ProposerReward mocks base method
+func (m *MockBeaconServiceClient) ProposerReward(arg0 context.Context, arg1 *v10.BlockByRootRequest, arg2 ...grpc.CallOption) (*v10.ProposerRewardResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ProposerReward", varargs...)
+	ret0, _ := ret[0].(*v10.ProposerRewardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProposerReward indicates an expected call of ProposerReward
+func (mr *MockBeaconServiceClientMockRecorder) ProposerReward(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProposerReward", reflect.TypeOf((*MockBeaconServiceClient)(nil).ProposerReward), varargs...)
+}
+
+// SkippedSlots mocks base method
+func (m *MockBeaconServiceClient) SkippedSlots(arg0 context.Context, arg1 *v10.SkippedSlotsRequest, arg2 ...grpc.CallOption) (*v10.SkippedSlotsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SkippedSlots", varargs...)
+	ret0, _ := ret[0].(*v10.SkippedSlotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SkippedSlots indicates an expected call of SkippedSlots
+func (mr *MockBeaconServiceClientMockRecorder) SkippedSlots(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkippedSlots", reflect.TypeOf((*MockBeaconServiceClient)(nil).SkippedSlots), varargs...)
+}
+
+// SlotAttestationCoverage mocks base method
+func (m *MockBeaconServiceClient) SlotAttestationCoverage(arg0 context.Context, arg1 *v10.SlotCoverageRequest, arg2 ...grpc.CallOption) (*v10.SlotCoverageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SlotAttestationCoverage", varargs...)
+	ret0, _ := ret[0].(*v10.SlotCoverageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlotAttestationCoverage indicates an expected call of SlotAttestationCoverage
+func (mr *MockBeaconServiceClientMockRecorder) SlotAttestationCoverage(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlotAttestationCoverage", reflect.TypeOf((*MockBeaconServiceClient)(nil).SlotAttestationCoverage), varargs...)
+}
+
+// SlotTickStream mocks base method
+func (m *MockBeaconServiceClient) SlotTickStream(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_SlotTickStreamClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SlotTickStream", varargs...)
+	ret0, _ := ret[0].(v10.BeaconService_SlotTickStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlotTickStream indicates an expected call of SlotTickStream
+func (mr *MockBeaconServiceClientMockRecorder) SlotTickStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlotTickStream", reflect.TypeOf((*MockBeaconServiceClient)(nil).SlotTickStream), varargs...)
+}
+
+// StateSchemaInfo mocks base method
+func (m *MockBeaconServiceClient) StateSchemaInfo(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.StateSchemaInfoResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StateSchemaInfo", varargs...)
+	ret0, _ := ret[0].(*v10.StateSchemaInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateSchemaInfo indicates an expected call of StateSchemaInfo
+func (mr *MockBeaconServiceClientMockRecorder) StateSchemaInfo(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateSchemaInfo", reflect.TypeOf((*MockBeaconServiceClient)(nil).StateSchemaInfo), varargs...)
+}
+
+// SyncStatus mocks base method
+func (m *MockBeaconServiceClient) SyncStatus(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.SyncStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncStatus", varargs...)
+	ret0, _ := ret[0].(*v10.SyncStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStatus indicates an expected call of SyncStatus
+func (mr *MockBeaconServiceClientMockRecorder) SyncStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockBeaconServiceClient)(nil).SyncStatus), varargs...)
+}
+
+// TotalDeposited mocks base method
+func (m *MockBeaconServiceClient) TotalDeposited(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.TotalDepositedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "TotalDeposited", varargs...)
+	ret0, _ := ret[0].(*v10.TotalDepositedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalDeposited indicates an expected call of TotalDeposited
+func (mr *MockBeaconServiceClientMockRecorder) TotalDeposited(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalDeposited", reflect.TypeOf((*MockBeaconServiceClient)(nil).TotalDeposited), varargs...)
+}
+
+// UpcomingActivations mocks base method
+func (m *MockBeaconServiceClient) UpcomingActivations(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.UpcomingActivationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpcomingActivations", varargs...)
+	ret0, _ := ret[0].(*v10.UpcomingActivationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpcomingActivations indicates an expected call of UpcomingActivations
+func (mr *MockBeaconServiceClientMockRecorder) UpcomingActivations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpcomingActivations", reflect.TypeOf((*MockBeaconServiceClient)(nil).UpcomingActivations), varargs...)
+}
+
+// WaitForChainStart mocks base method
+func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_WaitForChainStartClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WaitForChainStart", varargs...)
+	ret0, _ := ret[0].(v10.BeaconService_WaitForChainStartClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForChainStart indicates an expected call of WaitForChainStart
+func (mr *MockBeaconServiceClientMockRecorder) WaitForChainStart(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForChainStart", reflect.TypeOf((*MockBeaconServiceClient)(nil).WaitForChainStart), varargs...)
+}
+
+// MockBeaconService_LatestAttestationClient is a mock of BeaconService_LatestAttestationClient interface
+type MockBeaconService_LatestAttestationClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_LatestAttestationClientMockRecorder
+}
+
+// MockBeaconService_LatestAttestationClientMockRecorder is the mock recorder for MockBeaconService_LatestAttestationClient
+type MockBeaconService_LatestAttestationClientMockRecorder struct {
+	mock *MockBeaconService_LatestAttestationClient
+}
+
+// NewMockBeaconService_LatestAttestationClient creates a new mock instance
+func NewMockBeaconService_LatestAttestationClient(ctrl *gomock.Controller) *MockBeaconService_LatestAttestationClient {
+	mock := &MockBeaconService_LatestAttestationClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_LatestAttestationClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_LatestAttestationClient) EXPECT() *MockBeaconService_LatestAttestationClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockBeaconService_LatestAttestationClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockBeaconService_LatestAttestationClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockBeaconService_LatestAttestationClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockBeaconService_LatestAttestationClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Header))
+}
+
+// Recv mocks base method
+func (m *MockBeaconService_LatestAttestationClient) Recv() (*v1.Attestation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v1.Attestation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method
+func (m *MockBeaconService_LatestAttestationClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_LatestAttestationClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconService_LatestAttestationClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_LatestAttestationClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockBeaconService_LatestAttestationClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Trailer))
+}
+
+// MockBeaconService_WaitForChainStartClient is a mock of BeaconService_WaitForChainStartClient interface
+type MockBeaconService_WaitForChainStartClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_WaitForChainStartClientMockRecorder
+}
+
+// MockBeaconService_WaitForChainStartClientMockRecorder is the mock recorder for MockBeaconService_WaitForChainStartClient
+type MockBeaconService_WaitForChainStartClientMockRecorder struct {
+	mock *MockBeaconService_WaitForChainStartClient
+}
+
+// NewMockBeaconService_WaitForChainStartClient creates a new mock instance
+func NewMockBeaconService_WaitForChainStartClient(ctrl *gomock.Controller) *MockBeaconService_WaitForChainStartClient {
+	mock := &MockBeaconService_WaitForChainStartClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_WaitForChainStartClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_WaitForChainStartClient) EXPECT() *MockBeaconService_WaitForChainStartClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockBeaconService_WaitForChainStartClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).CloseSend))
+}
+
+// Context mocks base method
+func (m *MockBeaconService_WaitForChainStartClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Context))
+}
+
+// Header mocks base method
+func (m *MockBeaconService_WaitForChainStartClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header
+func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Header))
+}
+
+// Recv mocks base method
+func (m *MockBeaconService_WaitForChainStartClient) Recv() (*v10.ChainStartResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v10.ChainStartResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv
+func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTree", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlockTree), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Recv))
 }
 
-// BlockTreeBySlots mocks base method
-func (m *MockBeaconServiceClient) BlockTreeBySlots(arg0 context.Context, arg1 *v10.TreeBlockSlotRequest, arg2 ...grpc.CallOption) (*v10.BlockTreeResponse, error) {
+// RecvMsg mocks base method
+func (m *MockBeaconService_WaitForChainStartClient) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "BlockTreeBySlots", varargs...)
-	ret0, _ := ret[0].(*v10.BlockTreeResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// BlockTreeBySlots indicates an expected call of BlockTreeBySlots
-func (mr *MockBeaconServiceClientMockRecorder) BlockTreeBySlots(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockTreeBySlots", reflect.TypeOf((*MockBeaconServiceClient)(nil).BlockTreeBySlots), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).RecvMsg), arg0)
 }
 
-// CanonicalHead mocks base method
-func (m *MockBeaconServiceClient) CanonicalHead(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.BeaconBlock, error) {
+// SendMsg mocks base method
+func (m *MockBeaconService_WaitForChainStartClient) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "CanonicalHead", varargs...)
-	ret0, _ := ret[0].(*v1.BeaconBlock)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// CanonicalHead indicates an expected call of CanonicalHead
-func (mr *MockBeaconServiceClientMockRecorder) CanonicalHead(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceClient)(nil).CanonicalHead), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).SendMsg), arg0)
 }
 
-// Eth1Data mocks base method
-func (m *MockBeaconServiceClient) Eth1Data(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.Eth1DataResponse, error) {
+// Trailer mocks base method
+func (m *MockBeaconService_WaitForChainStartClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Eth1Data", varargs...)
-	ret0, _ := ret[0].(*v10.Eth1DataResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
 }
 
-// Eth1Data indicates an expected call of Eth1Data
-func (mr *MockBeaconServiceClientMockRecorder) Eth1Data(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Trailer indicates an expected call of Trailer
+func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1Data", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1Data), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Trailer))
 }
 
-// ForkData mocks base method
-func (m *MockBeaconServiceClient) ForkData(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.Fork, error) {
+// MockBeaconService_BlockStreamClient is a mock of BeaconService_BlockStreamClient interface
+type MockBeaconService_BlockStreamClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconService_BlockStreamClientMockRecorder
+}
+
+// MockBeaconService_BlockStreamClientMockRecorder is the mock recorder for MockBeaconService_BlockStreamClient
+type MockBeaconService_BlockStreamClientMockRecorder struct {
+	mock *MockBeaconService_BlockStreamClient
+}
+
+// NewMockBeaconService_BlockStreamClient creates a new mock instance
+func NewMockBeaconService_BlockStreamClient(ctrl *gomock.Controller) *MockBeaconService_BlockStreamClient {
+	mock := &MockBeaconService_BlockStreamClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_BlockStreamClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockBeaconService_BlockStreamClient) EXPECT() *MockBeaconService_BlockStreamClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method
+func (m *MockBeaconService_BlockStreamClient) CloseSend() error {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "ForkData", varargs...)
-	ret0, _ := ret[0].(*v1.Fork)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ForkData indicates an expected call of ForkData
-func (mr *MockBeaconServiceClientMockRecorder) ForkData(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// CloseSend indicates an expected call of CloseSend
+func (mr *MockBeaconService_BlockStreamClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkData", reflect.TypeOf((*MockBeaconServiceClient)(nil).ForkData), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_BlockStreamClient)(nil).CloseSend))
 }
 
-// LatestAttestation mocks base method
-func (m *MockBeaconServiceClient) LatestAttestation(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_LatestAttestationClient, error) {
+// Context mocks base method
+func (m *MockBeaconService_BlockStreamClient) Context() context.Context {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "LatestAttestation", varargs...)
-	ret0, _ := ret[0].(v10.BeaconService_LatestAttestationClient)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
 }
 
-// LatestAttestation indicates an expected call of LatestAttestation
-func (mr *MockBeaconServiceClientMockRecorder) LatestAttestation(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Context indicates an expected call of Context
+func (mr *MockBeaconService_BlockStreamClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LatestAttestation", reflect.TypeOf((*MockBeaconServiceClient)(nil).LatestAttestation), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_BlockStreamClient)(nil).Context))
 }
 
-// PendingDeposits mocks base method
-func (m *MockBeaconServiceClient) PendingDeposits(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.PendingDepositsResponse, error) {
+// Header mocks base method
+func (m *MockBeaconService_BlockStreamClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "PendingDeposits", varargs...)
-	ret0, _ := ret[0].(*v10.PendingDepositsResponse)
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// PendingDeposits indicates an expected call of PendingDeposits
-func (mr *MockBeaconServiceClientMockRecorder) PendingDeposits(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Header indicates an expected call of Header
+func (mr *MockBeaconService_BlockStreamClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PendingDeposits", reflect.TypeOf((*MockBeaconServiceClient)(nil).PendingDeposits), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_BlockStreamClient)(nil).Header))
 }
 
-// WaitForChainStart mocks base method
-func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v10.BeaconService_WaitForChainStartClient, error) {
+// Recv mocks base method
+func (m *MockBeaconService_BlockStreamClient) Recv() (*v1.BeaconBlock, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0, arg1}
-	for _, a := range arg2 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "WaitForChainStart", varargs...)
-	ret0, _ := ret[0].(v10.BeaconService_WaitForChainStartClient)
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v1.BeaconBlock)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// WaitForChainStart indicates an expected call of WaitForChainStart
-func (mr *MockBeaconServiceClientMockRecorder) WaitForChainStart(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv
+func (mr *MockBeaconService_BlockStreamClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0, arg1}, arg2...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForChainStart", reflect.TypeOf((*MockBeaconServiceClient)(nil).WaitForChainStart), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_BlockStreamClient)(nil).Recv))
 }
 
-// MockBeaconService_LatestAttestationClient is a mock of BeaconService_LatestAttestationClient interface
-type MockBeaconService_LatestAttestationClient struct {
+// RecvMsg mocks base method
+func (m *MockBeaconService_BlockStreamClient) RecvMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecvMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg
+func (mr *MockBeaconService_BlockStreamClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_BlockStreamClient)(nil).RecvMsg), arg0)
+}
+
+// SendMsg mocks base method
+func (m *MockBeaconService_BlockStreamClient) SendMsg(arg0 interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMsg", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg
+func (mr *MockBeaconService_BlockStreamClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_BlockStreamClient)(nil).SendMsg), arg0)
+}
+
+// Trailer mocks base method
+func (m *MockBeaconService_BlockStreamClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer
+func (mr *MockBeaconService_BlockStreamClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_BlockStreamClient)(nil).Trailer))
+}
+
+// MockBeaconService_SlotTickStreamClient is a mock of BeaconService_SlotTickStreamClient interface
+type MockBeaconService_SlotTickStreamClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockBeaconService_LatestAttestationClientMockRecorder
+	recorder *MockBeaconService_SlotTickStreamClientMockRecorder
 }
 
-// MockBeaconService_LatestAttestationClientMockRecorder is the mock recorder for MockBeaconService_LatestAttestationClient
-type MockBeaconService_LatestAttestationClientMockRecorder struct {
-	mock *MockBeaconService_LatestAttestationClient
+// MockBeaconService_SlotTickStreamClientMockRecorder is the mock recorder for MockBeaconService_SlotTickStreamClient
+type MockBeaconService_SlotTickStreamClientMockRecorder struct {
+	mock *MockBeaconService_SlotTickStreamClient
 }
 
-// NewMockBeaconService_LatestAttestationClient creates a new mock instance
-func NewMockBeaconService_LatestAttestationClient(ctrl *gomock.Controller) *MockBeaconService_LatestAttestationClient {
-	mock := &MockBeaconService_LatestAttestationClient{ctrl: ctrl}
-	mock.recorder = &MockBeaconService_LatestAttestationClientMockRecorder{mock}
+// NewMockBeaconService_SlotTickStreamClient creates a new mock instance
+func NewMockBeaconService_SlotTickStreamClient(ctrl *gomock.Controller) *MockBeaconService_SlotTickStreamClient {
+	mock := &MockBeaconService_SlotTickStreamClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_SlotTickStreamClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockBeaconService_LatestAttestationClient) EXPECT() *MockBeaconService_LatestAttestationClientMockRecorder {
+func (m *MockBeaconService_SlotTickStreamClient) EXPECT() *MockBeaconService_SlotTickStreamClientMockRecorder {
 	return m.recorder
 }
 
 // CloseSend mocks base method
-func (m *MockBeaconService_LatestAttestationClient) CloseSend() error {
+func (m *MockBeaconService_SlotTickStreamClient) CloseSend() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloseSend")
 	ret0, _ := ret[0].(error)
@@ -231,13 +1360,13 @@ func (m *MockBeaconService_LatestAttestationClient) CloseSend() error {
 }
 
 // CloseSend indicates an expected call of CloseSend
-func (mr *MockBeaconService_LatestAttestationClientMockRecorder) CloseSend() *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).CloseSend))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_SlotTickStreamClient)(nil).CloseSend))
 }
 
 // Context mocks base method
-func (m *MockBeaconService_LatestAttestationClient) Context() context.Context {
+func (m *MockBeaconService_SlotTickStreamClient) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -245,13 +1374,13 @@ func (m *MockBeaconService_LatestAttestationClient) Context() context.Context {
 }
 
 // Context indicates an expected call of Context
-func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Context() *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_SlotTickStreamClient)(nil).Context))
 }
 
 // Header mocks base method
-func (m *MockBeaconService_LatestAttestationClient) Header() (metadata.MD, error) {
+func (m *MockBeaconService_SlotTickStreamClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Header")
 	ret0, _ := ret[0].(metadata.MD)
@@ -260,28 +1389,28 @@ func (m *MockBeaconService_LatestAttestationClient) Header() (metadata.MD, error
 }
 
 // Header indicates an expected call of Header
-func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Header() *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Header))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_SlotTickStreamClient)(nil).Header))
 }
 
 // Recv mocks base method
-func (m *MockBeaconService_LatestAttestationClient) Recv() (*v1.Attestation, error) {
+func (m *MockBeaconService_SlotTickStreamClient) Recv() (*v10.SlotTick, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*v1.Attestation)
+	ret0, _ := ret[0].(*v10.SlotTick)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Recv indicates an expected call of Recv
-func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Recv() *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_SlotTickStreamClient)(nil).Recv))
 }
 
 // RecvMsg mocks base method
-func (m *MockBeaconService_LatestAttestationClient) RecvMsg(arg0 interface{}) error {
+func (m *MockBeaconService_SlotTickStreamClient) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -289,13 +1418,13 @@ func (m *MockBeaconService_LatestAttestationClient) RecvMsg(arg0 interface{}) er
 }
 
 // RecvMsg indicates an expected call of RecvMsg
-func (mr *MockBeaconService_LatestAttestationClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).RecvMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_SlotTickStreamClient)(nil).RecvMsg), arg0)
 }
 
 // SendMsg mocks base method
-func (m *MockBeaconService_LatestAttestationClient) SendMsg(arg0 interface{}) error {
+func (m *MockBeaconService_SlotTickStreamClient) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -303,13 +1432,13 @@ func (m *MockBeaconService_LatestAttestationClient) SendMsg(arg0 interface{}) er
 }
 
 // SendMsg indicates an expected call of SendMsg
-func (mr *MockBeaconService_LatestAttestationClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).SendMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_SlotTickStreamClient)(nil).SendMsg), arg0)
 }
 
 // Trailer mocks base method
-func (m *MockBeaconService_LatestAttestationClient) Trailer() metadata.MD {
+func (m *MockBeaconService_SlotTickStreamClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Trailer")
 	ret0, _ := ret[0].(metadata.MD)
@@ -317,36 +1446,36 @@ func (m *MockBeaconService_LatestAttestationClient) Trailer() metadata.MD {
 }
 
 // Trailer indicates an expected call of Trailer
-func (mr *MockBeaconService_LatestAttestationClientMockRecorder) Trailer() *gomock.Call {
+func (mr *MockBeaconService_SlotTickStreamClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_LatestAttestationClient)(nil).Trailer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_SlotTickStreamClient)(nil).Trailer))
 }
 
-// MockBeaconService_WaitForChainStartClient is a mock of BeaconService_WaitForChainStartClient interface
-type MockBeaconService_WaitForChainStartClient struct {
+// MockBeaconService_AggregateAttestationStreamClient is a mock of BeaconService_AggregateAttestationStreamClient interface
+type MockBeaconService_AggregateAttestationStreamClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockBeaconService_WaitForChainStartClientMockRecorder
+	recorder *MockBeaconService_AggregateAttestationStreamClientMockRecorder
 }
 
-// MockBeaconService_WaitForChainStartClientMockRecorder is the mock recorder for MockBeaconService_WaitForChainStartClient
-type MockBeaconService_WaitForChainStartClientMockRecorder struct {
-	mock *MockBeaconService_WaitForChainStartClient
+// MockBeaconService_AggregateAttestationStreamClientMockRecorder is the mock recorder for MockBeaconService_AggregateAttestationStreamClient
+type MockBeaconService_AggregateAttestationStreamClientMockRecorder struct {
+	mock *MockBeaconService_AggregateAttestationStreamClient
 }
 
-// NewMockBeaconService_WaitForChainStartClient creates a new mock instance
-func NewMockBeaconService_WaitForChainStartClient(ctrl *gomock.Controller) *MockBeaconService_WaitForChainStartClient {
-	mock := &MockBeaconService_WaitForChainStartClient{ctrl: ctrl}
-	mock.recorder = &MockBeaconService_WaitForChainStartClientMockRecorder{mock}
+// NewMockBeaconService_AggregateAttestationStreamClient creates a new mock instance
+func NewMockBeaconService_AggregateAttestationStreamClient(ctrl *gomock.Controller) *MockBeaconService_AggregateAttestationStreamClient {
+	mock := &MockBeaconService_AggregateAttestationStreamClient{ctrl: ctrl}
+	mock.recorder = &MockBeaconService_AggregateAttestationStreamClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use
-func (m *MockBeaconService_WaitForChainStartClient) EXPECT() *MockBeaconService_WaitForChainStartClientMockRecorder {
+func (m *MockBeaconService_AggregateAttestationStreamClient) EXPECT() *MockBeaconService_AggregateAttestationStreamClientMockRecorder {
 	return m.recorder
 }
 
 // CloseSend mocks base method
-func (m *MockBeaconService_WaitForChainStartClient) CloseSend() error {
+func (m *MockBeaconService_AggregateAttestationStreamClient) CloseSend() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloseSend")
 	ret0, _ := ret[0].(error)
@@ -354,13 +1483,13 @@ func (m *MockBeaconService_WaitForChainStartClient) CloseSend() error {
 }
 
 // CloseSend indicates an expected call of CloseSend
-func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) CloseSend() *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).CloseSend))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamClient)(nil).CloseSend))
 }
 
 // Context mocks base method
-func (m *MockBeaconService_WaitForChainStartClient) Context() context.Context {
+func (m *MockBeaconService_AggregateAttestationStreamClient) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -368,13 +1497,13 @@ func (m *MockBeaconService_WaitForChainStartClient) Context() context.Context {
 }
 
 // Context indicates an expected call of Context
-func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Context() *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamClient)(nil).Context))
 }
 
 // Header mocks base method
-func (m *MockBeaconService_WaitForChainStartClient) Header() (metadata.MD, error) {
+func (m *MockBeaconService_AggregateAttestationStreamClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Header")
 	ret0, _ := ret[0].(metadata.MD)
@@ -383,28 +1512,28 @@ func (m *MockBeaconService_WaitForChainStartClient) Header() (metadata.MD, error
 }
 
 // Header indicates an expected call of Header
-func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Header() *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Header))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamClient)(nil).Header))
 }
 
 // Recv mocks base method
-func (m *MockBeaconService_WaitForChainStartClient) Recv() (*v10.ChainStartResponse, error) {
+func (m *MockBeaconService_AggregateAttestationStreamClient) Recv() (*v1.Attestation, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*v10.ChainStartResponse)
+	ret0, _ := ret[0].(*v1.Attestation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Recv indicates an expected call of Recv
-func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Recv() *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamClient)(nil).Recv))
 }
 
 // RecvMsg mocks base method
-func (m *MockBeaconService_WaitForChainStartClient) RecvMsg(arg0 interface{}) error {
+func (m *MockBeaconService_AggregateAttestationStreamClient) RecvMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RecvMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -412,13 +1541,13 @@ func (m *MockBeaconService_WaitForChainStartClient) RecvMsg(arg0 interface{}) er
 }
 
 // RecvMsg indicates an expected call of RecvMsg
-func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamClientMockRecorder) RecvMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).RecvMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamClient)(nil).RecvMsg), arg0)
 }
 
 // SendMsg mocks base method
-func (m *MockBeaconService_WaitForChainStartClient) SendMsg(arg0 interface{}) error {
+func (m *MockBeaconService_AggregateAttestationStreamClient) SendMsg(arg0 interface{}) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendMsg", arg0)
 	ret0, _ := ret[0].(error)
@@ -426,13 +1555,13 @@ func (m *MockBeaconService_WaitForChainStartClient) SendMsg(arg0 interface{}) er
 }
 
 // SendMsg indicates an expected call of SendMsg
-func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamClientMockRecorder) SendMsg(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).SendMsg), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamClient)(nil).SendMsg), arg0)
 }
 
 // Trailer mocks base method
-func (m *MockBeaconService_WaitForChainStartClient) Trailer() metadata.MD {
+func (m *MockBeaconService_AggregateAttestationStreamClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Trailer")
 	ret0, _ := ret[0].(metadata.MD)
@@ -440,7 +1569,7 @@ func (m *MockBeaconService_WaitForChainStartClient) Trailer() metadata.MD {
 }
 
 // Trailer indicates an expected call of Trailer
-func (mr *MockBeaconService_WaitForChainStartClientMockRecorder) Trailer() *gomock.Call {
+func (mr *MockBeaconService_AggregateAttestationStreamClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_WaitForChainStartClient)(nil).Trailer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockBeaconService_AggregateAttestationStreamClient)(nil).Trailer))
 }
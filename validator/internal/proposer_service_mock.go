@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	types "github.com/gogo/protobuf/types"
 	gomock "github.com/golang/mock/gomock"
 	v1 "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	v10 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
@@ -57,6 +58,26 @@ func (mr *MockProposerServiceClientMockRecorder) ComputeStateRoot(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ComputeStateRoot", reflect.TypeOf((*MockProposerServiceClient)(nil).ComputeStateRoot), varargs...)
 }
 
+// CurrentProposer mocks base method
+func (m *MockProposerServiceClient) CurrentProposer(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v10.CurrentProposerResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CurrentProposer", varargs...)
+	ret0, _ := ret[0].(*v10.CurrentProposerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CurrentProposer indicates an expected call of CurrentProposer
+func (mr *MockProposerServiceClientMockRecorder) CurrentProposer(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CurrentProposer", reflect.TypeOf((*MockProposerServiceClient)(nil).CurrentProposer), varargs...)
+}
+
 // PendingAttestations mocks base method
 func (m *MockProposerServiceClient) PendingAttestations(arg0 context.Context, arg1 *v10.PendingAttestationsRequest, arg2 ...grpc.CallOption) (*v10.PendingAttestationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -37,6 +37,26 @@ func (m *MockValidatorServiceClient) EXPECT() *MockValidatorServiceClientMockRec
 	return m.recorder
 }
 
+// AggregatePublicKey mocks base method
+func (m *MockValidatorServiceClient) AggregatePublicKey(arg0 context.Context, arg1 *v1.AggregatePublicKeyRequest, arg2 ...grpc.CallOption) (*v1.AggregatePublicKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AggregatePublicKey", varargs...)
+	ret0, _ := ret[0].(*v1.AggregatePublicKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AggregatePublicKey indicates an expected call of AggregatePublicKey
+func (mr *MockValidatorServiceClientMockRecorder) AggregatePublicKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AggregatePublicKey", reflect.TypeOf((*MockValidatorServiceClient)(nil).AggregatePublicKey), varargs...)
+}
+
 // CommitteeAssignment mocks base method
 func (m *MockValidatorServiceClient) CommitteeAssignment(arg0 context.Context, arg1 *v1.CommitteeAssignmentsRequest, arg2 ...grpc.CallOption) (*v1.CommitteeAssignmentResponse, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +97,126 @@ func (mr *MockValidatorServiceClientMockRecorder) ExitedValidators(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExitedValidators", reflect.TypeOf((*MockValidatorServiceClient)(nil).ExitedValidators), varargs...)
 }
 
+// ValidatePubkey mocks base method
+func (m *MockValidatorServiceClient) ValidatePubkey(arg0 context.Context, arg1 *v1.ValidatePubkeyRequest, arg2 ...grpc.CallOption) (*v1.ValidatePubkeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatePubkey", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatePubkeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatePubkey indicates an expected call of ValidatePubkey
+func (mr *MockValidatorServiceClientMockRecorder) ValidatePubkey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatePubkey", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatePubkey), varargs...)
+}
+
+// ValidatorAttestations mocks base method
+func (m *MockValidatorServiceClient) ValidatorAttestations(arg0 context.Context, arg1 *v1.ValidatorAttestationsRequest, arg2 ...grpc.CallOption) (*v1.ValidatorAttestationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorAttestations", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatorAttestationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorAttestations indicates an expected call of ValidatorAttestations
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorAttestations(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorAttestations", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorAttestations), varargs...)
+}
+
+// ValidatorAttested mocks base method
+func (m *MockValidatorServiceClient) ValidatorAttested(arg0 context.Context, arg1 *v1.ValidatorAttestedRequest, arg2 ...grpc.CallOption) (*v1.ValidatorAttestedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorAttested", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatorAttestedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorAttested indicates an expected call of ValidatorAttested
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorAttested(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorAttested", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorAttested), varargs...)
+}
+
+// ValidatorBalanceDelta mocks base method
+func (m *MockValidatorServiceClient) ValidatorBalanceDelta(arg0 context.Context, arg1 *v1.ValidatorBalanceDeltaRequest, arg2 ...grpc.CallOption) (*v1.ValidatorBalanceDeltaResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorBalanceDelta", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatorBalanceDeltaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorBalanceDelta indicates an expected call of ValidatorBalanceDelta
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorBalanceDelta(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorBalanceDelta", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorBalanceDelta), varargs...)
+}
+
+// ValidatorBalances mocks base method
+func (m *MockValidatorServiceClient) ValidatorBalances(arg0 context.Context, arg1 *v1.ValidatorBalancesRequest, arg2 ...grpc.CallOption) (*v1.ValidatorBalancesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorBalances", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatorBalancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorBalances indicates an expected call of ValidatorBalances
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorBalances(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorBalances", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorBalances), varargs...)
+}
+
+// ValidatorDuties mocks base method
+func (m *MockValidatorServiceClient) ValidatorDuties(arg0 context.Context, arg1 *v1.ValidatorDutiesRequest, arg2 ...grpc.CallOption) (*v1.ValidatorDutiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorDuties", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatorDutiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorDuties indicates an expected call of ValidatorDuties
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorDuties(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorDuties", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorDuties), varargs...)
+}
+
 // ValidatorIndex mocks base method
 func (m *MockValidatorServiceClient) ValidatorIndex(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorIndexResponse, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +257,26 @@ func (mr *MockValidatorServiceClientMockRecorder) ValidatorPerformance(arg0, arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformance", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorPerformance), varargs...)
 }
 
+// ValidatorPerformanceSummary mocks base method
+func (m *MockValidatorServiceClient) ValidatorPerformanceSummary(arg0 context.Context, arg1 *v1.ValidatorPerformanceSummaryRequest, arg2 ...grpc.CallOption) (*v1.ValidatorPerformanceSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ValidatorPerformanceSummary", varargs...)
+	ret0, _ := ret[0].(*v1.ValidatorPerformanceSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidatorPerformanceSummary indicates an expected call of ValidatorPerformanceSummary
+func (mr *MockValidatorServiceClientMockRecorder) ValidatorPerformanceSummary(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidatorPerformanceSummary", reflect.TypeOf((*MockValidatorServiceClient)(nil).ValidatorPerformanceSummary), varargs...)
+}
+
 // ValidatorStatus mocks base method
 func (m *MockValidatorServiceClient) ValidatorStatus(arg0 context.Context, arg1 *v1.ValidatorIndexRequest, arg2 ...grpc.CallOption) (*v1.ValidatorStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +317,46 @@ func (mr *MockValidatorServiceClientMockRecorder) WaitForActivation(arg0, arg1 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForActivation", reflect.TypeOf((*MockValidatorServiceClient)(nil).WaitForActivation), varargs...)
 }
 
+// WithdrawableValidators mocks base method
+func (m *MockValidatorServiceClient) WithdrawableValidators(arg0 context.Context, arg1 *v1.WithdrawableValidatorsRequest, arg2 ...grpc.CallOption) (*v1.WithdrawableValidatorsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WithdrawableValidators", varargs...)
+	ret0, _ := ret[0].(*v1.WithdrawableValidatorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawableValidators indicates an expected call of WithdrawableValidators
+func (mr *MockValidatorServiceClientMockRecorder) WithdrawableValidators(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawableValidators", reflect.TypeOf((*MockValidatorServiceClient)(nil).WithdrawableValidators), varargs...)
+}
+
+// WithdrawalCredentials mocks base method
+func (m *MockValidatorServiceClient) WithdrawalCredentials(arg0 context.Context, arg1 *v1.WithdrawalCredentialsRequest, arg2 ...grpc.CallOption) (*v1.WithdrawalCredentialsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WithdrawalCredentials", varargs...)
+	ret0, _ := ret[0].(*v1.WithdrawalCredentialsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WithdrawalCredentials indicates an expected call of WithdrawalCredentials
+func (mr *MockValidatorServiceClientMockRecorder) WithdrawalCredentials(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithdrawalCredentials", reflect.TypeOf((*MockValidatorServiceClient)(nil).WithdrawalCredentials), varargs...)
+}
+
 // MockValidatorService_WaitForActivationClient is a mock of ValidatorService_WaitForActivationClient interface
 type MockValidatorService_WaitForActivationClient struct {
 	ctrl     *gomock.Controller